@@ -3,13 +3,18 @@ package main
 import (
 	"fmt"
 
-	"github.com/thezmc/go-sinch/pkg/api"
 	"github.com/thezmc/go-sinch/pkg/sms"
 )
 
 func main() {
-	apiClient := new(api.Client)
-	smsClient := new(sms.Client).WithPlanID("YOUR_PLAN_ID").WithAuthToken("YOUR_AUTH_TOKEN").WithSinchAPI(apiClient)
+	smsClient, err := sms.New(
+		sms.WithPlanID("YOUR_PLAN_ID"),
+		sms.WithAuthToken("YOUR_AUTH_TOKEN"),
+		sms.WithBaseURL(sms.EUBaseURLv1), // Optional, defaults to the US region.
+	)
+	if err != nil {
+		panic(err)
+	}
 
 	request := new(sms.BatchSendRequest).
 		To("RECIPIENT_PHONE_NUMBER").
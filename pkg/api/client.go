@@ -2,8 +2,11 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"io"
+	"net"
 	"net/http"
+	"time"
 
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
@@ -13,6 +16,70 @@ type Client struct {
 	HTTPClient *http.Client
 }
 
+const DefaultTimeout = 30 * time.Second
+
+// DefaultTransport is the transport shared by the HTTP clients created by this package, so that every service client
+// reuses the same connection pool. It does not limit the time to wait for a response, which is left to the timeout of
+// each HTTP client.
+var DefaultTransport http.RoundTripper = &http.Transport{
+	Proxy: http.ProxyFromEnvironment,
+	DialContext: (&net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}).DialContext,
+	ForceAttemptHTTP2:     true,
+	MaxIdleConns:          100,
+	MaxIdleConnsPerHost:   10,
+	IdleConnTimeout:       90 * time.Second,
+	TLSHandshakeTimeout:   10 * time.Second,
+	ExpectContinueTimeout: time.Second,
+}
+
+// DefaultHTTPClient is the HTTP client used by New when none is given. It uses DefaultTransport and DefaultTimeout.
+var DefaultHTTPClient = NewHTTPClient(DefaultTimeout)
+
+// NewHTTPClient returns an HTTP client with the given overall request timeout that uses DefaultTransport.
+func NewHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{Transport: DefaultTransport, Timeout: timeout}
+}
+
+// Option configures a Client created with New.
+type Option func(*Client)
+
+// WithBaseURL sets the base URL of the API.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.BaseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets the HTTP client used to send requests instead of DefaultHTTPClient.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = httpClient
+	}
+}
+
+// WithTimeout replaces the HTTP client with one that uses DefaultTransport and the given overall request timeout.
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.HTTPClient = NewHTTPClient(timeout)
+	}
+}
+
+// New returns a client that uses DefaultHTTPClient unless configured otherwise. A base URL is required; it returns an
+// error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	c := &Client{HTTPClient: DefaultHTTPClient}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if err := c.Validate(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
 func (api *Client) WithBaseURL(baseURL string) *Client {
 	api.BaseURL = baseURL
 	return api
@@ -33,7 +100,14 @@ func (c Client) Validate() error {
 	return nil
 }
 
+// Do executes the request with a background context. See DoContext.
 func (c Client) Do(client sinch.APIClient, req sinch.APIRequest, recv sinch.APIResponse) error {
+	return c.DoContext(context.Background(), client, req, recv)
+}
+
+// DoContext validates and sends the request on behalf of the service client and decodes the response into recv. The
+// request is aborted if ctx is done before it completes.
+func (c Client) DoContext(ctx context.Context, client sinch.APIClient, req sinch.APIRequest, recv sinch.APIResponse) error {
 	if err := Validate(c, client, req); err != nil {
 		return err
 	}
@@ -49,20 +123,24 @@ func (c Client) Do(client sinch.APIClient, req sinch.APIRequest, recv sinch.APIR
 	}
 
 	url := client.URL() + req.Path() + queryString
-	httpReq, err := http.NewRequest(req.Method(), url, bytes.NewReader(body))
+	httpReq, err := http.NewRequestWithContext(ctx, req.Method(), url, bytes.NewReader(body))
 	if err != nil {
 		return err
 	}
 
+	if httpReq.ContentLength > 0 {
+		contentType := "application/json"
+		if ct, ok := req.(sinch.ContentTyper); ok {
+			contentType = ct.ContentType()
+		}
+		httpReq.Header.Set("Content-Type", contentType)
+	}
+
 	_, err = client.Authenticate(httpReq)
 	if err != nil {
 		return err
 	}
 
-	if httpReq.ContentLength > 0 {
-		httpReq.Header.Set("Content-Type", "application/json")
-	}
-
 	httpResp, err := c.HTTPClient.Do(httpReq)
 	if err != nil {
 		return err
@@ -1,9 +1,11 @@
 package api
 
 import (
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 	"github.com/thezmc/go-sinch/pkg/sinch"
@@ -207,3 +209,124 @@ func Test_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_StatusCode(t *testing.T) {
+	code, ok := StatusCode(UnexpectedStatusCodeErr(http.StatusOK, http.StatusNotFound))
+	if !ok || code != http.StatusNotFound {
+		t.Errorf("StatusCode() = %d, %v, expected %d, true", code, ok, http.StatusNotFound)
+	}
+	if _, ok := StatusCode(FakeError); ok {
+		t.Errorf("StatusCode() found a status code in %v", FakeError)
+	}
+}
+
+func Test_New(t *testing.T) {
+	custom := &http.Client{}
+
+	tests := map[string]struct {
+		opts           []Option
+		wantHTTPClient *http.Client
+		wantTimeout    time.Duration
+		wantErr        error
+	}{
+		"defaults": {
+			opts:           []Option{WithBaseURL("https://example.com")},
+			wantHTTPClient: DefaultHTTPClient,
+			wantTimeout:    DefaultTimeout,
+		},
+		"custom http client": {
+			opts:           []Option{WithBaseURL("https://example.com"), WithHTTPClient(custom)},
+			wantHTTPClient: custom,
+		},
+		"timeout": {
+			opts:        []Option{WithBaseURL("https://example.com"), WithTimeout(time.Second)},
+			wantTimeout: time.Second,
+		},
+		"no base url": {
+			wantErr: NoBaseURLError,
+		},
+		"nil http client": {
+			opts:    []Option{WithBaseURL("https://example.com"), WithHTTPClient(nil)},
+			wantErr: NilHTTPClientError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := New(tt.opts...)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("New() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.BaseURL != "https://example.com" {
+				t.Errorf("New() BaseURL = %q", got.BaseURL)
+			}
+			if tt.wantHTTPClient != nil && got.HTTPClient != tt.wantHTTPClient {
+				t.Errorf("New() HTTPClient = %p, want %p", got.HTTPClient, tt.wantHTTPClient)
+			}
+			if tt.wantTimeout != 0 && (got.HTTPClient.Timeout != tt.wantTimeout || got.HTTPClient.Transport != DefaultTransport) {
+				t.Errorf("New() HTTPClient = %+v, want timeout %v with DefaultTransport", got.HTTPClient, tt.wantTimeout)
+			}
+		})
+	}
+}
+
+func Test_NewHTTPClient_LongTimeout(t *testing.T) {
+	client := NewHTTPClient(2 * DefaultTimeout)
+	if transport := client.Transport.(*http.Transport); transport.ResponseHeaderTimeout != 0 {
+		t.Errorf("ResponseHeaderTimeout = %v, want the client timeout %v to apply", transport.ResponseHeaderTimeout, client.Timeout)
+	}
+}
+
+type contentTypeRequest struct {
+	*sinch.MockAPIRequest
+}
+
+func (contentTypeRequest) ContentType() string {
+	return "text/plain"
+}
+
+func Test_Do_ContentType(t *testing.T) {
+	var gotContentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotContentType = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+	client := new(Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client())
+
+	tests := map[string]struct {
+		wrap func(*sinch.MockAPIRequest) sinch.APIRequest
+		want string
+	}{
+		"json":   {wrap: func(r *sinch.MockAPIRequest) sinch.APIRequest { return r }, want: "application/json"},
+		"custom": {wrap: func(r *sinch.MockAPIRequest) sinch.APIRequest { return contentTypeRequest{r} }, want: "text/plain"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mockRequest := new(sinch.MockAPIRequest)
+			mockRequest.On("Validate").Return(nil)
+			mockRequest.On("QueryString").Return("", nil)
+			mockRequest.On("Body").Return([]byte("hello"), nil)
+			mockRequest.On("Method").Return("POST")
+			mockRequest.On("Path").Return("/path")
+			mockRequest.On("ExpectedStatusCode").Return(http.StatusOK)
+			mockClient := new(sinch.MockAPIClient)
+			mockClient.On("Validate").Return(nil)
+			mockClient.On("URL").Return(srv.URL)
+			mockClient.On("Authenticate", mock.Anything).Return((*http.Request)(nil), nil)
+			mockResponse := new(sinch.MockAPIResponse)
+			mockResponse.On("FromJSON").Return(nil)
+
+			if err := client.Do(mockClient, test.wrap(mockRequest), mockResponse); err != nil {
+				t.Fatalf("Client.Do() error = %v", err)
+			}
+			if gotContentType != test.want {
+				t.Errorf("Content-Type = %q, expected %q", gotContentType, test.want)
+			}
+		})
+	}
+}
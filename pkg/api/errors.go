@@ -1,10 +1,10 @@
 package api
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/thezmc/go-sinch/pkg/sinch"
-	"go.uber.org/multierr"
 )
 
 type Error string
@@ -13,11 +13,8 @@ func (e Error) Error() string {
 	return string(e)
 }
 
-type Errors []error
-
-func (e Errors) Error() string {
-	return multierr.Combine(e...).Error()
-}
+// Errors is a list of errors that matches errors.Is and errors.As if any of its errors does.
+type Errors = sinch.Errors
 
 const (
 	NoBaseURLError            = Error("a base URL is required")
@@ -26,13 +23,44 @@ const (
 	NilValidatableError       = Error("validatable cannot be nil")
 	NilClientError            = Error("client cannot be nil")
 	InvalidRequestTypeError   = Error("invalid request type")
+	NoMoreItemsError          = Error("no more items")
+	StuckPagerError           = Error("an empty page did not advance the page token")
+)
+
+// The errors returned by ProjectClient and ApplicationClient. The packages of the clients that embed them export them
+// under the same names.
+const (
+	ProjectIDRequiredError         = sinch.Error("project ID is required")
+	KeyIDRequiredError             = sinch.Error("key ID is required")
+	KeySecretRequiredError         = sinch.Error("key secret is required")
+	ApplicationKeyRequiredError    = sinch.Error("application key is required")
+	ApplicationSecretRequiredError = sinch.Error("application secret is required")
 )
 
+// StatusCodeError holds the expected and actual status codes of a response that did not match its request.
+type StatusCodeError struct {
+	Expected int
+	Actual   int
+}
+
+func (e *StatusCodeError) Error() string {
+	return fmt.Sprintf("expected %d, got %d", e.Expected, e.Actual)
+}
+
 func UnexpectedStatusCodeErr(exp, actual int) error {
 	return Errors{
 		UnexpectedStatusCodeError,
-		fmt.Errorf("expected %d, got %d", exp, actual),
+		&StatusCodeError{Expected: exp, Actual: actual},
+	}
+}
+
+// StatusCode returns the actual status code carried by err, if err was caused by an unexpected status code.
+func StatusCode(err error) (int, bool) {
+	var sce *StatusCodeError
+	if errors.As(err, &sce) {
+		return sce.Actual, true
 	}
+	return 0, false
 }
 
 type RequestValidator func(req sinch.APIRequest) error
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Pager iterates over the items of a paginated list, fetching pages lazily as the items are consumed.
+type Pager[T any] struct {
+	client      sinch.APIClient
+	request     sinch.ListRequest
+	newResponse func() sinch.ListResponse[T]
+	maxItems    int
+	page        []T
+	returned    int
+	nextToken   string
+	fetched     bool
+}
+
+// NewPager returns a pager that sends req with client for every page and decodes pages into the responses returned by
+// newResponse. The pager takes ownership of req: it sets the page token of req for every page after the first, so req
+// is left pointing at the last page fetched and must not be reused or modified while the pager is in use.
+func NewPager[T any](client sinch.APIClient, req sinch.ListRequest, newResponse func() sinch.ListResponse[T]) *Pager[T] {
+	return &Pager[T]{client: client, request: req, newResponse: newResponse}
+}
+
+// WithMaxItems stops the pager after n items. Zero or less means no limit.
+func (p *Pager[T]) WithMaxItems(n int) *Pager[T] {
+	p.maxItems = n
+	return p
+}
+
+// Next returns the next item, fetching the next page if needed. Once all items have been returned, or the max items
+// cap is reached, it returns NoMoreItemsError. It returns StuckPagerError if an empty page returns the page token it
+// was fetched with, which would otherwise fetch the same page forever.
+func (p *Pager[T]) Next(ctx context.Context) (T, error) {
+	var zero T
+	if p.maxItems > 0 && p.returned >= p.maxItems {
+		return zero, NoMoreItemsError
+	}
+	for len(p.page) == 0 {
+		if p.fetched && p.nextToken == "" {
+			return zero, NoMoreItemsError
+		}
+		if err := p.fetch(ctx); err != nil {
+			return zero, err
+		}
+	}
+	item := p.page[0]
+	p.page = p.page[1:]
+	p.returned++
+	return item, nil
+}
+
+// All returns all the remaining items.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	var items []T
+	for {
+		item, err := p.Next(ctx)
+		if err == NoMoreItemsError {
+			return items, nil
+		}
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+}
+
+func (p *Pager[T]) fetch(ctx context.Context) error {
+	token := p.nextToken
+	if p.fetched {
+		p.request.SetPageToken(token)
+	}
+	resp := p.newResponse()
+	if err := doContext(ctx, p.client, p.request, resp); err != nil {
+		return err
+	}
+	p.fetched = true
+	p.page = resp.Items()
+	p.nextToken = resp.NextPageToken()
+	if len(p.page) == 0 && p.nextToken != "" && p.nextToken == token {
+		return StuckPagerError
+	}
+	return nil
+}
+
+// doContext sends req with client, passing ctx along if the client supports it.
+func doContext(ctx context.Context, client sinch.APIClient, req sinch.APIRequest, resp sinch.APIResponse) error {
+	if cc, ok := client.(sinch.ContextAPIClient); ok {
+		return cc.DoContext(ctx, req, resp)
+	}
+	return client.Do(req, resp)
+}
@@ -0,0 +1,24 @@
+//go:build go1.23
+
+package api
+
+import (
+	"context"
+	"iter"
+)
+
+// Seq returns an iterator over the remaining items for use with range. Iteration stops after the first error, which
+// is yielded with the zero value of T.
+func (p *Pager[T]) Seq(ctx context.Context) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			item, err := p.Next(ctx)
+			if err == NoMoreItemsError {
+				return
+			}
+			if !yield(item, err) || err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,31 @@
+//go:build go1.23
+
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Pager_Seq(t *testing.T) {
+	var items []int
+	for item, err := range newFakePager(new(fakeListClient)).Seq(context.Background()) {
+		assert.NoError(t, err)
+		if item == 5 {
+			break
+		}
+		items = append(items, item)
+	}
+	assert.Equal(t, []int{0, 1, 2, 3, 4}, items)
+
+	client := &fakeListClient{failAt: 2}
+	var errs int
+	for _, err := range newFakePager(client).Seq(context.Background()) {
+		if err != nil {
+			errs++
+		}
+	}
+	assert.Equal(t, 1, errs)
+}
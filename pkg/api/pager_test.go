@@ -0,0 +1,165 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// fakeListRequest pages through the numbers 0-9 three at a time.
+type fakeListRequest struct {
+	sinch.MockAPIRequest
+	token string
+}
+
+func (r *fakeListRequest) SetPageToken(token string) {
+	r.token = token
+}
+
+type fakeListResponse struct {
+	Numbers []int  `json:"numbers"`
+	Next    string `json:"next"`
+}
+
+func (r *fakeListResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, r)
+}
+
+func (r *fakeListResponse) Items() []int {
+	return r.Numbers
+}
+
+func (r *fakeListResponse) NextPageToken() string {
+	return r.Next
+}
+
+type fakeListClient struct {
+	sinch.MockAPIClient
+	fetches int
+	failAt  int
+}
+
+func (c *fakeListClient) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	c.fetches++
+	if c.fetches == c.failAt {
+		return UnexpectedStatusCodeErr(http.StatusOK, http.StatusInternalServerError)
+	}
+	start, _ := strconv.Atoi(req.(*fakeListRequest).token)
+	page := &fakeListResponse{}
+	for i := start; i < start+3 && i < 10; i++ {
+		page.Numbers = append(page.Numbers, i)
+	}
+	if start+3 < 10 {
+		page.Next = strconv.Itoa(start + 3)
+	}
+	data, _ := json.Marshal(page)
+	return resp.FromJSON(data)
+}
+
+func (c *fakeListClient) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.DoContext(context.Background(), req, resp)
+}
+
+// doOnlyClient hides the DoContext method of its client.
+type doOnlyClient struct {
+	sinch.APIClient
+}
+
+// emptyPageClient returns empty pages that all point to the page with token 1, so the page token stops advancing after
+// the first page.
+type emptyPageClient struct {
+	sinch.MockAPIClient
+	fetches int
+}
+
+func (c *emptyPageClient) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
+	c.fetches++
+	return resp.FromJSON([]byte(`{"next": "1"}`))
+}
+
+func newFakePager(client *fakeListClient) *Pager[int] {
+	return NewPager[int](client, new(fakeListRequest), func() sinch.ListResponse[int] {
+		return new(fakeListResponse)
+	})
+}
+
+func Test_Pager(t *testing.T) {
+	ctx := context.Background()
+	tests := map[string]struct {
+		maxItems        int
+		failAt          int
+		expectedItems   []int
+		expectedFetches int
+		wantErr         bool
+	}{
+		"all pages": {
+			expectedItems:   []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+			expectedFetches: 4,
+		},
+		"max items": {
+			maxItems:        4,
+			expectedItems:   []int{0, 1, 2, 3},
+			expectedFetches: 2,
+		},
+		"fetch error": {
+			failAt:          3,
+			expectedItems:   []int{0, 1, 2, 3, 4, 5},
+			expectedFetches: 3,
+			wantErr:         true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeListClient{failAt: test.failAt}
+			items, err := newFakePager(client).WithMaxItems(test.maxItems).All(ctx)
+			if test.wantErr {
+				assert.ErrorIs(t, err, UnexpectedStatusCodeError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedItems, items)
+			assert.Equal(t, test.expectedFetches, client.fetches)
+		})
+	}
+}
+
+func Test_Pager_Next(t *testing.T) {
+	ctx := context.Background()
+	client := new(fakeListClient)
+	pager := newFakePager(client).WithMaxItems(1)
+
+	item, err := pager.Next(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, item)
+	_, err = pager.Next(ctx)
+	assert.Equal(t, NoMoreItemsError, err)
+	assert.Equal(t, 1, client.fetches)
+}
+
+func Test_Pager_ClientWithoutContext(t *testing.T) {
+	client := new(fakeListClient)
+	pager := NewPager[int](doOnlyClient{client}, new(fakeListRequest), func() sinch.ListResponse[int] {
+		return new(fakeListResponse)
+	})
+
+	items, err := pager.All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, items, 10)
+	assert.Equal(t, 4, client.fetches)
+}
+
+func Test_Pager_StuckPageToken(t *testing.T) {
+	client := new(emptyPageClient)
+	pager := NewPager[int](doOnlyClient{client}, new(fakeListRequest), func() sinch.ListResponse[int] {
+		return new(fakeListResponse)
+	})
+
+	_, err := pager.Next(context.Background())
+	assert.ErrorIs(t, err, StuckPagerError)
+	assert.Equal(t, 2, client.fetches)
+}
@@ -0,0 +1,192 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// ProjectClient sends requests to an API that authenticates with an access key of a Sinch project, like the Fax and
+// Conversation APIs. The clients of those APIs embed it. Clients that add checks to Validate or change URL must also
+// define Do and DoContext, so that their own methods are used.
+type ProjectClient struct {
+	SinchAPI      Client // The API client requests are sent with, unless a shared one is set with WithSinchAPI.
+	ProjectID     string
+	KeyID         string
+	KeySecret     string
+	Authenticator sinch.Authenticator // When set, authenticates requests instead of basic auth with KeyID and KeySecret.
+	sharedAPI     *Client
+}
+
+// API returns the API client the client sends requests with.
+func (c *ProjectClient) API() *Client {
+	if c.sharedAPI != nil {
+		return c.sharedAPI
+	}
+	return &c.SinchAPI
+}
+
+func (c *ProjectClient) ShareAPI(sinchAPI *Client) {
+	c.sharedAPI = sinchAPI
+}
+
+// WithSinchAPI makes the client send requests with sinchAPI, which other clients may share.
+func (c *ProjectClient) WithSinchAPI(sinchAPI *Client) *ProjectClient {
+	c.ShareAPI(sinchAPI)
+	return c
+}
+
+func (c *ProjectClient) WithProjectID(projectID string) *ProjectClient {
+	c.ProjectID = projectID
+	return c
+}
+
+// WithKey sets the ID and secret of the access key used for basic authentication.
+func (c *ProjectClient) WithKey(keyID, keySecret string) *ProjectClient {
+	c.KeyID = keyID
+	c.KeySecret = keySecret
+	return c
+}
+
+// WithAuthenticator authenticates requests with a instead of the access key. See the auth package for implementations.
+func (c *ProjectClient) WithAuthenticator(a sinch.Authenticator) *ProjectClient {
+	c.Authenticator = a
+	return c
+}
+
+// WithTokenSource authenticates requests with OAuth2 access tokens from ts instead of the access key. See
+// auth.ClientCredentials.
+func (c *ProjectClient) WithTokenSource(ts auth.TokenSource) *ProjectClient {
+	return c.WithAuthenticator(auth.NewOAuth2(ts))
+}
+
+// Project returns the client itself, so that the options in this package can configure the clients that embed it.
+func (c *ProjectClient) Project() *ProjectClient {
+	return c
+}
+
+func (c *ProjectClient) authenticator() *sinch.Authenticator {
+	return &c.Authenticator
+}
+
+func (c *ProjectClient) Validate() error {
+	if c.ProjectID == "" {
+		return ProjectIDRequiredError
+	}
+	if c.Authenticator != nil {
+		return nil
+	}
+	if c.KeyID == "" {
+		return KeyIDRequiredError
+	}
+	if c.KeySecret == "" {
+		return KeySecretRequiredError
+	}
+	return nil
+}
+
+func (c *ProjectClient) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	if c.Authenticator != nil {
+		return c.Authenticator.Authenticate(httpReq)
+	}
+	httpReq.SetBasicAuth(c.KeyID, c.KeySecret)
+	return httpReq, nil
+}
+
+func (c *ProjectClient) URL() string {
+	return c.API().BaseURL + "/" + c.ProjectID
+}
+
+func (c *ProjectClient) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.DoContext(context.Background(), req, resp)
+}
+
+func (c *ProjectClient) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.API().DoContext(ctx, c, req, resp)
+}
+
+// ApplicationClient sends requests to an API that signs them with the key and secret of a Sinch application, like the
+// Verification and Voice APIs. The clients of those APIs embed it.
+type ApplicationClient struct {
+	SinchAPI          Client // The API client requests are sent with, unless a shared one is set with WithSinchAPI.
+	ApplicationKey    string
+	ApplicationSecret string              // The base 64 encoded application secret.
+	Authenticator     sinch.Authenticator // When set, authenticates requests instead of signing them with the application key and secret.
+	sharedAPI         *Client
+}
+
+// API returns the API client the client sends requests with.
+func (c *ApplicationClient) API() *Client {
+	if c.sharedAPI != nil {
+		return c.sharedAPI
+	}
+	return &c.SinchAPI
+}
+
+func (c *ApplicationClient) ShareAPI(sinchAPI *Client) {
+	c.sharedAPI = sinchAPI
+}
+
+// WithSinchAPI makes the client send requests with sinchAPI, like ProjectClient.WithSinchAPI.
+func (c *ApplicationClient) WithSinchAPI(sinchAPI *Client) *ApplicationClient {
+	c.ShareAPI(sinchAPI)
+	return c
+}
+
+// WithApplication sets the key and base 64 encoded secret of the application requests are signed with.
+func (c *ApplicationClient) WithApplication(key, secret string) *ApplicationClient {
+	c.ApplicationKey = key
+	c.ApplicationSecret = secret
+	return c
+}
+
+// WithAuthenticator authenticates requests with a instead of the application key and secret. See the auth package for
+// implementations.
+func (c *ApplicationClient) WithAuthenticator(a sinch.Authenticator) *ApplicationClient {
+	c.Authenticator = a
+	return c
+}
+
+// Application returns the client itself, so that the options in this package can configure the clients that embed it.
+func (c *ApplicationClient) Application() *ApplicationClient {
+	return c
+}
+
+func (c *ApplicationClient) authenticator() *sinch.Authenticator {
+	return &c.Authenticator
+}
+
+func (c *ApplicationClient) Validate() error {
+	if c.Authenticator != nil {
+		return nil
+	}
+	if c.ApplicationKey == "" {
+		return ApplicationKeyRequiredError
+	}
+	if c.ApplicationSecret == "" {
+		return ApplicationSecretRequiredError
+	}
+	return nil
+}
+
+// Authenticate signs the request with the application key and secret, see auth.ApplicationSigned.
+func (c *ApplicationClient) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	if c.Authenticator != nil {
+		return c.Authenticator.Authenticate(httpReq)
+	}
+	return auth.NewApplicationSigned(c.ApplicationKey, c.ApplicationSecret).Authenticate(httpReq)
+}
+
+func (c *ApplicationClient) URL() string {
+	return c.API().BaseURL
+}
+
+func (c *ApplicationClient) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.DoContext(context.Background(), req, resp)
+}
+
+func (c *ApplicationClient) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.API().DoContext(ctx, c, req, resp)
+}
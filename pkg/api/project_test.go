@@ -0,0 +1,116 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type fakeProjectService struct {
+	ProjectClient
+}
+
+type fakeApplicationService struct {
+	ApplicationClient
+}
+
+func Test_ProjectClient_Implementations(t *testing.T) {
+	var _ sinch.ContextAPIClient = new(ProjectClient)
+	var _ sinch.ContextAPIClient = new(ApplicationClient)
+	var _ ProjectService = new(fakeProjectService)
+	var _ ApplicationService = new(fakeApplicationService)
+	var _ AuthenticatorService = new(fakeProjectService)
+	var _ AuthenticatorService = new(fakeApplicationService)
+}
+
+func Test_ProjectClient(t *testing.T) {
+	tests := map[string]struct {
+		opts     []ServiceOption[*fakeProjectService]
+		wantErr  error
+		wantAuth string
+	}{
+		"no project id": {
+			opts:    []ServiceOption[*fakeProjectService]{WithServiceKey[*fakeProjectService]("key", "secret")},
+			wantErr: ProjectIDRequiredError,
+		},
+		"no key id": {
+			opts:    []ServiceOption[*fakeProjectService]{WithServiceProjectID[*fakeProjectService]("project")},
+			wantErr: KeyIDRequiredError,
+		},
+		"no key secret": {
+			opts: []ServiceOption[*fakeProjectService]{
+				WithServiceProjectID[*fakeProjectService]("project"),
+				WithServiceKey[*fakeProjectService]("key", ""),
+			},
+			wantErr: KeySecretRequiredError,
+		},
+		"basic auth": {
+			opts: []ServiceOption[*fakeProjectService]{
+				WithServiceProjectID[*fakeProjectService]("project"),
+				WithServiceKey[*fakeProjectService]("key", "secret"),
+			},
+			wantAuth: "Basic a2V5OnNlY3JldA==",
+		},
+		"authenticator": {
+			opts: []ServiceOption[*fakeProjectService]{
+				WithServiceProjectID[*fakeProjectService]("project"),
+				WithServiceAuthenticator[*fakeProjectService](auth.NewBearerToken("token")),
+			},
+			wantAuth: "Bearer token",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			c, err := NewService(WithServiceBaseURL[*fakeProjectService]("https://example.com/v1/projects"), tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, "https://example.com/v1/projects/project", c.URL())
+
+			httpReq, _ := http.NewRequest(http.MethodGet, c.URL(), nil)
+			_, err = c.Authenticate(httpReq)
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAuth, httpReq.Header.Get("Authorization"))
+		})
+	}
+}
+
+func Test_ApplicationClient(t *testing.T) {
+	c := new(fakeApplicationService)
+	assert.ErrorIs(t, c.Validate(), ApplicationKeyRequiredError)
+
+	WithServiceApplication[*fakeApplicationService]("key", "")(c)
+	assert.ErrorIs(t, c.Validate(), ApplicationSecretRequiredError)
+
+	WithServiceApplication[*fakeApplicationService]("key", "c2VjcmV0")(c)
+	assert.NoError(t, c.Validate())
+
+	WithServiceBaseURL[*fakeApplicationService]("https://example.com/v1")(c)
+	assert.Equal(t, "https://example.com/v1", c.URL())
+
+	WithServiceAuthenticator[*fakeApplicationService](auth.NewBearerToken("token"))(c)
+	httpReq, _ := http.NewRequest(http.MethodGet, c.URL(), nil)
+	_, err := c.Authenticate(httpReq)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", httpReq.Header.Get("Authorization"))
+}
+
+func Test_ProjectClient_Builders(t *testing.T) {
+	shared := &Client{BaseURL: "https://example.com/v1/projects", HTTPClient: http.DefaultClient}
+	c := new(ProjectClient).WithSinchAPI(shared).WithProjectID("project").WithKey("key", "secret")
+	assert.NoError(t, Validate(c, c))
+	assert.Same(t, shared, c.API())
+	assert.Equal(t, "https://example.com/v1/projects/project", c.URL())
+
+	c.WithTokenSource(nil)
+	assert.IsType(t, &auth.OAuth2{}, c.Authenticator)
+
+	a := new(ApplicationClient).WithSinchAPI(shared).WithApplication("key", "c2VjcmV0")
+	assert.NoError(t, Validate(a, a))
+	assert.Same(t, shared, a.API())
+}
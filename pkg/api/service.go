@@ -0,0 +1,127 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Service is implemented by the clients of the Sinch APIs, like sms.Client and numbers.Client, which send their requests
+// with an API client. It lets the options in this package configure all of them.
+type Service interface {
+	sinch.Validatable
+	API() *Client // Returns the API client the service client sends its requests with.
+	// ShareAPI makes the service client send its requests with sinchAPI, which may be shared with other service
+	// clients. With nil, it sends them with its own API client again.
+	ShareAPI(sinchAPI *Client)
+}
+
+// ProjectService is implemented by the service clients that embed a ProjectClient.
+type ProjectService interface {
+	Service
+	Project() *ProjectClient
+}
+
+// ApplicationService is implemented by the service clients that embed an ApplicationClient.
+type ApplicationService interface {
+	Service
+	Application() *ApplicationClient
+}
+
+// AuthenticatorService is implemented by the service clients that embed a ProjectClient or an ApplicationClient.
+type AuthenticatorService interface {
+	Service
+	authenticator() *sinch.Authenticator
+}
+
+// ServiceOption configures a service client of type S created with NewService.
+type ServiceOption[S Service] func(S)
+
+// NewService returns a new service client that sends requests with DefaultHTTPClient. It applies defaults and then
+// opts, and returns an error if the configured service client or its API client is not valid.
+func NewService[T any, S interface {
+	*T
+	Service
+}](defaults ServiceOption[S], opts ...ServiceOption[S]) (S, error) {
+	s := S(new(T))
+	s.API().HTTPClient = DefaultHTTPClient
+	defaults(s)
+	for _, opt := range opts {
+		opt(s)
+	}
+	if err := Validate(s.API(), s); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// OwnAPI makes s send requests with its own API client, starting as a copy of the one it sends them with, and returns
+// it. Service clients change their API client through OwnAPI, so that they never change one shared with others.
+func OwnAPI(s Service) *Client {
+	own := *s.API()
+	s.ShareAPI(nil)
+	*s.API() = own
+	return s.API()
+}
+
+// WithServiceSinchAPI makes a service client send requests with sinchAPI. Service clients created with the same
+// sinchAPI share it, so changes made to sinchAPI later apply to all of them.
+func WithServiceSinchAPI[S Service](sinchAPI *Client) ServiceOption[S] {
+	return func(s S) {
+		s.ShareAPI(sinchAPI)
+	}
+}
+
+// WithServiceBaseURL sets the base URL of a service client. A shared API client is left unchanged, see OwnAPI.
+func WithServiceBaseURL[S Service](baseURL string) ServiceOption[S] {
+	return func(s S) {
+		OwnAPI(s).BaseURL = baseURL
+	}
+}
+
+// WithServiceHTTPClient sets the HTTP client a service client sends requests with. A shared API client is left
+// unchanged, see OwnAPI.
+func WithServiceHTTPClient[S Service](httpClient *http.Client) ServiceOption[S] {
+	return func(s S) {
+		OwnAPI(s).HTTPClient = httpClient
+	}
+}
+
+// WithServiceProjectID sets the project ID of a service client.
+func WithServiceProjectID[S ProjectService](projectID string) ServiceOption[S] {
+	return func(s S) {
+		s.Project().WithProjectID(projectID)
+	}
+}
+
+// WithServiceKey sets the ID and secret of the access key a service client uses for basic authentication.
+func WithServiceKey[S ProjectService](keyID, keySecret string) ServiceOption[S] {
+	return func(s S) {
+		s.Project().WithKey(keyID, keySecret)
+	}
+}
+
+// WithServiceTokenSource makes a service client authenticate requests with OAuth2 access tokens from ts instead of the
+// access key. See auth.ClientCredentials.
+func WithServiceTokenSource[S ProjectService](ts auth.TokenSource) ServiceOption[S] {
+	return func(s S) {
+		s.Project().WithTokenSource(ts)
+	}
+}
+
+// WithServiceApplication sets the key and base 64 encoded secret of the application a service client signs requests
+// with.
+func WithServiceApplication[S ApplicationService](key, secret string) ServiceOption[S] {
+	return func(s S) {
+		s.Application().WithApplication(key, secret)
+	}
+}
+
+// WithServiceAuthenticator makes a service client authenticate requests with a instead of its access key or application
+// key. See the auth package for implementations.
+func WithServiceAuthenticator[S AuthenticatorService](a sinch.Authenticator) ServiceOption[S] {
+	return func(s S) {
+		*s.authenticator() = a
+	}
+}
@@ -0,0 +1,116 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type fakeService struct {
+	SinchAPI Client
+	Err      error
+	shared   *Client
+}
+
+func (s *fakeService) API() *Client {
+	if s.shared != nil {
+		return s.shared
+	}
+	return &s.SinchAPI
+}
+
+func (s *fakeService) ShareAPI(sinchAPI *Client) {
+	s.shared = sinchAPI
+}
+
+func (s *fakeService) Validate() error {
+	return s.Err
+}
+
+func Test_NewService(t *testing.T) {
+	shared, _ := New(WithBaseURL("http://shared"))
+	custom := &http.Client{}
+	defaults := WithServiceBaseURL[*fakeService]("http://default")
+
+	tests := map[string]struct {
+		opts        []ServiceOption[*fakeService]
+		wantBaseURL string
+		wantHTTP    *http.Client
+		wantErr     error
+	}{
+		"defaults": {
+			wantBaseURL: "http://default",
+			wantHTTP:    DefaultHTTPClient,
+		},
+		"base url and http client": {
+			opts: []ServiceOption[*fakeService]{
+				WithServiceBaseURL[*fakeService]("http://localhost"),
+				WithServiceHTTPClient[*fakeService](custom),
+			},
+			wantBaseURL: "http://localhost",
+			wantHTTP:    custom,
+		},
+		"http client on shared api client": {
+			opts: []ServiceOption[*fakeService]{
+				WithServiceSinchAPI[*fakeService](shared),
+				WithServiceHTTPClient[*fakeService](custom),
+			},
+			wantBaseURL: "http://shared",
+			wantHTTP:    custom,
+		},
+		"no base url": {
+			opts:    []ServiceOption[*fakeService]{WithServiceBaseURL[*fakeService]("")},
+			wantErr: NoBaseURLError,
+		},
+		"nil http client": {
+			opts:    []ServiceOption[*fakeService]{WithServiceHTTPClient[*fakeService](nil)},
+			wantErr: NilHTTPClientError,
+		},
+		"invalid service": {
+			opts:    []ServiceOption[*fakeService]{func(s *fakeService) { s.Err = FakeError }},
+			wantErr: FakeError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NewService(defaults, tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantBaseURL, got.API().BaseURL)
+			assert.Same(t, tt.wantHTTP, got.API().HTTPClient)
+		})
+	}
+
+	assert.Same(t, DefaultHTTPClient, shared.HTTPClient, "shared API client must not be changed")
+}
+
+func Test_NewService_SharedAPI(t *testing.T) {
+	shared, _ := New(WithBaseURL("http://shared"))
+	a, err := NewService(WithServiceBaseURL[*fakeService]("http://default"), WithServiceSinchAPI[*fakeService](shared))
+	assert.NoError(t, err)
+	b, err := NewService(WithServiceBaseURL[*fakeService]("http://default"), WithServiceSinchAPI[*fakeService](shared))
+	assert.NoError(t, err)
+	assert.Same(t, shared, a.API())
+	assert.Same(t, shared, b.API())
+
+	shared.WithBaseURL("http://changed")
+	assert.Equal(t, "http://changed", a.API().BaseURL, "changes to the shared API client apply to every service client")
+
+	own := OwnAPI(b)
+	own.BaseURL = "http://own"
+	assert.NotSame(t, shared, b.API())
+	assert.Equal(t, "http://own", b.API().BaseURL)
+	assert.Equal(t, "http://changed", shared.BaseURL)
+	assert.Same(t, shared.HTTPClient, b.API().HTTPClient)
+}
+
+func Test_Service_Implementations(t *testing.T) {
+	var _ Service = new(fakeService)
+	var _ sinch.Validatable = new(fakeService)
+}
@@ -0,0 +1,162 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	TimestampHeader = "x-timestamp"
+	// ApplicationScheme is the scheme of the Authorization header of application signed requests.
+	ApplicationScheme = "Application"
+)
+
+// ApplicationSigned signs requests with an application key and secret, as used by the Verification and Voice APIs.
+// The signature is a base 64 encoded HMAC-SHA256 of the request method, content MD5, content type, timestamp and path.
+// The credentials can be rotated at runtime with Rotate.
+type ApplicationSigned struct {
+	mu     sync.RWMutex
+	key    string
+	secret string
+}
+
+// NewApplicationSigned returns an authenticator for the given application key and base 64 encoded secret.
+func NewApplicationSigned(key, secret string) *ApplicationSigned {
+	return &ApplicationSigned{key: key, secret: secret}
+}
+
+// Rotate replaces the credentials used by future requests. It is safe to call while requests are being authenticated.
+func (as *ApplicationSigned) Rotate(key, secret string) {
+	as.mu.Lock()
+	defer as.mu.Unlock()
+	as.key, as.secret = key, secret
+}
+
+func (as *ApplicationSigned) Validate() error {
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+	if as.key == "" {
+		return ApplicationKeyRequiredError
+	}
+	if as.secret == "" {
+		return ApplicationSecretRequiredError
+	}
+	return nil
+}
+
+// Authenticate sets the x-timestamp and Authorization headers of the request. The Content-Type header must be set
+// before the request is signed.
+func (as *ApplicationSigned) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	body, err := readBody(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	httpReq.Header.Set(TimestampHeader, timestamp)
+
+	as.mu.RLock()
+	defer as.mu.RUnlock()
+	signature, err := Sign(as.secret, StringToSign(httpReq.Method, body, httpReq.Header.Get("Content-Type"), timestamp, httpReq.URL.Path))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", ApplicationScheme+" "+as.key+":"+signature)
+	return httpReq, nil
+}
+
+// readBody returns the body of the request without consuming it.
+func readBody(httpReq *http.Request) ([]byte, error) {
+	if httpReq.Body == nil || httpReq.Body == http.NoBody {
+		return nil, nil
+	}
+	if httpReq.GetBody != nil {
+		rc, err := httpReq.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		defer rc.Close()
+		return io.ReadAll(rc)
+	}
+	body, err := io.ReadAll(httpReq.Body)
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Body.Close()
+	httpReq.Body = io.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+// ContentMD5 returns the base 64 encoded MD5 of body, or an empty string if body is empty.
+func ContentMD5(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+	sum := md5.Sum(body)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+// StringToSign returns the string signed by application signed requests and callbacks.
+func StringToSign(method string, body []byte, contentType, timestamp, path string) string {
+	return strings.Join([]string{
+		method,
+		ContentMD5(body),
+		contentType,
+		TimestampHeader + ":" + timestamp,
+		path,
+	}, "\n")
+}
+
+// Sign returns the base 64 encoded HMAC-SHA256 of s, keyed with the base 64 decoded application secret.
+func Sign(secret string, s string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(secret)
+	if err != nil {
+		return "", InvalidApplicationSecretError
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(s))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil)), nil
+}
+
+// CallbackStringToSign returns the string Sinch signs an application signed callback with. Callbacks are signed like
+// requests, see StringToSign, except that callbacks without an x-timestamp header sign the Date header instead.
+func CallbackStringToSign(r *http.Request, body []byte) string {
+	if timestamp := r.Header.Get(TimestampHeader); timestamp != "" {
+		return StringToSign(r.Method, body, r.Header.Get("Content-Type"), timestamp, r.URL.Path)
+	}
+	return strings.Join([]string{
+		r.Method,
+		ContentMD5(body),
+		r.Header.Get("Content-Type"),
+		r.Header.Get("Date"),
+		r.URL.Path,
+	}, "\n")
+}
+
+// VerifyCallback returns true if the Authorization header of the callback holds a valid signature of the request by
+// the application with the given key and base 64 encoded secret.
+func VerifyCallback(key, secret string, r *http.Request, body []byte) bool {
+	if key == "" || secret == "" {
+		return false
+	}
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, ApplicationScheme+" ") {
+		return false
+	}
+	gotKey, signature, ok := strings.Cut(strings.TrimPrefix(authorization, ApplicationScheme+" "), ":")
+	if !ok || gotKey != key {
+		return false
+	}
+	want, err := Sign(secret, CallbackStringToSign(r, body))
+	if err != nil {
+		return false
+	}
+	return hmac.Equal([]byte(want), []byte(signature))
+}
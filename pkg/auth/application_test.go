@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_StringToSign(t *testing.T) {
+	body := []byte(`{"message":"Hello world"}`)
+	s := StringToSign(http.MethodPost, body, "application/json", "2014-06-04T13:41:58Z", "/v1/sms/+46700000000")
+	assert.Equal(t, "POST\njANzQ+rgAHyf1MWQFSwvYw==\napplication/json\nx-timestamp:2014-06-04T13:41:58Z\n/v1/sms/+46700000000", s)
+	assert.Equal(t, "GET\n\n\nx-timestamp:2014-06-04T13:41:58Z\n/v1/sms", StringToSign(http.MethodGet, nil, "", "2014-06-04T13:41:58Z", "/v1/sms"))
+}
+
+func Test_Sign(t *testing.T) {
+	_, err := Sign("not base 64!", "test")
+	assert.ErrorIs(t, err, InvalidApplicationSecretError)
+
+	signature, err := Sign(base64.StdEncoding.EncodeToString([]byte("secret")), "test")
+	assert.NoError(t, err)
+	assert.Equal(t, "Aymga2LNFrM+tnkr6MYLFY2Jou46h2/Omogeu0iMCRQ=", signature)
+}
+
+func Test_ApplicationSigned(t *testing.T) {
+	secret := base64.StdEncoding.EncodeToString([]byte("secret"))
+	assert.ErrorIs(t, NewApplicationSigned("", secret).Validate(), ApplicationKeyRequiredError)
+	assert.ErrorIs(t, NewApplicationSigned("key", "").Validate(), ApplicationSecretRequiredError)
+
+	as := NewApplicationSigned("key", secret)
+	body := []byte(`{"identity":{"type":"number","endpoint":"+46700000000"}}`)
+	req, _ := http.NewRequest(http.MethodPost, "https://verification.api.sinch.com/verification/v1/verifications", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	_, err := as.Authenticate(req)
+	assert.NoError(t, err)
+
+	timestamp := req.Header.Get(TimestampHeader)
+	assert.NotEmpty(t, timestamp)
+	expected, _ := Sign(secret, StringToSign(http.MethodPost, body, "application/json", timestamp, "/verification/v1/verifications"))
+	assert.Equal(t, "Application key:"+expected, req.Header.Get("Authorization"))
+
+	sent, _ := io.ReadAll(req.Body)
+	assert.Equal(t, body, sent, "signing must not consume the body")
+
+	// Bodies without GetBody are buffered and restored.
+	req, _ = http.NewRequest(http.MethodPost, "https://example.com/path", io.NopCloser(strings.NewReader("hello")))
+	_, err = as.Authenticate(req)
+	assert.NoError(t, err)
+	sent, _ = io.ReadAll(req.Body)
+	assert.Equal(t, "hello", string(sent))
+}
+
+func Test_VerifyCallback(t *testing.T) {
+	secret := base64.StdEncoding.EncodeToString([]byte("secret"))
+	body := []byte(`{"event":"ice"}`)
+
+	// A request signed by ApplicationSigned is a valid callback.
+	req, _ := http.NewRequest(http.MethodPost, "https://example.com/callbacks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	_, err := NewApplicationSigned("key", secret).Authenticate(req)
+	assert.NoError(t, err)
+	assert.True(t, VerifyCallback("key", secret, req, body))
+	assert.False(t, VerifyCallback("other", secret, req, body))
+	assert.False(t, VerifyCallback("key", base64.StdEncoding.EncodeToString([]byte("wrong")), req, body))
+	assert.False(t, VerifyCallback("key", secret, req, []byte(`{"event":"ace"}`)))
+	assert.False(t, VerifyCallback("", "", req, body))
+
+	// Callbacks without x-timestamp sign the Date header.
+	req, _ = http.NewRequest(http.MethodPost, "https://example.com/callbacks", bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Date", "Wed, 04 Jun 2014 13:41:58 GMT")
+	assert.Equal(t, "POST\n"+ContentMD5(body)+"\napplication/json\nWed, 04 Jun 2014 13:41:58 GMT\n/callbacks", CallbackStringToSign(req, body))
+	signature, _ := Sign(secret, CallbackStringToSign(req, body))
+	req.Header.Set("Authorization", "Application key:"+signature)
+	assert.True(t, VerifyCallback("key", secret, req, body))
+
+	req.Header.Set("Authorization", "Basic key:"+signature)
+	assert.False(t, VerifyCallback("key", secret, req, body))
+}
@@ -0,0 +1,121 @@
+package auth
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// BearerToken authenticates requests with a static bearer token, like the API token of an SMS service plan. The token
+// can be rotated at runtime with Rotate.
+type BearerToken struct {
+	mu    sync.RWMutex
+	token string
+}
+
+// NewBearerToken returns an authenticator for the given token.
+func NewBearerToken(token string) *BearerToken {
+	return &BearerToken{token: token}
+}
+
+// Rotate replaces the token used by future requests. It is safe to call while requests are being authenticated.
+func (bt *BearerToken) Rotate(token string) {
+	bt.mu.Lock()
+	defer bt.mu.Unlock()
+	bt.token = token
+}
+
+func (bt *BearerToken) Validate() error {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	if bt.token == "" {
+		return TokenRequiredError
+	}
+	return nil
+}
+
+func (bt *BearerToken) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	bt.mu.RLock()
+	defer bt.mu.RUnlock()
+	httpReq.Header.Set("Authorization", "Bearer "+bt.token)
+	return httpReq, nil
+}
+
+// BasicAuth authenticates requests with HTTP basic auth, like the access key ID and secret of a project. The
+// credentials can be rotated at runtime with Rotate.
+type BasicAuth struct {
+	mu       sync.RWMutex
+	username string
+	password string
+}
+
+// NewBasicAuth returns an authenticator for the given username and password.
+func NewBasicAuth(username, password string) *BasicAuth {
+	return &BasicAuth{username: username, password: password}
+}
+
+// Rotate replaces the credentials used by future requests. It is safe to call while requests are being authenticated.
+func (ba *BasicAuth) Rotate(username, password string) {
+	ba.mu.Lock()
+	defer ba.mu.Unlock()
+	ba.username, ba.password = username, password
+}
+
+func (ba *BasicAuth) Validate() error {
+	ba.mu.RLock()
+	defer ba.mu.RUnlock()
+	if ba.username == "" {
+		return KeyIDRequiredError
+	}
+	if ba.password == "" {
+		return KeySecretRequiredError
+	}
+	return nil
+}
+
+func (ba *BasicAuth) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	ba.mu.RLock()
+	defer ba.mu.RUnlock()
+	httpReq.SetBasicAuth(ba.username, ba.password)
+	return httpReq, nil
+}
+
+// OAuth2 authenticates requests with bearer access tokens from a TokenSource.
+type OAuth2 struct {
+	TokenSource
+}
+
+// NewOAuth2 returns an authenticator that fetches access tokens from ts.
+func NewOAuth2(ts TokenSource) *OAuth2 {
+	return &OAuth2{TokenSource: ts}
+}
+
+func (o *OAuth2) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	if o.TokenSource == nil {
+		return nil, NilTokenSourceError
+	}
+	token, err := o.Token(httpReq.Context())
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return httpReq, nil
+}
+
+// AuthenticatorFunc adapts a function to the sinch.Authenticator interface, for example to read credentials from a
+// secrets manager on every request.
+type AuthenticatorFunc func(*http.Request) (*http.Request, error)
+
+func (f AuthenticatorFunc) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	return f(httpReq)
+}
+
+var (
+	_ sinch.Authenticator = new(BearerToken)
+	_ sinch.Authenticator = new(BasicAuth)
+	_ sinch.Authenticator = new(OAuth2)
+	_ sinch.Authenticator = new(ClientCredentials)
+	_ sinch.Authenticator = new(ApplicationSigned)
+	_ sinch.Authenticator = AuthenticatorFunc(nil)
+)
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type staticTokenSource string
+
+func (ts staticTokenSource) Token(ctx context.Context) (*Token, error) {
+	return &Token{AccessToken: string(ts)}, nil
+}
+
+func Test_BearerToken(t *testing.T) {
+	assert.ErrorIs(t, new(BearerToken).Validate(), TokenRequiredError)
+
+	bt := NewBearerToken("old")
+	assert.NoError(t, bt.Validate())
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, _ = bt.Authenticate(req)
+	assert.Equal(t, "Bearer old", req.Header.Get("Authorization"))
+
+	bt.Rotate("new")
+	_, _ = bt.Authenticate(req)
+	assert.Equal(t, "Bearer new", req.Header.Get("Authorization"))
+}
+
+func Test_BasicAuth(t *testing.T) {
+	assert.ErrorIs(t, NewBasicAuth("", "secret").Validate(), KeyIDRequiredError)
+	assert.ErrorIs(t, NewBasicAuth("key", "").Validate(), KeySecretRequiredError)
+
+	ba := NewBasicAuth("key", "secret")
+	ba.Rotate("key2", "secret2")
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, _ = ba.Authenticate(req)
+	username, password, ok := req.BasicAuth()
+	assert.True(t, ok)
+	assert.Equal(t, "key2", username)
+	assert.Equal(t, "secret2", password)
+}
+
+func Test_OAuth2(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, err := new(OAuth2).Authenticate(req)
+	assert.ErrorIs(t, err, NilTokenSourceError)
+
+	_, err = NewOAuth2(staticTokenSource("token")).Authenticate(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+}
+
+func Test_AuthenticatorFunc(t *testing.T) {
+	var called bool
+	f := AuthenticatorFunc(func(r *http.Request) (*http.Request, error) {
+		called = true
+		return r, nil
+	})
+	_, err := f.Authenticate(httptest.NewRequest(http.MethodGet, "/", nil))
+	assert.NoError(t, err)
+	assert.True(t, called)
+}
@@ -0,0 +1,14 @@
+package auth
+
+import "github.com/thezmc/go-sinch/pkg/sinch"
+
+const (
+	KeyIDRequiredError             = sinch.Error("key ID is required")
+	KeySecretRequiredError         = sinch.Error("key secret is required")
+	TokenRequiredError             = sinch.Error("token is required")
+	NoAccessTokenError             = sinch.Error("token response did not contain an access token")
+	NilTokenSourceError            = sinch.Error("token source cannot be nil")
+	ApplicationKeyRequiredError    = sinch.Error("application key is required")
+	ApplicationSecretRequiredError = sinch.Error("application secret is required")
+	InvalidApplicationSecretError  = sinch.Error("application secret must be base 64 encoded")
+)
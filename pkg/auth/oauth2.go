@@ -0,0 +1,210 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	TokenURL = "https://auth.sinch.com/oauth2/token"
+
+	// DefaultRefreshBefore is how long before its expiry a token is refreshed.
+	DefaultRefreshBefore = time.Minute
+)
+
+// Token is an OAuth2 access token.
+type Token struct {
+	AccessToken string    `json:"access_token"`
+	TokenType   string    `json:"token_type"`
+	ExpiresIn   int       `json:"expires_in"` // The lifetime of the token in seconds.
+	Scope       string    `json:"scope"`
+	Expiry      time.Time `json:"-"` // When the token expires, computed from ExpiresIn when the token is fetched.
+}
+
+// Valid returns true if the token is set and does not expire within margin.
+func (t *Token) Valid(margin time.Duration) bool {
+	return t != nil && t.AccessToken != "" && (t.Expiry.IsZero() || time.Now().Add(margin).Before(t.Expiry))
+}
+
+// TokenSource returns OAuth2 access tokens.
+type TokenSource interface {
+	Token(ctx context.Context) (*Token, error)
+}
+
+// ClientCredentials is a TokenSource that fetches access tokens for project-scoped APIs with the OAuth2 client
+// credentials grant, using a project access key ID and secret. Tokens are cached and refreshed shortly before they
+// expire. It is safe for concurrent use: when the token needs to be refreshed, a single request is made and concurrent
+// callers wait for its result.
+type ClientCredentials struct {
+	KeyID         string
+	KeySecret     string
+	TokenURL      string        // Defaults to TokenURL.
+	HTTPClient    *http.Client  // Defaults to http.DefaultClient.
+	RefreshBefore time.Duration // Defaults to DefaultRefreshBefore.
+
+	mu       sync.Mutex
+	token    *Token
+	inflight *tokenCall
+}
+
+type tokenCall struct {
+	done  chan struct{}
+	token *Token
+	err   error
+}
+
+func (cc *ClientCredentials) WithKeyID(keyID string) *ClientCredentials {
+	cc.KeyID = keyID
+	return cc
+}
+
+func (cc *ClientCredentials) WithKeySecret(keySecret string) *ClientCredentials {
+	cc.KeySecret = keySecret
+	return cc
+}
+
+func (cc *ClientCredentials) WithTokenURL(tokenURL string) *ClientCredentials {
+	cc.TokenURL = tokenURL
+	return cc
+}
+
+func (cc *ClientCredentials) WithHTTPClient(httpClient *http.Client) *ClientCredentials {
+	cc.HTTPClient = httpClient
+	return cc
+}
+
+// WithRefreshBefore sets how long before its expiry a token is refreshed.
+func (cc *ClientCredentials) WithRefreshBefore(d time.Duration) *ClientCredentials {
+	cc.RefreshBefore = d
+	return cc
+}
+
+func (cc *ClientCredentials) Validate() error {
+	if cc.KeyID == "" {
+		return KeyIDRequiredError
+	}
+	if cc.KeySecret == "" {
+		return KeySecretRequiredError
+	}
+	return nil
+}
+
+// Token returns the cached token, fetching a new one if it is missing or about to expire. Callers that arrive while a
+// fetch is in progress wait for it, and fetch again themselves if it failed only because the context of its caller
+// ended.
+func (cc *ClientCredentials) Token(ctx context.Context) (*Token, error) {
+	refreshBefore := cc.RefreshBefore
+	if refreshBefore == 0 {
+		refreshBefore = DefaultRefreshBefore
+	}
+
+	for {
+		cc.mu.Lock()
+		if cc.token.Valid(refreshBefore) {
+			token := cc.token
+			cc.mu.Unlock()
+			return token, nil
+		}
+		call := cc.inflight
+		if call == nil {
+			call = &tokenCall{done: make(chan struct{})}
+			cc.inflight = call
+			cc.mu.Unlock()
+			cc.call(ctx, call)
+			return call.token, call.err
+		}
+		cc.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if call.err == nil || !isContextError(call.err) || ctx.Err() != nil {
+			return call.token, call.err
+		}
+	}
+}
+
+func (cc *ClientCredentials) call(ctx context.Context, call *tokenCall) {
+	defer close(call.done)
+	call.token, call.err = cc.fetch(ctx)
+
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	if call.err == nil {
+		cc.token = call.token
+	}
+	cc.inflight = nil
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// Authenticate sets a bearer access token on the request.
+func (cc *ClientCredentials) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	token, err := cc.Token(httpReq.Context())
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Authorization", "Bearer "+token.AccessToken)
+	return httpReq, nil
+}
+
+func (cc *ClientCredentials) fetch(ctx context.Context) (*Token, error) {
+	if err := cc.Validate(); err != nil {
+		return nil, err
+	}
+	tokenURL := cc.TokenURL
+	if tokenURL == "" {
+		tokenURL = TokenURL
+	}
+	httpClient := cc.HTTPClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	form := url.Values{"grant_type": {"client_credentials"}}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.SetBasicAuth(cc.KeyID, cc.KeySecret)
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	httpResp, err := httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, sinch.UnexpectedStatusCodeErr(http.StatusOK, httpResp.StatusCode)
+	}
+
+	body, err := io.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+	token := new(Token)
+	if err := json.Unmarshal(body, token); err != nil {
+		return nil, err
+	}
+	if token.AccessToken == "" {
+		return nil, NoAccessTokenError
+	}
+	if token.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return token, nil
+}
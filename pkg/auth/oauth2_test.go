@@ -0,0 +1,141 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func newTokenServer(t *testing.T, fetches *int32, expiresIn int, status int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(fetches, 1)
+		keyID, keySecret, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "key", keyID)
+		assert.Equal(t, "secret", keySecret)
+		assert.NoError(t, r.ParseForm())
+		assert.Equal(t, "client_credentials", r.PostForm.Get("grant_type"))
+		time.Sleep(10 * time.Millisecond)
+		w.WriteHeader(status)
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "bearer", "expires_in": %d}`, n, expiresIn)
+	}))
+}
+
+func Test_ClientCredentials_Token(t *testing.T) {
+	var fetches int32
+	srv := newTokenServer(t, &fetches, 3600, http.StatusOK)
+	defer srv.Close()
+
+	cc := new(ClientCredentials).WithKeyID("key").WithKeySecret("secret").WithTokenURL(srv.URL).WithHTTPClient(srv.Client())
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			token, err := cc.Token(context.Background())
+			assert.NoError(t, err)
+			assert.Equal(t, "token-1", token.AccessToken)
+		}()
+	}
+	wg.Wait()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, err := cc.Authenticate(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token-1", req.Header.Get("Authorization"))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+}
+
+func Test_ClientCredentials_Refresh(t *testing.T) {
+	var fetches int32
+	srv := newTokenServer(t, &fetches, 30, http.StatusOK)
+	defer srv.Close()
+
+	cc := new(ClientCredentials).WithKeyID("key").WithKeySecret("secret").WithTokenURL(srv.URL).WithHTTPClient(srv.Client())
+
+	// Tokens expiring within DefaultRefreshBefore are refreshed on every call.
+	token, err := cc.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-1", token.AccessToken)
+	token, err = cc.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+
+	cc.WithRefreshBefore(time.Second)
+	token, err = cc.Token(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "token-2", token.AccessToken)
+}
+
+func Test_ClientCredentials_Errors(t *testing.T) {
+	var fetches int32
+	srv := newTokenServer(t, &fetches, 3600, http.StatusUnauthorized)
+	defer srv.Close()
+
+	_, err := new(ClientCredentials).WithKeyID("key").WithKeySecret("secret").WithTokenURL(srv.URL).Token(context.Background())
+	assert.ErrorIs(t, err, sinch.UnexpectedStatusCodeError)
+
+	_, err = new(ClientCredentials).WithKeySecret("secret").Token(context.Background())
+	assert.ErrorIs(t, err, KeyIDRequiredError)
+
+	_, err = new(ClientCredentials).WithKeyID("key").Token(context.Background())
+	assert.ErrorIs(t, err, KeySecretRequiredError)
+}
+
+func Test_ClientCredentials_LeaderCancelled(t *testing.T) {
+	var fetches int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&fetches, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "expires_in": 3600}`, n)
+	}))
+	defer srv.Close()
+	cc := new(ClientCredentials).WithKeyID("key").WithKeySecret("secret").WithTokenURL(srv.URL)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, err := cc.Token(leaderCtx)
+		leaderErr <- err
+	}()
+	for atomic.LoadInt32(&fetches) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	tokens := make([]*Token, 5)
+	errs := make([]error, 5)
+	for i := range tokens {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			tokens[i], errs[i] = cc.Token(context.Background())
+		}(i)
+	}
+
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	close(release)
+	wg.Wait()
+	for i := range tokens {
+		if assert.NoError(t, errs[i], "waiters retry when only the leader's context was cancelled") {
+			assert.Equal(t, "token-2", tokens[i].AccessToken)
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
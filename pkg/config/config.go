@@ -0,0 +1,322 @@
+// Package config builds Sinch API clients from environment variables or profiles in a YAML or JSON file.
+//
+// A configuration file holds named profiles:
+//
+//	profiles:
+//	  default:
+//	    projectId: YOUR_PROJECT_ID
+//	    keyId: YOUR_KEY_ID
+//	    keySecret: YOUR_KEY_SECRET
+//	    smsServicePlanId: YOUR_PLAN_ID
+//	    smsApiToken: YOUR_API_TOKEN
+//	    smsRegion: eu
+//
+// Environment variables override the values of the selected profile.
+package config
+
+import (
+	"encoding/json"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/conversation"
+	"github.com/thezmc/go-sinch/pkg/fax"
+	"github.com/thezmc/go-sinch/pkg/lookup"
+	"github.com/thezmc/go-sinch/pkg/numbers"
+	"github.com/thezmc/go-sinch/pkg/siptrunking"
+	"github.com/thezmc/go-sinch/pkg/sms"
+	"github.com/thezmc/go-sinch/pkg/tendlc"
+	"github.com/thezmc/go-sinch/pkg/verification"
+	"github.com/thezmc/go-sinch/pkg/voice"
+	"gopkg.in/yaml.v3"
+)
+
+// Environment variables read by Load and FromEnv.
+const (
+	EnvConfigFile         = "SINCH_CONFIG_FILE"
+	EnvProfile            = "SINCH_PROFILE"
+	EnvProjectID          = "SINCH_PROJECT_ID"
+	EnvKeyID              = "SINCH_KEY_ID"
+	EnvKeySecret          = "SINCH_KEY_SECRET"
+	EnvSMSServicePlanID   = "SINCH_SMS_SERVICE_PLAN_ID"
+	EnvSMSAPIToken        = "SINCH_SMS_API_TOKEN"
+	EnvSMSRegion          = "SINCH_SMS_REGION"
+	EnvSMSBaseURL         = "SINCH_SMS_BASE_URL"
+	EnvNumbersBaseURL     = "SINCH_NUMBERS_BASE_URL"
+	EnvConversationRegion = "SINCH_CONVERSATION_REGION"
+	EnvApplicationKey     = "SINCH_APPLICATION_KEY"
+	EnvApplicationSecret  = "SINCH_APPLICATION_SECRET"
+)
+
+const DefaultProfile = "default"
+
+// Profile holds the credentials and endpoints of one Sinch account.
+type Profile struct {
+	ProjectID          string `json:"projectId" yaml:"projectId"`
+	KeyID              string `json:"keyId" yaml:"keyId"`
+	KeySecret          string `json:"keySecret" yaml:"keySecret"`
+	SMSServicePlanID   string `json:"smsServicePlanId" yaml:"smsServicePlanId"`
+	SMSAPIToken        string `json:"smsApiToken" yaml:"smsApiToken"`
+	SMSRegion          string `json:"smsRegion" yaml:"smsRegion"`   // One of us, eu, au, br or ca. Defaults to us.
+	SMSBaseURL         string `json:"smsBaseUrl" yaml:"smsBaseUrl"` // Overrides the base URL of SMSRegion.
+	NumbersBaseURL     string `json:"numbersBaseUrl" yaml:"numbersBaseUrl"`
+	ConversationRegion string `json:"conversationRegion" yaml:"conversationRegion"` // One of us, eu or br. Defaults to us.
+	ApplicationKey     string `json:"applicationKey" yaml:"applicationKey"`
+	ApplicationSecret  string `json:"applicationSecret" yaml:"applicationSecret"`
+
+	HTTPClient *http.Client `json:"-" yaml:"-"` // The HTTP client used by the API clients. Defaults to api.DefaultHTTPClient.
+}
+
+type file struct {
+	Profiles map[string]*Profile `json:"profiles" yaml:"profiles"`
+}
+
+// DefaultConfigFile returns the path of the configuration file used when SINCH_CONFIG_FILE is not set.
+func DefaultConfigFile() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".sinch", "config.yaml")
+}
+
+// Load returns the named profile, or the profile named by SINCH_PROFILE if name is empty, from the configuration file
+// named by SINCH_CONFIG_FILE or DefaultConfigFile, with the environment variables applied on top. A missing default
+// configuration file is not an error, so the configuration can come from the environment alone.
+func Load(name string) (*Profile, error) {
+	if name == "" {
+		name = os.Getenv(EnvProfile)
+	}
+	path, explicit := os.LookupEnv(EnvConfigFile)
+	if !explicit {
+		path = DefaultConfigFile()
+	}
+
+	profile := new(Profile)
+	if path != "" {
+		p, err := LoadFile(path, name)
+		switch {
+		case err == nil:
+			profile = p
+		case !explicit && os.IsNotExist(err):
+		default:
+			return nil, err
+		}
+	}
+	profile.ApplyEnv(os.LookupEnv)
+	return profile, profile.Validate()
+}
+
+// FromEnv returns a profile read from the environment variables only.
+func FromEnv() (*Profile, error) {
+	profile := new(Profile)
+	profile.ApplyEnv(os.LookupEnv)
+	return profile, profile.Validate()
+}
+
+// LoadFile returns the named profile, or the default profile if name is empty, from a YAML or JSON configuration file.
+// The format is chosen by the file extension: .json files are JSON, everything else is YAML.
+func LoadFile(path string, name string) (*Profile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var f file
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		err = json.Unmarshal(data, &f)
+	case ".yaml", ".yml", "":
+		err = yaml.Unmarshal(data, &f)
+	default:
+		return nil, UnsupportedFormatError
+	}
+	if err != nil {
+		return nil, err
+	}
+	if name == "" {
+		name = DefaultProfile
+	}
+	profile, ok := f.Profiles[name]
+	if !ok || profile == nil {
+		return nil, ProfileNotFoundError
+	}
+	return profile, nil
+}
+
+// ApplyEnv overrides the profile with the environment variables that are set, as returned by lookup.
+func (p *Profile) ApplyEnv(lookup func(string) (string, bool)) {
+	for env, field := range map[string]*string{
+		EnvProjectID:          &p.ProjectID,
+		EnvKeyID:              &p.KeyID,
+		EnvKeySecret:          &p.KeySecret,
+		EnvSMSServicePlanID:   &p.SMSServicePlanID,
+		EnvSMSAPIToken:        &p.SMSAPIToken,
+		EnvSMSRegion:          &p.SMSRegion,
+		EnvSMSBaseURL:         &p.SMSBaseURL,
+		EnvNumbersBaseURL:     &p.NumbersBaseURL,
+		EnvConversationRegion: &p.ConversationRegion,
+		EnvApplicationKey:     &p.ApplicationKey,
+		EnvApplicationSecret:  &p.ApplicationSecret,
+	} {
+		if value, ok := lookup(env); ok {
+			*field = value
+		}
+	}
+}
+
+// Validate checks that the profile holds usable credentials. Each client builder also validates the client it builds.
+func (p *Profile) Validate() error {
+	if _, err := p.smsBaseURL(); err != nil {
+		return err
+	}
+	hasPlan := p.SMSServicePlanID != "" && p.SMSAPIToken != ""
+	hasProject := p.ProjectID != "" && p.KeyID != "" && p.KeySecret != ""
+	if !hasPlan && !hasProject && p.ApplicationKey == "" {
+		return MissingCredentialsError
+	}
+	return nil
+}
+
+func (p *Profile) smsBaseURL() (string, error) {
+	if p.SMSBaseURL != "" {
+		return p.SMSBaseURL, nil
+	}
+	region := sms.Region(strings.ToLower(p.SMSRegion))
+	if region == "" {
+		region = sms.RegionUS
+	}
+	if region.IsValid() {
+		return region.BaseURL(), nil
+	}
+	return "", UnknownSMSRegionError
+}
+
+func (p *Profile) httpClient() *http.Client {
+	if p.HTTPClient != nil {
+		return p.HTTPClient
+	}
+	return api.DefaultHTTPClient
+}
+
+// APIClient returns a validated API client for the given base URL using the profile's HTTP client.
+func (p *Profile) APIClient(baseURL string) (*api.Client, error) {
+	return api.New(api.WithBaseURL(baseURL), api.WithHTTPClient(p.httpClient()))
+}
+
+// ClientCredentials returns an OAuth2 token source for the profile's project access key.
+func (p *Profile) ClientCredentials() *auth.ClientCredentials {
+	return new(auth.ClientCredentials).WithKeyID(p.KeyID).WithKeySecret(p.KeySecret).WithHTTPClient(p.httpClient())
+}
+
+// SMSClient returns a validated SMS client. It uses the service plan ID and API token if they are set, otherwise the
+// project-based endpoints with OAuth2 authentication.
+func (p *Profile) SMSClient() (*sms.Client, error) {
+	baseURL, err := p.smsBaseURL()
+	if err != nil {
+		return nil, err
+	}
+	apiClient, err := p.APIClient(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	opts := []sms.Option{sms.WithSinchAPI(apiClient)}
+	if p.SMSServicePlanID != "" || p.ProjectID == "" {
+		opts = append(opts, sms.WithPlanID(p.SMSServicePlanID), sms.WithAuthToken(p.SMSAPIToken))
+	} else {
+		credentials := p.ClientCredentials()
+		if err := credentials.Validate(); err != nil {
+			return nil, err
+		}
+		opts = append(opts, sms.WithProjectID(p.ProjectID), sms.WithTokenSource(credentials))
+	}
+	return sms.New(opts...)
+}
+
+// NumbersClient returns a validated Numbers client authenticated with the project's access key.
+func (p *Profile) NumbersClient() (*numbers.Client, error) {
+	baseURL := p.NumbersBaseURL
+	if baseURL == "" {
+		baseURL = numbers.BaseURLv1
+	}
+	apiClient, err := p.APIClient(baseURL)
+	if err != nil {
+		return nil, err
+	}
+	return numbers.New(
+		numbers.WithSinchAPI(apiClient),
+		numbers.WithProjectID(p.ProjectID),
+		numbers.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// ConversationClient returns a validated Conversation client for the profile's region, authenticated with the
+// project's access key.
+func (p *Profile) ConversationClient() (*conversation.Client, error) {
+	region := conversation.RegionUS
+	if p.ConversationRegion != "" {
+		region = conversation.Region(strings.ToLower(p.ConversationRegion))
+	}
+	return conversation.New(
+		conversation.WithRegion(region),
+		conversation.WithHTTPClient(p.httpClient()),
+		conversation.WithProjectID(p.ProjectID),
+		conversation.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// FaxClient returns a validated Fax client authenticated with the project's access key.
+func (p *Profile) FaxClient() (*fax.Client, error) {
+	return fax.New(
+		fax.WithHTTPClient(p.httpClient()),
+		fax.WithProjectID(p.ProjectID),
+		fax.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// LookupClient returns a validated Number Lookup client authenticated with the project's access key.
+func (p *Profile) LookupClient() (*lookup.Client, error) {
+	return lookup.New(
+		lookup.WithHTTPClient(p.httpClient()),
+		lookup.WithProjectID(p.ProjectID),
+		lookup.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// SIPTrunkingClient returns a validated Elastic SIP Trunking client authenticated with the project's access key.
+func (p *Profile) SIPTrunkingClient() (*siptrunking.Client, error) {
+	return siptrunking.New(
+		siptrunking.WithHTTPClient(p.httpClient()),
+		siptrunking.WithProjectID(p.ProjectID),
+		siptrunking.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// TenDLCClient returns a validated 10DLC registration client authenticated with the project's access key.
+func (p *Profile) TenDLCClient() (*tendlc.Client, error) {
+	return tendlc.New(
+		tendlc.WithHTTPClient(p.httpClient()),
+		tendlc.WithProjectID(p.ProjectID),
+		tendlc.WithKey(p.KeyID, p.KeySecret),
+	)
+}
+
+// VerificationClient returns a validated Verification client that signs requests with the profile's application key
+// and secret.
+func (p *Profile) VerificationClient() (*verification.Client, error) {
+	return verification.New(
+		verification.WithApplication(p.ApplicationKey, p.ApplicationSecret),
+		verification.WithHTTPClient(p.httpClient()),
+	)
+}
+
+// VoiceClient returns a validated Voice client that signs requests with the profile's application key and secret.
+func (p *Profile) VoiceClient() (*voice.Client, error) {
+	return voice.New(
+		voice.WithApplication(p.ApplicationKey, p.ApplicationSecret),
+		voice.WithHTTPClient(p.httpClient()),
+	)
+}
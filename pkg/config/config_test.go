@@ -0,0 +1,261 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/conversation"
+	"github.com/thezmc/go-sinch/pkg/fax"
+	"github.com/thezmc/go-sinch/pkg/lookup"
+	"github.com/thezmc/go-sinch/pkg/numbers"
+	"github.com/thezmc/go-sinch/pkg/siptrunking"
+	"github.com/thezmc/go-sinch/pkg/sms"
+	"github.com/thezmc/go-sinch/pkg/tendlc"
+	"github.com/thezmc/go-sinch/pkg/verification"
+	"github.com/thezmc/go-sinch/pkg/voice"
+)
+
+const yamlConfig = `
+profiles:
+  default:
+    projectId: project
+    keyId: key
+    keySecret: secret
+    smsServicePlanId: plan
+    smsApiToken: token
+  staging:
+    projectId: staging-project
+    keyId: staging-key
+    keySecret: staging-secret
+    smsRegion: eu
+`
+
+const jsonConfig = `{"profiles": {"default": {"smsServicePlanId": "plan", "smsApiToken": "token", "smsRegion": "au"}}}`
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func Test_LoadFile(t *testing.T) {
+	yamlPath := writeFile(t, "config.yaml", yamlConfig)
+	jsonPath := writeFile(t, "config.json", jsonConfig)
+	tomlPath := writeFile(t, "config.toml", "")
+
+	tests := map[string]struct {
+		path    string
+		profile string
+		want    *Profile
+		wantErr error
+	}{
+		"yaml default": {
+			path: yamlPath,
+			want: &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret", SMSServicePlanID: "plan", SMSAPIToken: "token"},
+		},
+		"yaml named": {
+			path:    yamlPath,
+			profile: "staging",
+			want:    &Profile{ProjectID: "staging-project", KeyID: "staging-key", KeySecret: "staging-secret", SMSRegion: "eu"},
+		},
+		"json default": {
+			path: jsonPath,
+			want: &Profile{SMSServicePlanID: "plan", SMSAPIToken: "token", SMSRegion: "au"},
+		},
+		"missing profile": {
+			path:    yamlPath,
+			profile: "production",
+			wantErr: ProfileNotFoundError,
+		},
+		"unsupported format": {
+			path:    tomlPath,
+			wantErr: UnsupportedFormatError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := LoadFile(tt.path, tt.profile)
+			assert.ErrorIs(t, err, tt.wantErr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func Test_Load(t *testing.T) {
+	path := writeFile(t, "config.yaml", yamlConfig)
+
+	t.Run("environment overrides file", func(t *testing.T) {
+		t.Setenv(EnvConfigFile, path)
+		t.Setenv(EnvProfile, "staging")
+		t.Setenv(EnvKeySecret, "env-secret")
+		got, err := Load("")
+		assert.NoError(t, err)
+		assert.Equal(t, "staging-project", got.ProjectID)
+		assert.Equal(t, "env-secret", got.KeySecret)
+	})
+
+	t.Run("explicit file must exist", func(t *testing.T) {
+		t.Setenv(EnvConfigFile, filepath.Join(t.TempDir(), "missing.yaml"))
+		_, err := Load("")
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("environment only", func(t *testing.T) {
+		t.Setenv("HOME", t.TempDir())
+		t.Setenv(EnvSMSServicePlanID, "plan")
+		t.Setenv(EnvSMSAPIToken, "token")
+		got, err := Load("")
+		assert.NoError(t, err)
+		assert.Equal(t, &Profile{SMSServicePlanID: "plan", SMSAPIToken: "token"}, got)
+	})
+}
+
+func Test_Profile_Validate(t *testing.T) {
+	tests := map[string]struct {
+		profile Profile
+		wantErr error
+	}{
+		"plan credentials":    {profile: Profile{SMSServicePlanID: "plan", SMSAPIToken: "token"}},
+		"project credentials": {profile: Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}},
+		"no credentials":      {profile: Profile{ProjectID: "project"}, wantErr: MissingCredentialsError},
+		"unknown region": {
+			profile: Profile{SMSServicePlanID: "plan", SMSAPIToken: "token", SMSRegion: "mars"},
+			wantErr: UnknownSMSRegionError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.profile.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func Test_Profile_SMSClient(t *testing.T) {
+	t.Run("service plan", func(t *testing.T) {
+		p := &Profile{SMSServicePlanID: "plan", SMSAPIToken: "token", SMSRegion: "eu"}
+		client, err := p.SMSClient()
+		assert.NoError(t, err)
+		assert.Equal(t, sms.EUBaseURLv1, client.API().BaseURL)
+		assert.Equal(t, "plan", client.PlanID)
+		assert.Equal(t, "token", client.AuthToken)
+	})
+
+	t.Run("project with oauth2", func(t *testing.T) {
+		p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+		client, err := p.SMSClient()
+		assert.NoError(t, err)
+		assert.Equal(t, "project", client.ProjectID)
+		assert.Equal(t, sms.USProjectBaseURLv1+"/project", client.URL())
+		assert.IsType(t, &auth.OAuth2{}, client.Authenticator)
+	})
+
+	t.Run("missing token", func(t *testing.T) {
+		p := &Profile{SMSServicePlanID: "plan"}
+		_, err := p.SMSClient()
+		assert.ErrorIs(t, err, sms.NoAuthTokenError)
+	})
+}
+
+func Test_Profile_NumbersClient(t *testing.T) {
+	t.Run("valid", func(t *testing.T) {
+		p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+		client, err := p.NumbersClient()
+		assert.NoError(t, err)
+		assert.Equal(t, numbers.BaseURLv1, client.API().BaseURL)
+		assert.Equal(t, "project", client.ProjectID)
+	})
+
+	t.Run("custom base url", func(t *testing.T) {
+		p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret", NumbersBaseURL: "http://localhost"}
+		client, err := p.NumbersClient()
+		assert.NoError(t, err)
+		assert.Equal(t, "http://localhost", client.API().BaseURL)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		p := &Profile{ProjectID: "project"}
+		_, err := p.NumbersClient()
+		assert.Error(t, err)
+	})
+}
+
+func Test_Profile_ConversationClient(t *testing.T) {
+	p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+	client, err := p.ConversationClient()
+	assert.NoError(t, err)
+	assert.Equal(t, conversation.USBaseURLv1+"/project", client.URL())
+
+	p.ConversationRegion = "EU"
+	client, err = p.ConversationClient()
+	assert.NoError(t, err)
+	assert.Equal(t, conversation.RegionEU, client.Region)
+	assert.Equal(t, conversation.EUBaseURLv1+"/project", client.URL())
+
+	p.ConversationRegion = "au"
+	_, err = p.ConversationClient()
+	assert.ErrorIs(t, err, conversation.InvalidRegionError)
+}
+
+func Test_Profile_FaxClient(t *testing.T) {
+	p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+	client, err := p.FaxClient()
+	assert.NoError(t, err)
+	assert.Equal(t, fax.BaseURLv3+"/project", client.URL())
+
+	_, err = new(Profile).FaxClient()
+	assert.ErrorIs(t, err, fax.ProjectIDRequiredError)
+}
+
+func Test_Profile_LookupClient(t *testing.T) {
+	p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+	client, err := p.LookupClient()
+	assert.NoError(t, err)
+	assert.Equal(t, lookup.BaseURLv2+"/project", client.URL())
+
+	_, err = new(Profile).LookupClient()
+	assert.ErrorIs(t, err, lookup.ProjectIDRequiredError)
+}
+
+func Test_Profile_SIPTrunkingClient(t *testing.T) {
+	p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+	client, err := p.SIPTrunkingClient()
+	assert.NoError(t, err)
+	assert.Equal(t, siptrunking.BaseURLv1+"/project", client.URL())
+
+	_, err = new(Profile).SIPTrunkingClient()
+	assert.ErrorIs(t, err, siptrunking.ProjectIDRequiredError)
+}
+
+func Test_Profile_TenDLCClient(t *testing.T) {
+	p := &Profile{ProjectID: "project", KeyID: "key", KeySecret: "secret"}
+	client, err := p.TenDLCClient()
+	assert.NoError(t, err)
+	assert.Equal(t, tendlc.BaseURLv1+"/project", client.URL())
+
+	_, err = new(Profile).TenDLCClient()
+	assert.ErrorIs(t, err, tendlc.ProjectIDRequiredError)
+}
+
+func Test_Profile_VerificationClient(t *testing.T) {
+	p := &Profile{ApplicationKey: "key", ApplicationSecret: "c2VjcmV0"}
+	client, err := p.VerificationClient()
+	assert.NoError(t, err)
+	assert.Equal(t, verification.BaseURLv1, client.URL())
+
+	_, err = new(Profile).VerificationClient()
+	assert.ErrorIs(t, err, verification.ApplicationKeyRequiredError)
+}
+
+func Test_Profile_VoiceClient(t *testing.T) {
+	p := &Profile{ApplicationKey: "key", ApplicationSecret: "c2VjcmV0"}
+	client, err := p.VoiceClient()
+	assert.NoError(t, err)
+	assert.Equal(t, voice.BaseURLv1, client.URL())
+}
@@ -0,0 +1,10 @@
+package config
+
+import "github.com/thezmc/go-sinch/pkg/sinch"
+
+const (
+	ProfileNotFoundError    = sinch.Error("profile not found in configuration file")
+	UnsupportedFormatError  = sinch.Error("configuration file must be YAML or JSON")
+	UnknownSMSRegionError   = sinch.Error("SMS region must be one of us, eu, au, br or ca")
+	MissingCredentialsError = sinch.Error("either an SMS service plan ID and API token or a project ID, key ID and key secret are required")
+)
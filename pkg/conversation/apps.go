@@ -0,0 +1,376 @@
+package conversation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+// App is a set of channel credentials that messages are sent and received with. Apps are created in one region and can
+// only be used with a client for that region.
+type App struct {
+	ID                 string               `json:"id,omitempty"`
+	DisplayName        string               `json:"display_name,omitempty"`
+	ChannelCredentials []ChannelCredentials `json:"channel_credentials,omitempty"`
+	MetadataReportView string               `json:"conversation_metadata_report_view,omitempty"` // NONE or FULL.
+	RetentionPolicy    *RetentionPolicy     `json:"retention_policy,omitempty"`
+	ProcessingMode     string               `json:"processing_mode,omitempty"` // CONVERSATION or DISPATCH.
+}
+
+// RetentionPolicy sets how long the messages of an app's conversations are kept.
+type RetentionPolicy struct {
+	RetentionType string `json:"retention_type"` // MESSAGE_EXPIRE_POLICY, CONVERSATION_EXPIRE_POLICY or PERSIST_RETENTION_POLICY.
+	TTLDays       int    `json:"ttl_days,omitempty"`
+}
+
+// ChannelCredentials are the credentials of an app on one channel. Exactly one credential type must be set, see
+// StaticBearerCredentials and StaticTokenCredentials.
+type ChannelCredentials struct {
+	Channel        Channel          `json:"channel"`
+	StaticBearer   *StaticBearer    `json:"static_bearer,omitempty"`
+	StaticToken    *StaticToken     `json:"static_token,omitempty"`
+	MMSCredentials *MMSCredentials  `json:"mms_credentials,omitempty"`
+	CallbackSecret string           `json:"callback_secret,omitempty"` // Verifies callbacks from channels that sign them.
+	State          *CredentialState `json:"state,omitempty"`           // Set by the API.
+}
+
+// CredentialState is the status of channel credentials, e.g. ACTIVE or FAILING.
+type CredentialState struct {
+	Status      string `json:"status"`
+	Description string `json:"description,omitempty"`
+}
+
+// StaticBearer holds the credentials of channels authenticated with a claimed identity and a bearer token, like the
+// service plan ID and API token of SMS or the sender ID and token of WhatsApp.
+type StaticBearer struct {
+	ClaimedIdentity string `json:"claimed_identity"`
+	Token           string `json:"token"`
+}
+
+// StaticToken holds the credentials of channels authenticated with a token only, like Messenger, Viber or Telegram.
+type StaticToken struct {
+	Token string `json:"token"`
+}
+
+type MMSCredentials struct {
+	AccountID string    `json:"account_id"`
+	APIKey    string    `json:"api_key"`
+	BasicAuth BasicAuth `json:"basic_auth"`
+}
+
+type BasicAuth struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+// StaticBearerCredentials returns bearer token credentials for a channel, e.g. the service plan ID and API token for
+// ChannelSMS.
+func StaticBearerCredentials(channel Channel, claimedIdentity, token string) ChannelCredentials {
+	return ChannelCredentials{Channel: channel, StaticBearer: &StaticBearer{ClaimedIdentity: claimedIdentity, Token: token}}
+}
+
+// StaticTokenCredentials returns token credentials for a channel.
+func StaticTokenCredentials(channel Channel, token string) ChannelCredentials {
+	return ChannelCredentials{Channel: channel, StaticToken: &StaticToken{Token: token}}
+}
+
+func (a *App) WithID(id string) *App {
+	a.ID = id
+	return a
+}
+
+func (a *App) WithDisplayName(displayName string) *App {
+	a.DisplayName = displayName
+	return a
+}
+
+// WithChannelCredentials adds the credentials of channels.
+func (a *App) WithChannelCredentials(credentials ...ChannelCredentials) *App {
+	a.ChannelCredentials = append(a.ChannelCredentials, credentials...)
+	return a
+}
+
+func (a *App) WithRetentionPolicy(retentionType string, ttlDays int) *App {
+	a.RetentionPolicy = &RetentionPolicy{RetentionType: retentionType, TTLDays: ttlDays}
+	return a
+}
+
+func (a *App) WithProcessingMode(mode string) *App {
+	a.ProcessingMode = mode
+	return a
+}
+
+func (a *App) validateCredentials() error {
+	for _, credentials := range a.ChannelCredentials {
+		if !credentials.Channel.IsValid() {
+			return InvalidCredentialsError
+		}
+		if count(credentials.StaticBearer != nil, credentials.StaticToken != nil, credentials.MMSCredentials != nil) != 1 {
+			return InvalidCredentialsError
+		}
+	}
+	return nil
+}
+
+func (a *App) FromJSON(data []byte) error {
+	return json.Unmarshal(data, a)
+}
+
+type CreateAppAction struct {
+	request  *CreateAppRequest
+	response *App
+}
+
+func (caa *CreateAppAction) Request() *CreateAppRequest {
+	return caa.request
+}
+
+func (caa *CreateAppAction) Response() *App {
+	return caa.response
+}
+
+// CreateAppRequest creates an app. The response is the created app.
+type CreateAppRequest struct {
+	App *App
+}
+
+func (car *CreateAppRequest) WithApp(app *App) *CreateAppRequest {
+	car.App = app
+	return car
+}
+
+func (car *CreateAppRequest) Validate() error {
+	if car.App == nil {
+		return AppRequiredError
+	}
+	if car.App.DisplayName == "" {
+		return DisplayNameRequiredError
+	}
+	return car.App.validateCredentials()
+}
+
+func (car *CreateAppRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (car *CreateAppRequest) Method() string {
+	return http.MethodPost
+}
+
+func (car *CreateAppRequest) Path() string {
+	return "/apps"
+}
+
+func (car *CreateAppRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (car *CreateAppRequest) Body() ([]byte, error) {
+	return json.Marshal(car.App)
+}
+
+type GetAppAction struct {
+	request  *GetAppRequest
+	response *App
+}
+
+func (gaa *GetAppAction) Request() *GetAppRequest {
+	return gaa.request
+}
+
+func (gaa *GetAppAction) Response() *App {
+	return gaa.response
+}
+
+// GetAppRequest fetches an app.
+type GetAppRequest struct {
+	AppID string
+}
+
+func (gar *GetAppRequest) WithAppID(appID string) *GetAppRequest {
+	gar.AppID = appID
+	return gar
+}
+
+func (gar *GetAppRequest) Validate() error {
+	if gar.AppID == "" {
+		return AppIDRequiredError
+	}
+	return nil
+}
+
+func (gar *GetAppRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gar *GetAppRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gar *GetAppRequest) Path() string {
+	return "/apps/" + url.PathEscape(gar.AppID)
+}
+
+func (gar *GetAppRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gar *GetAppRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListAppsAction struct {
+	request  *ListAppsRequest
+	response *ListAppsResponse
+}
+
+func (laa *ListAppsAction) Request() *ListAppsRequest {
+	return laa.request
+}
+
+func (laa *ListAppsAction) Response() *ListAppsResponse {
+	return laa.response
+}
+
+// ListAppsRequest lists the apps of the project. The API returns all apps in one page, so the request only implements
+// sinch.ListRequest to be used with the same pager as the other lists.
+type ListAppsRequest struct{}
+
+type ListAppsResponse struct {
+	Apps []App `json:"apps"`
+}
+
+func (lar *ListAppsRequest) SetPageToken(string) {}
+
+func (lar *ListAppsRequest) Validate() error {
+	return nil
+}
+
+func (lar *ListAppsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lar *ListAppsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lar *ListAppsRequest) Path() string {
+	return "/apps"
+}
+
+func (lar *ListAppsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (lar *ListAppsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lar *ListAppsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lar)
+}
+
+func (lar *ListAppsResponse) Items() []App {
+	return lar.Apps
+}
+
+func (lar *ListAppsResponse) NextPageToken() string {
+	return ""
+}
+
+type UpdateAppAction struct {
+	request  *UpdateAppRequest
+	response *App
+}
+
+func (uaa *UpdateAppAction) Request() *UpdateAppRequest {
+	return uaa.request
+}
+
+func (uaa *UpdateAppAction) Response() *App {
+	return uaa.response
+}
+
+// UpdateAppRequest updates the app with the ID of App. Only the fields named by UpdateMask are updated, or all the
+// fields set in App if it is empty. Channel credentials replace the existing ones. The response is the updated app.
+type UpdateAppRequest struct {
+	App        *App     `url:"-"`
+	UpdateMask []string `url:"update_mask.paths,omitempty"` // JSON field names, e.g. channel_credentials.
+}
+
+func (uar *UpdateAppRequest) WithApp(app *App) *UpdateAppRequest {
+	uar.App = app
+	return uar
+}
+
+func (uar *UpdateAppRequest) WithUpdateMask(paths ...string) *UpdateAppRequest {
+	uar.UpdateMask = paths
+	return uar
+}
+
+func (uar *UpdateAppRequest) Validate() error {
+	if uar.App == nil {
+		return AppRequiredError
+	}
+	if uar.App.ID == "" {
+		return AppIDRequiredError
+	}
+	return uar.App.validateCredentials()
+}
+
+func (uar *UpdateAppRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (uar *UpdateAppRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (uar *UpdateAppRequest) Path() string {
+	return "/apps/" + url.PathEscape(uar.App.ID)
+}
+
+func (uar *UpdateAppRequest) QueryString() (string, error) {
+	return queryString(uar)
+}
+
+func (uar *UpdateAppRequest) Body() ([]byte, error) {
+	return json.Marshal(uar.App)
+}
+
+// DeleteAppRequest deletes an app. The response has no content, use sinch.NoContent.
+type DeleteAppRequest struct {
+	AppID string
+}
+
+func (dar *DeleteAppRequest) WithAppID(appID string) *DeleteAppRequest {
+	dar.AppID = appID
+	return dar
+}
+
+func (dar *DeleteAppRequest) Validate() error {
+	if dar.AppID == "" {
+		return AppIDRequiredError
+	}
+	return nil
+}
+
+func (dar *DeleteAppRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (dar *DeleteAppRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dar *DeleteAppRequest) Path() string {
+	return "/apps/" + url.PathEscape(dar.AppID)
+}
+
+func (dar *DeleteAppRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dar *DeleteAppRequest) Body() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,100 @@
+package conversation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Apps_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateAppRequest, *App] = new(CreateAppAction)
+	var _ sinch.Action[*GetAppRequest, *App] = new(GetAppAction)
+	var _ sinch.Action[*ListAppsRequest, *ListAppsResponse] = new(ListAppsAction)
+	var _ sinch.Action[*UpdateAppRequest, *App] = new(UpdateAppAction)
+	var _ sinch.ListRequest = new(ListAppsRequest)
+	var _ sinch.ListResponse[App] = new(ListAppsResponse)
+	var _ sinch.APIRequest = new(DeleteAppRequest)
+}
+
+func Test_AppRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		"create without display name": {
+			req:     new(CreateAppRequest).WithApp(new(App)),
+			wantErr: DisplayNameRequiredError,
+		},
+		"create with unknown channel": {
+			req:     new(CreateAppRequest).WithApp(new(App).WithDisplayName("App").WithChannelCredentials(StaticTokenCredentials("PIGEON", "token"))),
+			wantErr: InvalidCredentialsError,
+		},
+		"create without credential type": {
+			req:     new(CreateAppRequest).WithApp(new(App).WithDisplayName("App").WithChannelCredentials(ChannelCredentials{Channel: ChannelSMS})),
+			wantErr: InvalidCredentialsError,
+		},
+		"create": {
+			req: new(CreateAppRequest).WithApp(new(App).
+				WithDisplayName("App").
+				WithChannelCredentials(StaticBearerCredentials(ChannelSMS, "plan", "token"), StaticTokenCredentials(ChannelTelegram, "bot-token")).
+				WithRetentionPolicy("MESSAGE_EXPIRE_POLICY", 30)),
+			wantMethod: http.MethodPost,
+			wantPath:   "/apps",
+			wantBody: `{"display_name":"App","channel_credentials":[{"channel":"SMS","static_bearer":{"claimed_identity":"plan","token":"token"}},
+				{"channel":"TELEGRAM","static_token":{"token":"bot-token"}}],"retention_policy":{"retention_type":"MESSAGE_EXPIRE_POLICY","ttl_days":30}}`,
+		},
+		"get without id": {
+			req:     new(GetAppRequest),
+			wantErr: AppIDRequiredError,
+		},
+		"get": {
+			req:        new(GetAppRequest).WithAppID("app"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/apps/app",
+		},
+		"list": {
+			req:        new(ListAppsRequest),
+			wantMethod: http.MethodGet,
+			wantPath:   "/apps",
+		},
+		"update": {
+			req:        new(UpdateAppRequest).WithApp(new(App).WithID("app").WithDisplayName("Renamed")).WithUpdateMask("display_name"),
+			wantMethod: http.MethodPatch,
+			wantPath:   "/apps/app",
+			wantQuery:  "?update_mask.paths=display_name",
+			wantBody:   `{"id":"app","display_name":"Renamed"}`,
+		},
+		"delete": {
+			req:        new(DeleteAppRequest).WithAppID("app"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/apps/app",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Empty(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+// Package conversation is a client for the Sinch Conversation API, which sends and receives messages over SMS,
+// WhatsApp, RCS, Messenger and other channels with one API.
+package conversation
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Client struct {
+	api.ProjectClient
+	Region Region // The region requests are sent to. Empty if a custom base URL is used.
+}
+
+// Region is a region the Conversation API is hosted in. Apps are created in one region and can only be used there.
+type Region string
+
+const (
+	RegionUS Region = "us"
+	RegionEU Region = "eu"
+	RegionBR Region = "br"
+)
+
+const (
+	BaseURLv1   = ".conversation.api.sinch.com/v1/projects"
+	USBaseURLv1 = "https://us" + BaseURLv1
+	EUBaseURLv1 = "https://eu" + BaseURLv1
+	BRBaseURLv1 = "https://br" + BaseURLv1
+)
+
+// IsValid reports whether r is a known region.
+func (r Region) IsValid() bool {
+	switch r {
+	case RegionUS, RegionEU, RegionBR:
+		return true
+	}
+	return false
+}
+
+// BaseURL returns the base URL of the region, e.g. EUBaseURLv1.
+func (r Region) BaseURL() string {
+	return "https://" + string(r) + BaseURLv1
+}
+
+// WithRegion makes the client send requests to region r.
+func (c *Client) WithRegion(r Region) *Client {
+	c.Region = r
+	api.OwnAPI(c).BaseURL = r.BaseURL()
+	return c
+}
+
+// US is a shortcut for WithRegion(RegionUS).
+func (c *Client) US() *Client {
+	return c.WithRegion(RegionUS)
+}
+
+// EU is a shortcut for WithRegion(RegionEU).
+func (c *Client) EU() *Client {
+	return c.WithRegion(RegionEU)
+}
+
+// BR is a shortcut for WithRegion(RegionBR).
+func (c *Client) BR() *Client {
+	return c.WithRegion(RegionBR)
+}
+
+// WithSinchAPI makes the client send requests with sinchAPI, which other clients may share. The region is cleared, as
+// the base URL of sinchAPI may be a custom one.
+func (c *Client) WithSinchAPI(sinchAPI *api.Client) *Client {
+	c.ShareAPI(sinchAPI)
+	c.Region = ""
+	return c
+}
+
+func (c *Client) Validate() error {
+	if c.Region != "" && !c.Region.IsValid() {
+		return InvalidRegionError
+	}
+	return c.ProjectClient.Validate()
+}
+
+func (c *Client) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.DoContext(context.Background(), req, resp)
+}
+
+func (c *Client) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	return c.API().DoContext(ctx, c, req, resp)
+}
+
+// Send sends a message, see SendMessageRequest.
+func (c *Client) Send(ctx context.Context, req *SendMessageRequest) (*SendMessageResponse, error) {
+	resp := new(SendMessageResponse)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListContacts returns a pager over the contacts that match the request.
+func (c *Client) ListContacts(req *ListContactsRequest) *api.Pager[Contact] {
+	return api.NewPager[Contact](c, req, func() sinch.ListResponse[Contact] {
+		return new(ListContactsResponse)
+	})
+}
+
+// ListConversations returns a pager over the conversations that match the request.
+func (c *Client) ListConversations(req *ListConversationsRequest) *api.Pager[Conversation] {
+	return api.NewPager[Conversation](c, req, func() sinch.ListResponse[Conversation] {
+		return new(ListConversationsResponse)
+	})
+}
+
+// ListMessages returns a pager over the messages that match the request.
+func (c *Client) ListMessages(req *ListMessagesRequest) *api.Pager[Message] {
+	return api.NewPager[Message](c, req, func() sinch.ListResponse[Message] {
+		return new(ListMessagesResponse)
+	})
+}
+
+// ListApps returns a pager over the apps of the project.
+func (c *Client) ListApps() *api.Pager[App] {
+	return api.NewPager[App](c, new(ListAppsRequest), func() sinch.ListResponse[App] {
+		return new(ListAppsResponse)
+	})
+}
@@ -0,0 +1,134 @@
+package conversation
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing project": {opts: []Option{WithKey("key", "secret")}, wantErr: ProjectIDRequiredError},
+		"missing key id":  {opts: []Option{WithProjectID("project"), WithKey("", "secret")}, wantErr: KeyIDRequiredError},
+		"missing secret":  {opts: []Option{WithProjectID("project"), WithKey("key", "")}, wantErr: KeySecretRequiredError},
+		"invalid region":  {opts: []Option{WithProjectID("project"), WithKey("key", "secret"), WithRegion("au")}, wantErr: InvalidRegionError},
+		"basic auth":      {opts: []Option{WithProjectID("project"), WithKey("key", "secret"), WithRegion(RegionEU)}},
+		"authenticator":   {opts: []Option{WithProjectID("project"), WithAuthenticator(auth.NewBearerToken("token"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_Client_Regions(t *testing.T) {
+	c := new(Client)
+	c.WithProjectID("project")
+	assert.Equal(t, "/project", c.URL())
+	assert.Equal(t, USBaseURLv1+"/project", c.US().URL())
+	assert.Equal(t, EUBaseURLv1+"/project", c.EU().URL())
+	assert.Equal(t, BRBaseURLv1+"/project", c.BR().URL())
+	assert.Equal(t, RegionBR, c.Region)
+
+	shared := &api.Client{BaseURL: "http://localhost", HTTPClient: http.DefaultClient}
+	c.WithSinchAPI(shared).EU()
+	assert.Equal(t, "http://localhost", shared.BaseURL)
+	assert.Equal(t, http.DefaultClient, c.API().HTTPClient)
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, RegionUS, c.Region)
+	assert.Equal(t, USBaseURLv1+"/project", c.URL())
+
+	c, err = New(WithProjectID("project"), WithKey("key", "secret"), WithHTTPClient(http.DefaultClient), WithRegion(RegionEU))
+	assert.NoError(t, err)
+	assert.Equal(t, EUBaseURLv1+"/project", c.URL())
+	assert.Equal(t, http.DefaultClient, c.API().HTTPClient)
+
+	c, err = New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL("http://localhost"))
+	assert.NoError(t, err)
+	assert.Equal(t, Region(""), c.Region)
+
+	_, err = New(WithKey("key", "secret"))
+	assert.ErrorIs(t, err, ProjectIDRequiredError)
+}
+
+func Test_Client_Send(t *testing.T) {
+	var gotPath string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.Method + " " + r.URL.EscapedPath()
+		body, _ := io.ReadAll(r.Body)
+		gotBody = nil
+		_ = json.Unmarshal(body, &gotBody)
+		if user, pass, _ := r.BasicAuth(); user != "key" || pass != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		io.WriteString(w, `{"accepted_time": "2023-04-21T14:45:51.123Z", "message_id": "01GYJ4FKGH0TEHA0K7QFR6KZYW"}`)
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	req := new(SendMessageRequest).
+		WithAppID("app").
+		ToIdentities(ChannelIdentity{Channel: ChannelWhatsApp, Identity: "46700000000"}, ChannelIdentity{Channel: ChannelSMS, Identity: "46700000000"}).
+		WithChannelPriority(ChannelWhatsApp, ChannelSMS).
+		WithMessage(TextMessage("Hello"))
+	resp, err := c.Send(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, "POST /project/messages:send", gotPath)
+	assert.Equal(t, "app", gotBody["app_id"])
+	assert.Equal(t, []any{"WHATSAPP", "SMS"}, gotBody["channel_priority_order"])
+	assert.Equal(t, "01GYJ4FKGH0TEHA0K7QFR6KZYW", resp.MessageID)
+	assert.Equal(t, 2023, resp.AcceptedTime.Year())
+
+	c.WithKey("key", "wrong")
+	_, err = c.Send(context.Background(), req)
+	code, _ := api.StatusCode(err)
+	assert.Equal(t, http.StatusUnauthorized, code)
+}
+
+func Test_Client_ListContacts(t *testing.T) {
+	var gotQueries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotQueries = append(gotQueries, r.URL.RawQuery)
+		if r.URL.Query().Get("page_token") == "" {
+			io.WriteString(w, `{"contacts": [{"id": "1"}, {"id": "2"}], "next_page_token": "next", "total_size": 3}`)
+			return
+		}
+		io.WriteString(w, `{"contacts": [{"id": "3"}], "next_page_token": "", "total_size": 3}`)
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	contacts, err := c.ListContacts(new(ListContactsRequest).WithPageSize(2)).All(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, contacts, 3)
+	assert.Equal(t, "3", contacts[2].ID)
+	assert.Equal(t, []string{"page_size=2", "page_size=2&page_token=next"}, gotQueries)
+}
@@ -0,0 +1,445 @@
+package conversation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+)
+
+// Contact is a person or organization that messages are exchanged with, reachable on one or more channel identities.
+type Contact struct {
+	ID                string            `json:"id,omitempty"`
+	ChannelIdentities []ChannelIdentity `json:"channel_identities,omitempty"`
+	ChannelPriority   []Channel         `json:"channel_priority,omitempty"` // The channels used when sending to the contact, in order of preference.
+	DisplayName       string            `json:"display_name,omitempty"`
+	Email             string            `json:"email,omitempty"`
+	ExternalID        string            `json:"external_id,omitempty"` // An ID of the contact in an external system, like a CRM.
+	Metadata          string            `json:"metadata,omitempty"`
+	Language          string            `json:"language,omitempty"` // A BCP-47 language code, e.g. EN_US.
+}
+
+func (c *Contact) WithID(id string) *Contact {
+	c.ID = id
+	return c
+}
+
+// WithChannelIdentity adds the identity of the contact on a channel.
+func (c *Contact) WithChannelIdentity(channel Channel, identity string) *Contact {
+	c.ChannelIdentities = append(c.ChannelIdentities, ChannelIdentity{Channel: channel, Identity: identity})
+	return c
+}
+
+func (c *Contact) WithChannelPriority(channels ...Channel) *Contact {
+	c.ChannelPriority = channels
+	return c
+}
+
+func (c *Contact) WithDisplayName(displayName string) *Contact {
+	c.DisplayName = displayName
+	return c
+}
+
+func (c *Contact) WithEmail(email string) *Contact {
+	c.Email = email
+	return c
+}
+
+func (c *Contact) WithExternalID(externalID string) *Contact {
+	c.ExternalID = externalID
+	return c
+}
+
+func (c *Contact) WithMetadata(metadata string) *Contact {
+	c.Metadata = metadata
+	return c
+}
+
+func (c *Contact) WithLanguage(language string) *Contact {
+	c.Language = language
+	return c
+}
+
+func (c *Contact) validateChannels() error {
+	for _, identity := range c.ChannelIdentities {
+		if !identity.Channel.IsValid() {
+			return InvalidChannelError
+		}
+	}
+	for _, channel := range c.ChannelPriority {
+		if !channel.IsValid() {
+			return InvalidChannelError
+		}
+	}
+	return nil
+}
+
+func (c *Contact) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+type CreateContactAction struct {
+	request  *CreateContactRequest
+	response *Contact
+}
+
+func (cca *CreateContactAction) Request() *CreateContactRequest {
+	return cca.request
+}
+
+func (cca *CreateContactAction) Response() *Contact {
+	return cca.response
+}
+
+// CreateContactRequest creates a contact. The response is the created contact.
+type CreateContactRequest struct {
+	Contact *Contact
+}
+
+func (ccr *CreateContactRequest) WithContact(contact *Contact) *CreateContactRequest {
+	ccr.Contact = contact
+	return ccr
+}
+
+func (ccr *CreateContactRequest) Validate() error {
+	if ccr.Contact == nil {
+		return ContactRequiredError
+	}
+	if len(ccr.Contact.ChannelIdentities) == 0 {
+		return ChannelIdentityRequiredError
+	}
+	return ccr.Contact.validateChannels()
+}
+
+func (ccr *CreateContactRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ccr *CreateContactRequest) Method() string {
+	return http.MethodPost
+}
+
+func (ccr *CreateContactRequest) Path() string {
+	return "/contacts"
+}
+
+func (ccr *CreateContactRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ccr *CreateContactRequest) Body() ([]byte, error) {
+	return json.Marshal(ccr.Contact)
+}
+
+type GetContactAction struct {
+	request  *GetContactRequest
+	response *Contact
+}
+
+func (gca *GetContactAction) Request() *GetContactRequest {
+	return gca.request
+}
+
+func (gca *GetContactAction) Response() *Contact {
+	return gca.response
+}
+
+// GetContactRequest fetches a contact.
+type GetContactRequest struct {
+	ContactID string
+}
+
+func (gcr *GetContactRequest) WithContactID(contactID string) *GetContactRequest {
+	gcr.ContactID = contactID
+	return gcr
+}
+
+func (gcr *GetContactRequest) Validate() error {
+	if gcr.ContactID == "" {
+		return ContactIDRequiredError
+	}
+	return nil
+}
+
+func (gcr *GetContactRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gcr *GetContactRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gcr *GetContactRequest) Path() string {
+	return "/contacts/" + url.PathEscape(gcr.ContactID)
+}
+
+func (gcr *GetContactRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gcr *GetContactRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListContactsAction struct {
+	request  *ListContactsRequest
+	response *ListContactsResponse
+}
+
+func (lca *ListContactsAction) Request() *ListContactsRequest {
+	return lca.request
+}
+
+func (lca *ListContactsAction) Response() *ListContactsResponse {
+	return lca.response
+}
+
+// ListContactsRequest lists the contacts of the project, optionally filtered by external ID or channel identity.
+type ListContactsRequest struct {
+	ExternalID string  `url:"external_id,omitempty"`
+	Channel    Channel `url:"channel,omitempty"`  // Filters by channel identity together with Identity.
+	Identity   string  `url:"identity,omitempty"` // Filters by channel identity together with Channel.
+	PageSize   int     `url:"page_size,omitempty"`
+	PageToken  string  `url:"page_token,omitempty"`
+}
+
+type ListContactsResponse struct {
+	Contacts  []Contact `json:"contacts"`
+	NextPage  string    `json:"next_page_token"`
+	TotalSize int       `json:"total_size"`
+}
+
+func (lcr *ListContactsRequest) WithExternalID(externalID string) *ListContactsRequest {
+	lcr.ExternalID = externalID
+	return lcr
+}
+
+func (lcr *ListContactsRequest) WithChannelIdentity(channel Channel, identity string) *ListContactsRequest {
+	lcr.Channel = channel
+	lcr.Identity = identity
+	return lcr
+}
+
+func (lcr *ListContactsRequest) WithPageSize(pageSize int) *ListContactsRequest {
+	lcr.PageSize = pageSize
+	return lcr
+}
+
+func (lcr *ListContactsRequest) SetPageToken(token string) {
+	lcr.PageToken = token
+}
+
+func (lcr *ListContactsRequest) Validate() error {
+	if lcr.Channel != "" && !lcr.Channel.IsValid() {
+		return InvalidChannelError
+	}
+	return nil
+}
+
+func (lcr *ListContactsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lcr *ListContactsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lcr *ListContactsRequest) Path() string {
+	return "/contacts"
+}
+
+func (lcr *ListContactsRequest) QueryString() (string, error) {
+	return queryString(lcr)
+}
+
+func (lcr *ListContactsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lcr *ListContactsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lcr)
+}
+
+func (lcr *ListContactsResponse) Items() []Contact {
+	return lcr.Contacts
+}
+
+func (lcr *ListContactsResponse) NextPageToken() string {
+	return lcr.NextPage
+}
+
+type UpdateContactAction struct {
+	request  *UpdateContactRequest
+	response *Contact
+}
+
+func (uca *UpdateContactAction) Request() *UpdateContactRequest {
+	return uca.request
+}
+
+func (uca *UpdateContactAction) Response() *Contact {
+	return uca.response
+}
+
+// UpdateContactRequest updates the contact with the ID of Contact. Only the fields named by UpdateMask are updated, or
+// all the fields set in Contact if it is empty. The response is the updated contact.
+type UpdateContactRequest struct {
+	Contact    *Contact `url:"-"`
+	UpdateMask []string `url:"update_mask.paths,omitempty"` // JSON field names, e.g. display_name.
+}
+
+func (ucr *UpdateContactRequest) WithContact(contact *Contact) *UpdateContactRequest {
+	ucr.Contact = contact
+	return ucr
+}
+
+func (ucr *UpdateContactRequest) WithUpdateMask(paths ...string) *UpdateContactRequest {
+	ucr.UpdateMask = paths
+	return ucr
+}
+
+func (ucr *UpdateContactRequest) Validate() error {
+	if ucr.Contact == nil {
+		return ContactRequiredError
+	}
+	if ucr.Contact.ID == "" {
+		return ContactIDRequiredError
+	}
+	return ucr.Contact.validateChannels()
+}
+
+func (ucr *UpdateContactRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ucr *UpdateContactRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (ucr *UpdateContactRequest) Path() string {
+	return "/contacts/" + url.PathEscape(ucr.Contact.ID)
+}
+
+func (ucr *UpdateContactRequest) QueryString() (string, error) {
+	return queryString(ucr)
+}
+
+func (ucr *UpdateContactRequest) Body() ([]byte, error) {
+	return json.Marshal(ucr.Contact)
+}
+
+// DeleteContactRequest deletes a contact. The response has no content, use sinch.NoContent.
+type DeleteContactRequest struct {
+	ContactID string
+}
+
+func (dcr *DeleteContactRequest) WithContactID(contactID string) *DeleteContactRequest {
+	dcr.ContactID = contactID
+	return dcr
+}
+
+func (dcr *DeleteContactRequest) Validate() error {
+	if dcr.ContactID == "" {
+		return ContactIDRequiredError
+	}
+	return nil
+}
+
+func (dcr *DeleteContactRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (dcr *DeleteContactRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dcr *DeleteContactRequest) Path() string {
+	return "/contacts/" + url.PathEscape(dcr.ContactID)
+}
+
+func (dcr *DeleteContactRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dcr *DeleteContactRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type MergeContactsAction struct {
+	request  *MergeContactsRequest
+	response *Contact
+}
+
+func (mca *MergeContactsAction) Request() *MergeContactsRequest {
+	return mca.request
+}
+
+func (mca *MergeContactsAction) Response() *Contact {
+	return mca.response
+}
+
+// MergeContactsRequest merges the source contact into the destination contact. The channel identities, conversations
+// and messages of the source contact are moved to the destination contact and the source contact is deleted. The
+// response is the merged contact.
+type MergeContactsRequest struct {
+	DestinationID string `json:"-"`
+	SourceID      string `json:"source_id"`
+	Strategy      string `json:"strategy"`
+}
+
+func (mcr *MergeContactsRequest) Into(destinationID string) *MergeContactsRequest {
+	mcr.DestinationID = destinationID
+	return mcr
+}
+
+func (mcr *MergeContactsRequest) From(sourceID string) *MergeContactsRequest {
+	mcr.SourceID = sourceID
+	return mcr
+}
+
+func (mcr *MergeContactsRequest) Validate() error {
+	if mcr.DestinationID == "" {
+		return ContactIDRequiredError
+	}
+	if mcr.SourceID == "" {
+		return SourceIDRequiredError
+	}
+	return nil
+}
+
+func (mcr *MergeContactsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (mcr *MergeContactsRequest) Method() string {
+	return http.MethodPost
+}
+
+func (mcr *MergeContactsRequest) Path() string {
+	return "/contacts/" + url.PathEscape(mcr.DestinationID) + ":merge"
+}
+
+func (mcr *MergeContactsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (mcr *MergeContactsRequest) Body() ([]byte, error) {
+	strategy := mcr.Strategy
+	if strategy == "" {
+		strategy = "MERGE" // The only strategy supported by the API.
+	}
+	return json.Marshal(MergeContactsRequest{SourceID: mcr.SourceID, Strategy: strategy})
+}
+
+// queryString encodes v with its url struct tags, returning an empty string if no parameters are set.
+func queryString(v interface{}) (string, error) {
+	values, err := query.Values(v)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
@@ -0,0 +1,118 @@
+package conversation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Contacts_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateContactRequest, *Contact] = new(CreateContactAction)
+	var _ sinch.Action[*GetContactRequest, *Contact] = new(GetContactAction)
+	var _ sinch.Action[*ListContactsRequest, *ListContactsResponse] = new(ListContactsAction)
+	var _ sinch.Action[*UpdateContactRequest, *Contact] = new(UpdateContactAction)
+	var _ sinch.Action[*MergeContactsRequest, *Contact] = new(MergeContactsAction)
+	var _ sinch.ListRequest = new(ListContactsRequest)
+	var _ sinch.ListResponse[Contact] = new(ListContactsResponse)
+	var _ sinch.APIRequest = new(DeleteContactRequest)
+}
+
+func Test_ContactRequests(t *testing.T) {
+	contact := new(Contact).WithDisplayName("Jane").WithChannelIdentity(ChannelSMS, "46700000000").WithExternalID("crm-1")
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		"create without contact": {
+			req:     new(CreateContactRequest),
+			wantErr: ContactRequiredError,
+		},
+		"create without identities": {
+			req:     new(CreateContactRequest).WithContact(new(Contact).WithDisplayName("Jane")),
+			wantErr: ChannelIdentityRequiredError,
+		},
+		"create with unknown channel": {
+			req:     new(CreateContactRequest).WithContact(new(Contact).WithChannelIdentity("PIGEON", "1")),
+			wantErr: InvalidChannelError,
+		},
+		"create": {
+			req:        new(CreateContactRequest).WithContact(contact),
+			wantMethod: http.MethodPost,
+			wantPath:   "/contacts",
+			wantBody:   `{"channel_identities":[{"channel":"SMS","identity":"46700000000"}],"display_name":"Jane","external_id":"crm-1"}`,
+		},
+		"get without id": {
+			req:     new(GetContactRequest),
+			wantErr: ContactIDRequiredError,
+		},
+		"get": {
+			req:        new(GetContactRequest).WithContactID("contact"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/contacts/contact",
+		},
+		"list": {
+			req:        new(ListContactsRequest).WithChannelIdentity(ChannelWhatsApp, "46700000000").WithPageSize(10),
+			wantMethod: http.MethodGet,
+			wantPath:   "/contacts",
+			wantQuery:  "?channel=WHATSAPP&identity=46700000000&page_size=10",
+		},
+		"list with unknown channel": {
+			req:     new(ListContactsRequest).WithChannelIdentity("PIGEON", "1"),
+			wantErr: InvalidChannelError,
+		},
+		"update without id": {
+			req:     new(UpdateContactRequest).WithContact(new(Contact).WithDisplayName("Jane")),
+			wantErr: ContactIDRequiredError,
+		},
+		"update": {
+			req:        new(UpdateContactRequest).WithContact(new(Contact).WithID("contact").WithDisplayName("Jane Doe")).WithUpdateMask("display_name"),
+			wantMethod: http.MethodPatch,
+			wantPath:   "/contacts/contact",
+			wantQuery:  "?update_mask.paths=display_name",
+			wantBody:   `{"id":"contact","display_name":"Jane Doe"}`,
+		},
+		"delete": {
+			req:        new(DeleteContactRequest).WithContactID("contact"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/contacts/contact",
+		},
+		"merge without source": {
+			req:     new(MergeContactsRequest).Into("destination"),
+			wantErr: SourceIDRequiredError,
+		},
+		"merge": {
+			req:        new(MergeContactsRequest).Into("destination").From("source"),
+			wantMethod: http.MethodPost,
+			wantPath:   "/contacts/destination:merge",
+			wantBody:   `{"source_id":"source","strategy":"MERGE"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, http.StatusOK, tt.req.ExpectedStatusCode())
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Empty(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package conversation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Conversation is a collection of messages exchanged between an app and a contact.
+type Conversation struct {
+	ID            string      `json:"id,omitempty"`
+	AppID         string      `json:"app_id,omitempty"`
+	ContactID     string      `json:"contact_id,omitempty"`
+	Active        bool        `json:"active,omitempty"`
+	ActiveChannel Channel     `json:"active_channel,omitempty"` // The channel of the last message.
+	LastReceived  *sinch.Time `json:"last_received,omitempty"`  // Set by the API.
+	Metadata      string      `json:"metadata,omitempty"`
+	CorrelationID string      `json:"correlation_id,omitempty"`
+}
+
+func (c *Conversation) WithID(id string) *Conversation {
+	c.ID = id
+	return c
+}
+
+func (c *Conversation) WithAppID(appID string) *Conversation {
+	c.AppID = appID
+	return c
+}
+
+func (c *Conversation) WithContactID(contactID string) *Conversation {
+	c.ContactID = contactID
+	return c
+}
+
+func (c *Conversation) WithActiveChannel(channel Channel) *Conversation {
+	c.ActiveChannel = channel
+	return c
+}
+
+func (c *Conversation) WithMetadata(metadata string) *Conversation {
+	c.Metadata = metadata
+	return c
+}
+
+func (c *Conversation) WithCorrelationID(correlationID string) *Conversation {
+	c.CorrelationID = correlationID
+	return c
+}
+
+func (c *Conversation) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+type CreateConversationAction struct {
+	request  *CreateConversationRequest
+	response *Conversation
+}
+
+func (cca *CreateConversationAction) Request() *CreateConversationRequest {
+	return cca.request
+}
+
+func (cca *CreateConversationAction) Response() *Conversation {
+	return cca.response
+}
+
+// CreateConversationRequest creates a conversation between an app and a contact. The response is the created
+// conversation.
+type CreateConversationRequest struct {
+	Conversation *Conversation
+}
+
+func (ccr *CreateConversationRequest) WithConversation(conversation *Conversation) *CreateConversationRequest {
+	ccr.Conversation = conversation
+	return ccr
+}
+
+func (ccr *CreateConversationRequest) Validate() error {
+	if ccr.Conversation == nil {
+		return ConversationRequiredError
+	}
+	if ccr.Conversation.AppID == "" {
+		return AppIDRequiredError
+	}
+	if ccr.Conversation.ContactID == "" {
+		return ContactIDRequiredError
+	}
+	if ccr.Conversation.ActiveChannel != "" && !ccr.Conversation.ActiveChannel.IsValid() {
+		return InvalidChannelError
+	}
+	return nil
+}
+
+func (ccr *CreateConversationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ccr *CreateConversationRequest) Method() string {
+	return http.MethodPost
+}
+
+func (ccr *CreateConversationRequest) Path() string {
+	return "/conversations"
+}
+
+func (ccr *CreateConversationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ccr *CreateConversationRequest) Body() ([]byte, error) {
+	return json.Marshal(ccr.Conversation)
+}
+
+type GetConversationAction struct {
+	request  *GetConversationRequest
+	response *Conversation
+}
+
+func (gca *GetConversationAction) Request() *GetConversationRequest {
+	return gca.request
+}
+
+func (gca *GetConversationAction) Response() *Conversation {
+	return gca.response
+}
+
+// GetConversationRequest fetches a conversation.
+type GetConversationRequest struct {
+	ConversationID string
+}
+
+func (gcr *GetConversationRequest) WithConversationID(conversationID string) *GetConversationRequest {
+	gcr.ConversationID = conversationID
+	return gcr
+}
+
+func (gcr *GetConversationRequest) Validate() error {
+	if gcr.ConversationID == "" {
+		return ConversationIDRequiredError
+	}
+	return nil
+}
+
+func (gcr *GetConversationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gcr *GetConversationRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gcr *GetConversationRequest) Path() string {
+	return "/conversations/" + url.PathEscape(gcr.ConversationID)
+}
+
+func (gcr *GetConversationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gcr *GetConversationRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListConversationsAction struct {
+	request  *ListConversationsRequest
+	response *ListConversationsResponse
+}
+
+func (lca *ListConversationsAction) Request() *ListConversationsRequest {
+	return lca.request
+}
+
+func (lca *ListConversationsAction) Response() *ListConversationsResponse {
+	return lca.response
+}
+
+// ListConversationsRequest lists the conversations of the project, optionally filtered by app, contact or channel.
+type ListConversationsRequest struct {
+	AppID         string  `url:"app_id,omitempty"`
+	ContactID     string  `url:"contact_id,omitempty"`
+	OnlyActive    bool    `url:"only_active"`
+	ActiveChannel Channel `url:"active_channel,omitempty"`
+	PageSize      int     `url:"page_size,omitempty"`
+	PageToken     string  `url:"page_token,omitempty"`
+}
+
+type ListConversationsResponse struct {
+	Conversations []Conversation `json:"conversations"`
+	NextPage      string         `json:"next_page_token"`
+	TotalSize     int            `json:"total_size"`
+}
+
+func (lcr *ListConversationsRequest) WithAppID(appID string) *ListConversationsRequest {
+	lcr.AppID = appID
+	return lcr
+}
+
+func (lcr *ListConversationsRequest) WithContactID(contactID string) *ListConversationsRequest {
+	lcr.ContactID = contactID
+	return lcr
+}
+
+// OnlyActiveConversations lists only the conversations that have not been stopped.
+func (lcr *ListConversationsRequest) OnlyActiveConversations() *ListConversationsRequest {
+	lcr.OnlyActive = true
+	return lcr
+}
+
+func (lcr *ListConversationsRequest) WithActiveChannel(channel Channel) *ListConversationsRequest {
+	lcr.ActiveChannel = channel
+	return lcr
+}
+
+func (lcr *ListConversationsRequest) WithPageSize(pageSize int) *ListConversationsRequest {
+	lcr.PageSize = pageSize
+	return lcr
+}
+
+func (lcr *ListConversationsRequest) SetPageToken(token string) {
+	lcr.PageToken = token
+}
+
+func (lcr *ListConversationsRequest) Validate() error {
+	if lcr.ActiveChannel != "" && !lcr.ActiveChannel.IsValid() {
+		return InvalidChannelError
+	}
+	return nil
+}
+
+func (lcr *ListConversationsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lcr *ListConversationsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lcr *ListConversationsRequest) Path() string {
+	return "/conversations"
+}
+
+func (lcr *ListConversationsRequest) QueryString() (string, error) {
+	return queryString(lcr)
+}
+
+func (lcr *ListConversationsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lcr *ListConversationsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lcr)
+}
+
+func (lcr *ListConversationsResponse) Items() []Conversation {
+	return lcr.Conversations
+}
+
+func (lcr *ListConversationsResponse) NextPageToken() string {
+	return lcr.NextPage
+}
+
+type UpdateConversationAction struct {
+	request  *UpdateConversationRequest
+	response *Conversation
+}
+
+func (uca *UpdateConversationAction) Request() *UpdateConversationRequest {
+	return uca.request
+}
+
+func (uca *UpdateConversationAction) Response() *Conversation {
+	return uca.response
+}
+
+// UpdateConversationRequest updates the conversation with the ID of Conversation. Only the fields named by UpdateMask
+// are updated, or all the fields set in Conversation if it is empty. The response is the updated conversation.
+type UpdateConversationRequest struct {
+	Conversation *Conversation `url:"-"`
+	UpdateMask   []string      `url:"update_mask.paths,omitempty"` // JSON field names, e.g. metadata.
+}
+
+func (ucr *UpdateConversationRequest) WithConversation(conversation *Conversation) *UpdateConversationRequest {
+	ucr.Conversation = conversation
+	return ucr
+}
+
+func (ucr *UpdateConversationRequest) WithUpdateMask(paths ...string) *UpdateConversationRequest {
+	ucr.UpdateMask = paths
+	return ucr
+}
+
+func (ucr *UpdateConversationRequest) Validate() error {
+	if ucr.Conversation == nil {
+		return ConversationRequiredError
+	}
+	if ucr.Conversation.ID == "" {
+		return ConversationIDRequiredError
+	}
+	if ucr.Conversation.ActiveChannel != "" && !ucr.Conversation.ActiveChannel.IsValid() {
+		return InvalidChannelError
+	}
+	return nil
+}
+
+func (ucr *UpdateConversationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ucr *UpdateConversationRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (ucr *UpdateConversationRequest) Path() string {
+	return "/conversations/" + url.PathEscape(ucr.Conversation.ID)
+}
+
+func (ucr *UpdateConversationRequest) QueryString() (string, error) {
+	return queryString(ucr)
+}
+
+func (ucr *UpdateConversationRequest) Body() ([]byte, error) {
+	return json.Marshal(ucr.Conversation)
+}
+
+// DeleteConversationRequest deletes a conversation and its messages. The response has no content, use
+// sinch.NoContent.
+type DeleteConversationRequest struct {
+	ConversationID string
+}
+
+func (dcr *DeleteConversationRequest) WithConversationID(conversationID string) *DeleteConversationRequest {
+	dcr.ConversationID = conversationID
+	return dcr
+}
+
+func (dcr *DeleteConversationRequest) Validate() error {
+	if dcr.ConversationID == "" {
+		return ConversationIDRequiredError
+	}
+	return nil
+}
+
+func (dcr *DeleteConversationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (dcr *DeleteConversationRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dcr *DeleteConversationRequest) Path() string {
+	return "/conversations/" + url.PathEscape(dcr.ConversationID)
+}
+
+func (dcr *DeleteConversationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dcr *DeleteConversationRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+// StopConversationRequest stops an active conversation. New messages between the app and the contact start a new
+// conversation. The response has no content, use sinch.NoContent.
+type StopConversationRequest struct {
+	ConversationID string
+}
+
+func (scr *StopConversationRequest) WithConversationID(conversationID string) *StopConversationRequest {
+	scr.ConversationID = conversationID
+	return scr
+}
+
+func (scr *StopConversationRequest) Validate() error {
+	if scr.ConversationID == "" {
+		return ConversationIDRequiredError
+	}
+	return nil
+}
+
+func (scr *StopConversationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (scr *StopConversationRequest) Method() string {
+	return http.MethodPost
+}
+
+func (scr *StopConversationRequest) Path() string {
+	return "/conversations/" + url.PathEscape(scr.ConversationID) + ":stop"
+}
+
+func (scr *StopConversationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (scr *StopConversationRequest) Body() ([]byte, error) {
+	return nil, nil
+}
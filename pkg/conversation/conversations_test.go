@@ -0,0 +1,111 @@
+package conversation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Conversations_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateConversationRequest, *Conversation] = new(CreateConversationAction)
+	var _ sinch.Action[*GetConversationRequest, *Conversation] = new(GetConversationAction)
+	var _ sinch.Action[*ListConversationsRequest, *ListConversationsResponse] = new(ListConversationsAction)
+	var _ sinch.Action[*UpdateConversationRequest, *Conversation] = new(UpdateConversationAction)
+	var _ sinch.ListRequest = new(ListConversationsRequest)
+	var _ sinch.ListResponse[Conversation] = new(ListConversationsResponse)
+	var _ sinch.APIRequest = new(DeleteConversationRequest)
+	var _ sinch.APIRequest = new(StopConversationRequest)
+}
+
+func Test_ConversationRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		"create without app": {
+			req:     new(CreateConversationRequest).WithConversation(new(Conversation).WithContactID("contact")),
+			wantErr: AppIDRequiredError,
+		},
+		"create without contact": {
+			req:     new(CreateConversationRequest).WithConversation(new(Conversation).WithAppID("app")),
+			wantErr: ContactIDRequiredError,
+		},
+		"create": {
+			req:        new(CreateConversationRequest).WithConversation(new(Conversation).WithAppID("app").WithContactID("contact").WithActiveChannel(ChannelSMS)),
+			wantMethod: http.MethodPost,
+			wantPath:   "/conversations",
+			wantBody:   `{"app_id":"app","contact_id":"contact","active_channel":"SMS"}`,
+		},
+		"get": {
+			req:        new(GetConversationRequest).WithConversationID("conversation"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/conversations/conversation",
+		},
+		"list": {
+			req:        new(ListConversationsRequest).WithAppID("app").OnlyActiveConversations(),
+			wantMethod: http.MethodGet,
+			wantPath:   "/conversations",
+			wantQuery:  "?app_id=app&only_active=true",
+		},
+		"update without conversation": {
+			req:     new(UpdateConversationRequest),
+			wantErr: ConversationRequiredError,
+		},
+		"update": {
+			req:        new(UpdateConversationRequest).WithConversation(new(Conversation).WithID("conversation").WithMetadata("vip")).WithUpdateMask("metadata"),
+			wantMethod: http.MethodPatch,
+			wantPath:   "/conversations/conversation",
+			wantQuery:  "?update_mask.paths=metadata",
+			wantBody:   `{"id":"conversation","metadata":"vip"}`,
+		},
+		"delete without id": {
+			req:     new(DeleteConversationRequest),
+			wantErr: ConversationIDRequiredError,
+		},
+		"delete": {
+			req:        new(DeleteConversationRequest).WithConversationID("conversation"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/conversations/conversation",
+		},
+		"stop": {
+			req:        new(StopConversationRequest).WithConversationID("conversation"),
+			wantMethod: http.MethodPost,
+			wantPath:   "/conversations/conversation:stop",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Empty(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func Test_Conversation_FromJSON(t *testing.T) {
+	c := new(Conversation)
+	assert.NoError(t, c.FromJSON([]byte(`{"id": "conversation", "app_id": "app", "contact_id": "contact", "active": true, "active_channel": "WHATSAPP", "last_received": "2023-04-21T14:45:51Z"}`)))
+	assert.True(t, c.Active)
+	assert.Equal(t, ChannelWhatsApp, c.ActiveChannel)
+	assert.Equal(t, 2023, c.LastReceived.Year())
+}
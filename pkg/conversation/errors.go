@@ -0,0 +1,31 @@
+package conversation
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ProjectIDRequiredError         = api.ProjectIDRequiredError
+	KeyIDRequiredError             = api.KeyIDRequiredError
+	KeySecretRequiredError         = api.KeySecretRequiredError
+	InvalidRegionError             = sinch.Error("region must be one of us, eu or br")
+	AppIDRequiredError             = sinch.Error("app ID is required")
+	RecipientRequiredError         = sinch.Error("exactly one of a contact ID or channel identities is required")
+	MessageRequiredError           = sinch.Error("a message is required")
+	InvalidMessageError            = sinch.Error("exactly one message type must be set")
+	InvalidChannelError            = sinch.Error("unknown channel")
+	InvalidChoiceError             = sinch.Error("exactly one choice type must be set")
+	InvalidProcessingStrategyError = sinch.Error("processing strategy must be DEFAULT or DISPATCH_ONLY")
+	ContactIDRequiredError         = sinch.Error("contact ID is required")
+	ChannelIdentityRequiredError   = sinch.Error("at least one channel identity is required")
+	ContactRequiredError           = sinch.Error("a contact is required")
+	SourceIDRequiredError          = sinch.Error("source contact ID is required")
+	ConversationIDRequiredError    = sinch.Error("conversation ID is required")
+	ConversationRequiredError      = sinch.Error("a conversation is required")
+	MessageIDRequiredError         = sinch.Error("message ID is required")
+	InvalidMessagesSourceError     = sinch.Error("messages source must be CONVERSATION_SOURCE or DISPATCH_SOURCE")
+	AppRequiredError               = sinch.Error("an app is required")
+	DisplayNameRequiredError       = sinch.Error("display name is required")
+	InvalidCredentialsError        = sinch.Error("channel credentials need a known channel and exactly one credential type")
+)
@@ -0,0 +1,216 @@
+package conversation
+
+// AppMessage is a message sent by an app. Exactly one message type must be set, see the constructors TextMessage,
+// MediaMessage, CardMessage, CarouselMessage, ChoiceMessage, LocationMessage and TemplateMessage. Channels that do not
+// support a type natively get a transcoded version of it, e.g. a text with links on SMS.
+type AppMessage struct {
+	TextMessage            *Text              `json:"text_message,omitempty"`
+	MediaMessage           *Media             `json:"media_message,omitempty"`
+	CardMessage            *Card              `json:"card_message,omitempty"`
+	CarouselMessage        *Carousel          `json:"carousel_message,omitempty"`
+	ChoiceMessage          *Choices           `json:"choice_message,omitempty"`
+	LocationMessage        *Location          `json:"location_message,omitempty"`
+	TemplateMessage        *Template          `json:"template_message,omitempty"`
+	ExplicitChannelMessage map[Channel]string `json:"explicit_channel_message,omitempty"` // Channel specific payloads overriding the transcoded message.
+}
+
+type Text struct {
+	Text string `json:"text"`
+}
+
+type Media struct {
+	URL              string `json:"url"`
+	ThumbnailURL     string `json:"thumbnail_url,omitempty"`
+	FilenameOverride string `json:"filename_override,omitempty"`
+}
+
+type Card struct {
+	Title        string   `json:"title,omitempty"`
+	Description  string   `json:"description,omitempty"`
+	MediaMessage *Media   `json:"media_message,omitempty"`
+	Height       string   `json:"height,omitempty"` // One of SHORT, MEDIUM or TALL.
+	Choices      []Choice `json:"choices,omitempty"`
+}
+
+type Carousel struct {
+	Cards   []Card   `json:"cards"`
+	Choices []Choice `json:"choices,omitempty"`
+}
+
+type Choices struct {
+	TextMessage *Text    `json:"text_message,omitempty"`
+	Choices     []Choice `json:"choices"`
+}
+
+type Coordinates struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type Location struct {
+	Coordinates Coordinates `json:"coordinates"`
+	Title       string      `json:"title"`
+	Label       string      `json:"label,omitempty"`
+}
+
+// Template is a message from a template, either an omni-channel template stored in the Template Management API or a
+// channel specific template such as an approved WhatsApp template.
+type Template struct {
+	OmniTemplate    *TemplateReference             `json:"omni_template,omitempty"`
+	ChannelTemplate map[Channel]*TemplateReference `json:"channel_template,omitempty"`
+}
+
+type TemplateReference struct {
+	TemplateID   string            `json:"template_id"`
+	Version      string            `json:"version"`
+	LanguageCode string            `json:"language_code,omitempty"`
+	Parameters   map[string]string `json:"parameters,omitempty"`
+}
+
+// Choice is an option the recipient can pick. Exactly one choice type must be set. The postback data is sent back in
+// the inbound message when the choice is picked.
+type Choice struct {
+	TextMessage     *Text     `json:"text_message,omitempty"`
+	CallMessage     *Call     `json:"call_message,omitempty"`
+	URLMessage      *URL      `json:"url_message,omitempty"`
+	LocationMessage *Location `json:"location_message,omitempty"`
+	PostbackData    string    `json:"postback_data,omitempty"`
+}
+
+type Call struct {
+	PhoneNumber string `json:"phone_number"`
+	Title       string `json:"title"`
+}
+
+type URL struct {
+	URL   string `json:"url"`
+	Title string `json:"title"`
+}
+
+// TextMessage returns a text message.
+func TextMessage(text string) *AppMessage {
+	return &AppMessage{TextMessage: &Text{Text: text}}
+}
+
+// MediaMessage returns a message with an image, video, audio or document.
+func MediaMessage(url string) *AppMessage {
+	return &AppMessage{MediaMessage: &Media{URL: url}}
+}
+
+// CardMessage returns a rich card message.
+func CardMessage(card Card) *AppMessage {
+	return &AppMessage{CardMessage: &card}
+}
+
+// CarouselMessage returns a message with several cards the recipient can scroll through.
+func CarouselMessage(cards ...Card) *AppMessage {
+	return &AppMessage{CarouselMessage: &Carousel{Cards: cards}}
+}
+
+// ChoiceMessage returns a text with choices.
+func ChoiceMessage(text string, choices ...Choice) *AppMessage {
+	return &AppMessage{ChoiceMessage: &Choices{TextMessage: &Text{Text: text}, Choices: choices}}
+}
+
+// LocationMessage returns a message with a location.
+func LocationMessage(latitude, longitude float64, title string) *AppMessage {
+	return &AppMessage{LocationMessage: &Location{Coordinates: Coordinates{Latitude: latitude, Longitude: longitude}, Title: title}}
+}
+
+// TemplateMessage returns a message from an omni-channel template.
+func TemplateMessage(templateID, version string, parameters map[string]string) *AppMessage {
+	return &AppMessage{TemplateMessage: &Template{OmniTemplate: &TemplateReference{TemplateID: templateID, Version: version, Parameters: parameters}}}
+}
+
+// TextChoice returns a choice that replies with text.
+func TextChoice(text, postbackData string) Choice {
+	return Choice{TextMessage: &Text{Text: text}, PostbackData: postbackData}
+}
+
+// CallChoice returns a choice that calls a phone number.
+func CallChoice(title, phoneNumber string) Choice {
+	return Choice{CallMessage: &Call{Title: title, PhoneNumber: phoneNumber}}
+}
+
+// URLChoice returns a choice that opens a URL.
+func URLChoice(title, url string) Choice {
+	return Choice{URLMessage: &URL{Title: title, URL: url}}
+}
+
+func (m *AppMessage) Validate() error {
+	if m == nil {
+		return MessageRequiredError
+	}
+	set := count(m.TextMessage != nil, m.MediaMessage != nil, m.CardMessage != nil, m.CarouselMessage != nil,
+		m.ChoiceMessage != nil, m.LocationMessage != nil, m.TemplateMessage != nil)
+	if set != 1 {
+		return InvalidMessageError
+	}
+	var choices []Choice
+	switch {
+	case m.CardMessage != nil:
+		choices = m.CardMessage.Choices
+	case m.CarouselMessage != nil:
+		choices = m.CarouselMessage.Choices
+		for _, card := range m.CarouselMessage.Cards {
+			choices = append(choices, card.Choices...)
+		}
+	case m.ChoiceMessage != nil:
+		choices = m.ChoiceMessage.Choices
+	}
+	for _, choice := range choices {
+		if count(choice.TextMessage != nil, choice.CallMessage != nil, choice.URLMessage != nil, choice.LocationMessage != nil) != 1 {
+			return InvalidChoiceError
+		}
+	}
+	return nil
+}
+
+func count(values ...bool) int {
+	n := 0
+	for _, v := range values {
+		if v {
+			n++
+		}
+	}
+	return n
+}
+
+// ContactMessage is a message sent by a contact. Exactly one message type is set.
+type ContactMessage struct {
+	TextMessage           *Text           `json:"text_message,omitempty"`
+	MediaMessage          *Media          `json:"media_message,omitempty"`
+	MediaCardMessage      *MediaCard      `json:"media_card_message,omitempty"`
+	LocationMessage       *Location       `json:"location_message,omitempty"`
+	ChoiceResponseMessage *ChoiceResponse `json:"choice_response_message,omitempty"`
+	FallbackMessage       *Fallback       `json:"fallback_message,omitempty"`
+	ReplyTo               *ReplyTo        `json:"reply_to,omitempty"` // The message the contact replied to, if any.
+}
+
+type MediaCard struct {
+	URL     string `json:"url"`
+	Caption string `json:"caption,omitempty"`
+}
+
+// ChoiceResponse is sent when a contact picks a choice.
+type ChoiceResponse struct {
+	MessageID    string `json:"message_id"`
+	PostbackData string `json:"postback_data"`
+}
+
+// Fallback is a contact message the API could not transcode, with the raw channel message.
+type Fallback struct {
+	RawMessage string  `json:"raw_message"`
+	Reason     *Reason `json:"reason,omitempty"`
+}
+
+// Reason explains why a message could not be processed or delivered.
+type Reason struct {
+	Code        string `json:"code"`
+	Description string `json:"description"`
+	SubCode     string `json:"sub_code,omitempty"`
+}
+
+type ReplyTo struct {
+	MessageID string `json:"message_id"`
+}
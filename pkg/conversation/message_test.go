@@ -0,0 +1,72 @@
+package conversation
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_AppMessage(t *testing.T) {
+	tests := map[string]struct {
+		message *AppMessage
+		wantErr error
+		want    string
+	}{
+		"nil": {
+			wantErr: MessageRequiredError,
+		},
+		"empty": {
+			message: new(AppMessage),
+			wantErr: InvalidMessageError,
+		},
+		"two types": {
+			message: &AppMessage{TextMessage: &Text{Text: "Hello"}, MediaMessage: &Media{URL: "https://example.com/cat.jpg"}},
+			wantErr: InvalidMessageError,
+		},
+		"text": {
+			message: TextMessage("Hello"),
+			want:    `{"text_message":{"text":"Hello"}}`,
+		},
+		"media": {
+			message: MediaMessage("https://example.com/cat.jpg"),
+			want:    `{"media_message":{"url":"https://example.com/cat.jpg"}}`,
+		},
+		"card": {
+			message: CardMessage(Card{Title: "Cat", MediaMessage: &Media{URL: "https://example.com/cat.jpg"}, Choices: []Choice{URLChoice("More", "https://example.com")}}),
+			want:    `{"card_message":{"title":"Cat","media_message":{"url":"https://example.com/cat.jpg"},"choices":[{"url_message":{"url":"https://example.com","title":"More"}}]}}`,
+		},
+		"carousel with invalid choice": {
+			message: CarouselMessage(Card{Title: "Cat", Choices: []Choice{{PostbackData: "cat"}}}),
+			wantErr: InvalidChoiceError,
+		},
+		"carousel": {
+			message: CarouselMessage(Card{Title: "Cat"}, Card{Title: "Dog"}),
+			want:    `{"carousel_message":{"cards":[{"title":"Cat"},{"title":"Dog"}]}}`,
+		},
+		"choice": {
+			message: ChoiceMessage("Pick one", TextChoice("Yes", "yes"), CallChoice("Call us", "+46700000000")),
+			want:    `{"choice_message":{"text_message":{"text":"Pick one"},"choices":[{"text_message":{"text":"Yes"},"postback_data":"yes"},{"call_message":{"phone_number":"+46700000000","title":"Call us"}}]}}`,
+		},
+		"location": {
+			message: LocationMessage(59.33, 18.06, "Stockholm"),
+			want:    `{"location_message":{"coordinates":{"latitude":59.33,"longitude":18.06},"title":"Stockholm"}}`,
+		},
+		"template": {
+			message: TemplateMessage("template", "1", map[string]string{"name": "Jane"}),
+			want:    `{"template_message":{"omni_template":{"template_id":"template","version":"1","parameters":{"name":"Jane"}}}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.message.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			data, err := json.Marshal(tt.message)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data))
+		})
+	}
+}
@@ -0,0 +1,277 @@
+package conversation
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Message directions.
+const (
+	DirectionToApp     = "TO_APP"
+	DirectionToContact = "TO_CONTACT"
+)
+
+// Message sources. Messages sent with ProcessingDispatchOnly are only stored in the dispatch source.
+const (
+	SourceConversation = "CONVERSATION_SOURCE"
+	SourceDispatch     = "DISPATCH_SOURCE"
+)
+
+// Message is a message stored by the Conversation API, sent either by an app or by a contact.
+type Message struct {
+	ID              string           `json:"id"`
+	Direction       string           `json:"direction"`
+	AppMessage      *AppMessage      `json:"app_message,omitempty"`     // Set for messages sent by an app.
+	ContactMessage  *ContactMessage  `json:"contact_message,omitempty"` // Set for messages sent by a contact.
+	ChannelIdentity *ChannelIdentity `json:"channel_identity,omitempty"`
+	ConversationID  string           `json:"conversation_id"`
+	ContactID       string           `json:"contact_id"`
+	Metadata        string           `json:"metadata,omitempty"`
+	AcceptTime      sinch.Time       `json:"accept_time"`
+	SenderID        string           `json:"sender_id,omitempty"`
+	ProcessingMode  string           `json:"processing_mode,omitempty"`
+}
+
+func (m *Message) FromJSON(data []byte) error {
+	return json.Unmarshal(data, m)
+}
+
+func validSource(source string) bool {
+	switch source {
+	case "", SourceConversation, SourceDispatch:
+		return true
+	}
+	return false
+}
+
+type GetMessageAction struct {
+	request  *GetMessageRequest
+	response *Message
+}
+
+func (gma *GetMessageAction) Request() *GetMessageRequest {
+	return gma.request
+}
+
+func (gma *GetMessageAction) Response() *Message {
+	return gma.response
+}
+
+// GetMessageRequest fetches a message.
+type GetMessageRequest struct {
+	MessageID      string `url:"-"`
+	MessagesSource string `url:"messages_source,omitempty"`
+}
+
+func (gmr *GetMessageRequest) WithMessageID(messageID string) *GetMessageRequest {
+	gmr.MessageID = messageID
+	return gmr
+}
+
+func (gmr *GetMessageRequest) WithMessagesSource(source string) *GetMessageRequest {
+	gmr.MessagesSource = source
+	return gmr
+}
+
+func (gmr *GetMessageRequest) Validate() error {
+	if gmr.MessageID == "" {
+		return MessageIDRequiredError
+	}
+	if !validSource(gmr.MessagesSource) {
+		return InvalidMessagesSourceError
+	}
+	return nil
+}
+
+func (gmr *GetMessageRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gmr *GetMessageRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gmr *GetMessageRequest) Path() string {
+	return "/messages/" + url.PathEscape(gmr.MessageID)
+}
+
+func (gmr *GetMessageRequest) QueryString() (string, error) {
+	return queryString(gmr)
+}
+
+func (gmr *GetMessageRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListMessagesAction struct {
+	request  *ListMessagesRequest
+	response *ListMessagesResponse
+}
+
+func (lma *ListMessagesAction) Request() *ListMessagesRequest {
+	return lma.request
+}
+
+func (lma *ListMessagesAction) Response() *ListMessagesResponse {
+	return lma.response
+}
+
+// ListMessagesRequest lists the messages of the project, optionally filtered by conversation, contact, app, channel
+// and time.
+type ListMessagesRequest struct {
+	ConversationID          string      `url:"conversation_id,omitempty"`
+	ContactID               string      `url:"contact_id,omitempty"`
+	AppID                   string      `url:"app_id,omitempty"`
+	ChannelIdentity         string      `url:"channel_identity,omitempty"`
+	Channel                 Channel     `url:"channel,omitempty"`
+	StartTime               *sinch.Time `url:"start_time,omitempty"`
+	EndTime                 *sinch.Time `url:"end_time,omitempty"`
+	OnlyRecipientOriginated bool        `url:"only_recipient_originated,omitempty"` // Lists only the messages sent by contacts.
+	MessagesSource          string      `url:"messages_source,omitempty"`
+	PageSize                int         `url:"page_size,omitempty"`
+	PageToken               string      `url:"page_token,omitempty"`
+}
+
+type ListMessagesResponse struct {
+	Messages  []Message `json:"messages"`
+	NextPage  string    `json:"next_page_token"`
+	TotalSize int       `json:"total_size"`
+}
+
+func (lmr *ListMessagesRequest) WithConversationID(conversationID string) *ListMessagesRequest {
+	lmr.ConversationID = conversationID
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) WithContactID(contactID string) *ListMessagesRequest {
+	lmr.ContactID = contactID
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) WithAppID(appID string) *ListMessagesRequest {
+	lmr.AppID = appID
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) WithChannelIdentity(channel Channel, identity string) *ListMessagesRequest {
+	lmr.Channel = channel
+	lmr.ChannelIdentity = identity
+	return lmr
+}
+
+// Between lists only the messages accepted in the given time range.
+func (lmr *ListMessagesRequest) Between(start, end sinch.Time) *ListMessagesRequest {
+	lmr.StartTime = &start
+	lmr.EndTime = &end
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) OnlyFromContacts() *ListMessagesRequest {
+	lmr.OnlyRecipientOriginated = true
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) WithMessagesSource(source string) *ListMessagesRequest {
+	lmr.MessagesSource = source
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) WithPageSize(pageSize int) *ListMessagesRequest {
+	lmr.PageSize = pageSize
+	return lmr
+}
+
+func (lmr *ListMessagesRequest) SetPageToken(token string) {
+	lmr.PageToken = token
+}
+
+func (lmr *ListMessagesRequest) Validate() error {
+	if lmr.Channel != "" && !lmr.Channel.IsValid() {
+		return InvalidChannelError
+	}
+	if !validSource(lmr.MessagesSource) {
+		return InvalidMessagesSourceError
+	}
+	return nil
+}
+
+func (lmr *ListMessagesRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lmr *ListMessagesRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lmr *ListMessagesRequest) Path() string {
+	return "/messages"
+}
+
+func (lmr *ListMessagesRequest) QueryString() (string, error) {
+	return queryString(lmr)
+}
+
+func (lmr *ListMessagesRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lmr *ListMessagesResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lmr)
+}
+
+func (lmr *ListMessagesResponse) Items() []Message {
+	return lmr.Messages
+}
+
+func (lmr *ListMessagesResponse) NextPageToken() string {
+	return lmr.NextPage
+}
+
+// DeleteMessageRequest deletes a message. The response has no content, use sinch.NoContent.
+type DeleteMessageRequest struct {
+	MessageID      string `url:"-"`
+	MessagesSource string `url:"messages_source,omitempty"`
+}
+
+func (dmr *DeleteMessageRequest) WithMessageID(messageID string) *DeleteMessageRequest {
+	dmr.MessageID = messageID
+	return dmr
+}
+
+func (dmr *DeleteMessageRequest) WithMessagesSource(source string) *DeleteMessageRequest {
+	dmr.MessagesSource = source
+	return dmr
+}
+
+func (dmr *DeleteMessageRequest) Validate() error {
+	if dmr.MessageID == "" {
+		return MessageIDRequiredError
+	}
+	if !validSource(dmr.MessagesSource) {
+		return InvalidMessagesSourceError
+	}
+	return nil
+}
+
+func (dmr *DeleteMessageRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (dmr *DeleteMessageRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dmr *DeleteMessageRequest) Path() string {
+	return "/messages/" + url.PathEscape(dmr.MessageID)
+}
+
+func (dmr *DeleteMessageRequest) QueryString() (string, error) {
+	return queryString(dmr)
+}
+
+func (dmr *DeleteMessageRequest) Body() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,91 @@
+package conversation
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Messages_Implementations(t *testing.T) {
+	var _ sinch.Action[*GetMessageRequest, *Message] = new(GetMessageAction)
+	var _ sinch.Action[*ListMessagesRequest, *ListMessagesResponse] = new(ListMessagesAction)
+	var _ sinch.ListRequest = new(ListMessagesRequest)
+	var _ sinch.ListResponse[Message] = new(ListMessagesResponse)
+	var _ sinch.APIRequest = new(DeleteMessageRequest)
+}
+
+func Test_MessageRequests(t *testing.T) {
+	start := sinch.NewTime(time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC))
+	end := sinch.NewTime(time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC))
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+	}{
+		"get without id": {
+			req:     new(GetMessageRequest),
+			wantErr: MessageIDRequiredError,
+		},
+		"get with invalid source": {
+			req:     new(GetMessageRequest).WithMessageID("message").WithMessagesSource("ELSEWHERE"),
+			wantErr: InvalidMessagesSourceError,
+		},
+		"get": {
+			req:        new(GetMessageRequest).WithMessageID("message"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/messages/message",
+		},
+		"get from dispatch source": {
+			req:        new(GetMessageRequest).WithMessageID("message").WithMessagesSource(SourceDispatch),
+			wantMethod: http.MethodGet,
+			wantPath:   "/messages/message",
+			wantQuery:  "?messages_source=DISPATCH_SOURCE",
+		},
+		"list": {
+			req:        new(ListMessagesRequest).WithConversationID("conversation").Between(start, end).OnlyFromContacts().WithPageSize(20),
+			wantMethod: http.MethodGet,
+			wantPath:   "/messages",
+			wantQuery:  "?conversation_id=conversation&end_time=2023-04-02T00%3A00%3A00Z&only_recipient_originated=true&page_size=20&start_time=2023-04-01T00%3A00%3A00Z",
+		},
+		"list with unknown channel": {
+			req:     new(ListMessagesRequest).WithChannelIdentity("PIGEON", "1"),
+			wantErr: InvalidChannelError,
+		},
+		"delete": {
+			req:        new(DeleteMessageRequest).WithMessageID("message"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/messages/message",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+		})
+	}
+}
+
+func Test_Message_FromJSON(t *testing.T) {
+	m := new(Message)
+	assert.NoError(t, m.FromJSON([]byte(`{"id": "message", "direction": "TO_APP", "conversation_id": "conversation", "contact_id": "contact",
+		"channel_identity": {"channel": "WHATSAPP", "identity": "46700000000", "app_id": "app"}, "accept_time": "2023-04-21T14:45:51.123Z",
+		"contact_message": {"choice_response_message": {"message_id": "sent", "postback_data": "yes"}, "reply_to": {"message_id": "sent"}}}`)))
+	assert.Equal(t, DirectionToApp, m.Direction)
+	assert.Equal(t, ChannelWhatsApp, m.ChannelIdentity.Channel)
+	assert.Equal(t, "yes", m.ContactMessage.ChoiceResponseMessage.PostbackData)
+	assert.Equal(t, "sent", m.ContactMessage.ReplyTo.MessageID)
+	assert.Nil(t, m.AppMessage)
+}
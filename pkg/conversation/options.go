@@ -0,0 +1,47 @@
+package conversation
+
+import "github.com/thezmc/go-sinch/pkg/api"
+
+// Option configures a Client created with New.
+type Option = api.ServiceOption[*Client]
+
+// New returns a client for the US region that sends requests with api.DefaultHTTPClient unless configured otherwise.
+// It returns an error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	return api.NewService(WithRegion(RegionUS), opts...)
+}
+
+var (
+	// WithProjectID sets the project ID.
+	WithProjectID = api.WithServiceProjectID[*Client]
+	// WithKey sets the ID and secret of the access key used for basic authentication.
+	WithKey = api.WithServiceKey[*Client]
+	// WithAuthenticator authenticates requests with a instead of the access key.
+	WithAuthenticator = api.WithServiceAuthenticator[*Client]
+	// WithTokenSource authenticates requests with OAuth2 access tokens from ts instead of the access key.
+	WithTokenSource = api.WithServiceTokenSource[*Client]
+	// WithHTTPClient sets the HTTP client used to send requests.
+	WithHTTPClient = api.WithServiceHTTPClient[*Client]
+)
+
+// WithRegion sends requests to region r.
+func WithRegion(r Region) Option {
+	return func(c *Client) {
+		c.WithRegion(r)
+	}
+}
+
+// WithSinchAPI sends requests with sinchAPI, see Client.WithSinchAPI.
+func WithSinchAPI(sinchAPI *api.Client) Option {
+	return func(c *Client) {
+		c.WithSinchAPI(sinchAPI)
+	}
+}
+
+// WithBaseURL sets a custom base URL.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		api.OwnAPI(c).BaseURL = baseURL
+		c.Region = ""
+	}
+}
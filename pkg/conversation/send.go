@@ -0,0 +1,156 @@
+package conversation
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type SendMessageAction struct {
+	request  *SendMessageRequest
+	response *SendMessageResponse
+}
+
+func (sma *SendMessageAction) Request() *SendMessageRequest {
+	return sma.request
+}
+
+func (sma *SendMessageAction) Response() *SendMessageResponse {
+	return sma.response
+}
+
+// Processing strategies.
+const (
+	ProcessingDefault      = "DEFAULT"       // Creates contacts and conversations for the recipient as needed.
+	ProcessingDispatchOnly = "DISPATCH_ONLY" // Sends the message without creating contacts or conversations.
+)
+
+// SendMessageRequest sends a message from an app to a recipient. When the recipient has identities on several channels,
+// the message is sent on the first channel of ChannelPriorityOrder and falls back to the next one if it cannot be
+// delivered.
+type SendMessageRequest struct {
+	AppID                string                 `json:"app_id"`
+	Recipient            Recipient              `json:"recipient"`
+	Message              *AppMessage            `json:"message"`
+	ChannelPriorityOrder []Channel              `json:"channel_priority_order,omitempty"`
+	ChannelProperties    map[string]string      `json:"channel_properties,omitempty"` // E.g. SMS_SENDER or WHATSAPP_HEADER.
+	CallbackURL          string                 `json:"callback_url,omitempty"`
+	MessageMetadata      string                 `json:"message_metadata,omitempty"`
+	ConversationMetadata map[string]interface{} `json:"conversation_metadata,omitempty"`
+	CorrelationID        string                 `json:"correlation_id,omitempty"`
+	Queue                string                 `json:"queue,omitempty"` // NORMAL_PRIORITY or HIGH_PRIORITY.
+	TTL                  string                 `json:"ttl,omitempty"`   // How long the message may be delivered, e.g. "10s".
+	ProcessingStrategy   string                 `json:"processing_strategy,omitempty"`
+}
+
+type SendMessageResponse struct {
+	AcceptedTime sinch.Time `json:"accepted_time"`
+	MessageID    string     `json:"message_id"`
+}
+
+func (smr *SendMessageRequest) WithAppID(appID string) *SendMessageRequest {
+	smr.AppID = appID
+	return smr
+}
+
+// To sends the message to the contact with the given ID.
+func (smr *SendMessageRequest) To(contactID string) *SendMessageRequest {
+	smr.Recipient = ContactRecipient(contactID)
+	return smr
+}
+
+// ToIdentities sends the message to a recipient identified by its channel identities.
+func (smr *SendMessageRequest) ToIdentities(identities ...ChannelIdentity) *SendMessageRequest {
+	smr.Recipient = IdentityRecipient(identities...)
+	return smr
+}
+
+func (smr *SendMessageRequest) WithMessage(message *AppMessage) *SendMessageRequest {
+	smr.Message = message
+	return smr
+}
+
+// WithChannelPriority sets the channels the message is sent on, in order of preference. The message falls back to the
+// next channel if it cannot be delivered on one.
+func (smr *SendMessageRequest) WithChannelPriority(channels ...Channel) *SendMessageRequest {
+	smr.ChannelPriorityOrder = channels
+	return smr
+}
+
+func (smr *SendMessageRequest) WithChannelProperty(key, value string) *SendMessageRequest {
+	if smr.ChannelProperties == nil {
+		smr.ChannelProperties = make(map[string]string)
+	}
+	smr.ChannelProperties[key] = value
+	return smr
+}
+
+func (smr *SendMessageRequest) WithCallbackURL(callbackURL string) *SendMessageRequest {
+	smr.CallbackURL = callbackURL
+	return smr
+}
+
+func (smr *SendMessageRequest) WithMessageMetadata(metadata string) *SendMessageRequest {
+	smr.MessageMetadata = metadata
+	return smr
+}
+
+func (smr *SendMessageRequest) WithCorrelationID(correlationID string) *SendMessageRequest {
+	smr.CorrelationID = correlationID
+	return smr
+}
+
+func (smr *SendMessageRequest) WithTTL(ttl string) *SendMessageRequest {
+	smr.TTL = ttl
+	return smr
+}
+
+func (smr *SendMessageRequest) WithProcessingStrategy(strategy string) *SendMessageRequest {
+	smr.ProcessingStrategy = strategy
+	return smr
+}
+
+func (smr *SendMessageRequest) Validate() error {
+	if smr.AppID == "" {
+		return AppIDRequiredError
+	}
+	if err := smr.Recipient.Validate(); err != nil {
+		return err
+	}
+	for _, channel := range smr.ChannelPriorityOrder {
+		if !channel.IsValid() {
+			return InvalidChannelError
+		}
+	}
+	switch smr.ProcessingStrategy {
+	case "", ProcessingDefault, ProcessingDispatchOnly:
+	default:
+		return InvalidProcessingStrategyError
+	}
+	return smr.Message.Validate()
+}
+
+func (smr *SendMessageRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (smr *SendMessageRequest) Method() string {
+	return http.MethodPost
+}
+
+func (smr *SendMessageRequest) Path() string {
+	return "/messages:send"
+}
+
+func (smr *SendMessageRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (smr *SendMessageRequest) Body() ([]byte, error) {
+	return json.Marshal(smr)
+}
+
+func (smr *SendMessageResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, smr)
+}
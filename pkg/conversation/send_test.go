@@ -0,0 +1,79 @@
+package conversation
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_SendMessage_Implementations(t *testing.T) {
+	var _ sinch.Action[*SendMessageRequest, *SendMessageResponse] = new(SendMessageAction)
+	var _ sinch.APIRequest = new(SendMessageRequest)
+	var _ sinch.APIResponse = new(SendMessageResponse)
+}
+
+func Test_SendMessageRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *SendMessageRequest
+		wantErr  error
+		wantBody string
+	}{
+		"missing app": {
+			req:     new(SendMessageRequest).To("contact").WithMessage(TextMessage("Hello")),
+			wantErr: AppIDRequiredError,
+		},
+		"missing recipient": {
+			req:     new(SendMessageRequest).WithAppID("app").WithMessage(TextMessage("Hello")),
+			wantErr: RecipientRequiredError,
+		},
+		"unknown identity channel": {
+			req:     new(SendMessageRequest).WithAppID("app").ToIdentities(ChannelIdentity{Channel: "PIGEON", Identity: "1"}).WithMessage(TextMessage("Hello")),
+			wantErr: InvalidChannelError,
+		},
+		"unknown priority channel": {
+			req:     new(SendMessageRequest).WithAppID("app").To("contact").WithChannelPriority("PIGEON").WithMessage(TextMessage("Hello")),
+			wantErr: InvalidChannelError,
+		},
+		"invalid processing strategy": {
+			req:     new(SendMessageRequest).WithAppID("app").To("contact").WithProcessingStrategy("LATER").WithMessage(TextMessage("Hello")),
+			wantErr: InvalidProcessingStrategyError,
+		},
+		"missing message": {
+			req:     new(SendMessageRequest).WithAppID("app").To("contact"),
+			wantErr: MessageRequiredError,
+		},
+		"contact": {
+			req:      new(SendMessageRequest).WithAppID("app").To("contact").WithMessage(TextMessage("Hello")).WithCorrelationID("corr"),
+			wantBody: `{"app_id":"app","recipient":{"contact_id":"contact"},"message":{"text_message":{"text":"Hello"}},"correlation_id":"corr"}`,
+		},
+		"identities with fallback": {
+			req: new(SendMessageRequest).
+				WithAppID("app").
+				ToIdentities(ChannelIdentity{Channel: ChannelRCS, Identity: "46700000000"}, ChannelIdentity{Channel: ChannelSMS, Identity: "46700000000"}).
+				WithChannelPriority(ChannelRCS, ChannelSMS).
+				WithChannelProperty("SMS_SENDER", "Sinch").
+				WithTTL("30s").
+				WithProcessingStrategy(ProcessingDispatchOnly).
+				WithMessage(MediaMessage("https://example.com/cat.jpg")),
+			wantBody: `{"app_id":"app","recipient":{"identified_by":{"channel_identities":[{"channel":"RCS","identity":"46700000000"},{"channel":"SMS","identity":"46700000000"}]}},
+				"message":{"media_message":{"url":"https://example.com/cat.jpg"}},"channel_priority_order":["RCS","SMS"],"channel_properties":{"SMS_SENDER":"Sinch"},
+				"ttl":"30s","processing_strategy":"DISPATCH_ONLY"}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPost, tt.req.Method())
+			assert.Equal(t, "/messages:send", tt.req.Path())
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package conversation
+
+// Channel is a messaging channel of the Conversation API.
+type Channel string
+
+const (
+	ChannelSMS           Channel = "SMS"
+	ChannelMMS           Channel = "MMS"
+	ChannelWhatsApp      Channel = "WHATSAPP"
+	ChannelRCS           Channel = "RCS"
+	ChannelMessenger     Channel = "MESSENGER"
+	ChannelInstagram     Channel = "INSTAGRAM"
+	ChannelViber         Channel = "VIBER"
+	ChannelViberBM       Channel = "VIBERBM"
+	ChannelTelegram      Channel = "TELEGRAM"
+	ChannelKakaoTalk     Channel = "KAKAOTALK"
+	ChannelKakaoTalkChat Channel = "KAKAOTALKCHAT"
+	ChannelLine          Channel = "LINE"
+	ChannelWeChat        Channel = "WECHAT"
+	ChannelAppleBC       Channel = "APPLEBC"
+)
+
+// IsValid reports whether c is a known channel.
+func (c Channel) IsValid() bool {
+	switch c {
+	case ChannelSMS, ChannelMMS, ChannelWhatsApp, ChannelRCS, ChannelMessenger, ChannelInstagram, ChannelViber,
+		ChannelViberBM, ChannelTelegram, ChannelKakaoTalk, ChannelKakaoTalkChat, ChannelLine, ChannelWeChat, ChannelAppleBC:
+		return true
+	}
+	return false
+}
+
+// ChannelIdentity is the identity of a contact on a channel, e.g. a phone number on SMS or WhatsApp.
+type ChannelIdentity struct {
+	Channel  Channel `json:"channel"`
+	Identity string  `json:"identity"`
+	AppID    string  `json:"app_id,omitempty"` // Required for channels with app scoped identities, like Messenger.
+}
+
+// Recipient is a contact, or the channel identities of a recipient that may not be a contact yet.
+type Recipient struct {
+	ContactID    string        `json:"contact_id,omitempty"`
+	IdentifiedBy *IdentifiedBy `json:"identified_by,omitempty"`
+}
+
+type IdentifiedBy struct {
+	ChannelIdentities []ChannelIdentity `json:"channel_identities"`
+}
+
+// ContactRecipient returns the recipient with the given contact ID.
+func ContactRecipient(contactID string) Recipient {
+	return Recipient{ContactID: contactID}
+}
+
+// IdentityRecipient returns a recipient identified by its channel identities.
+func IdentityRecipient(identities ...ChannelIdentity) Recipient {
+	return Recipient{IdentifiedBy: &IdentifiedBy{ChannelIdentities: identities}}
+}
+
+func (r *Recipient) Validate() error {
+	hasIdentities := r.IdentifiedBy != nil && len(r.IdentifiedBy.ChannelIdentities) > 0
+	if (r.ContactID == "") == !hasIdentities {
+		return RecipientRequiredError
+	}
+	if hasIdentities {
+		for _, identity := range r.IdentifiedBy.ChannelIdentities {
+			if !identity.Channel.IsValid() {
+				return InvalidChannelError
+			}
+		}
+	}
+	return nil
+}
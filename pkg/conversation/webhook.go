@@ -0,0 +1,477 @@
+package conversation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	SignatureHeader          = "X-Sinch-Webhook-Signature"
+	SignatureNonceHeader     = "X-Sinch-Webhook-Signature-Nonce"
+	SignatureTimestampHeader = "X-Sinch-Webhook-Signature-Timestamp"
+
+	MaxWebhookBodySize = 1 << 20
+	// DefaultTolerance is how far the signature timestamp of a callback may be from the current time before it is
+	// rejected as a replay.
+	DefaultTolerance = 5 * time.Minute
+)
+
+// Trigger is the kind of event a webhook is called for. Webhooks subscribe to triggers when they are created.
+type Trigger string
+
+const (
+	TriggerMessageInbound     Trigger = "MESSAGE_INBOUND"
+	TriggerMessageDelivery    Trigger = "MESSAGE_DELIVERY"
+	TriggerMessageSubmit      Trigger = "MESSAGE_SUBMIT"
+	TriggerEventInbound       Trigger = "EVENT_INBOUND"
+	TriggerEventDelivery      Trigger = "EVENT_DELIVERY"
+	TriggerConversationStart  Trigger = "CONVERSATION_START"
+	TriggerConversationStop   Trigger = "CONVERSATION_STOP"
+	TriggerConversationDelete Trigger = "CONVERSATION_DELETE"
+	TriggerContactCreate      Trigger = "CONTACT_CREATE"
+	TriggerContactUpdate      Trigger = "CONTACT_UPDATE"
+	TriggerContactDelete      Trigger = "CONTACT_DELETE"
+	TriggerContactMerge       Trigger = "CONTACT_MERGE"
+	TriggerCapability         Trigger = "CAPABILITY"
+	TriggerOptIn              Trigger = "OPT_IN"
+	TriggerOptOut             Trigger = "OPT_OUT"
+	TriggerChannelEvent       Trigger = "CHANNEL_EVENT"
+	TriggerUnsupported        Trigger = "UNSUPPORTED"
+)
+
+// triggerFields maps the field holding the payload of each callback to its trigger. Callbacks do not name their
+// trigger, it is known from the payload field that is set.
+var triggerFields = map[string]Trigger{
+	"message":                          TriggerMessageInbound,
+	"message_delivery_report":          TriggerMessageDelivery,
+	"message_submit_notification":      TriggerMessageSubmit,
+	"event":                            TriggerEventInbound,
+	"event_delivery_report":            TriggerEventDelivery,
+	"conversation_start_notification":  TriggerConversationStart,
+	"conversation_stop_notification":   TriggerConversationStop,
+	"conversation_delete_notification": TriggerConversationDelete,
+	"contact_create_notification":      TriggerContactCreate,
+	"contact_update_notification":      TriggerContactUpdate,
+	"contact_delete_notification":      TriggerContactDelete,
+	"contact_merge_notification":       TriggerContactMerge,
+	"capability_notification":          TriggerCapability,
+	"opt_in_notification":              TriggerOptIn,
+	"opt_out_notification":             TriggerOptOut,
+	"channel_event_notification":       TriggerChannelEvent,
+	"unsupported_callback":             TriggerUnsupported,
+}
+
+// Callback holds the fields common to every callback.
+type Callback struct {
+	AppID           string     `json:"app_id"`
+	ProjectID       string     `json:"project_id"`
+	AcceptedTime    sinch.Time `json:"accepted_time"`
+	EventTime       sinch.Time `json:"event_time"`
+	MessageMetadata string     `json:"message_metadata,omitempty"`
+	CorrelationID   string     `json:"correlation_id,omitempty"`
+}
+
+// Delivery statuses.
+const (
+	StatusQueuedOnChannel  = "QUEUED_ON_CHANNEL"
+	StatusDelivered        = "DELIVERED"
+	StatusRead             = "READ"
+	StatusFailed           = "FAILED"
+	StatusSwitchingChannel = "SWITCHING_CHANNEL" // The message failed on a channel and is retried on the next one.
+)
+
+// DeliveryReport is the delivery status of a message or event on a channel.
+type DeliveryReport struct {
+	MessageID       string           `json:"message_id,omitempty"`
+	EventID         string           `json:"event_id,omitempty"`
+	ConversationID  string           `json:"conversation_id"`
+	ContactID       string           `json:"contact_id"`
+	ChannelIdentity *ChannelIdentity `json:"channel_identity,omitempty"`
+	Status          string           `json:"status"`
+	Reason          *Reason          `json:"reason,omitempty"` // Set when Status is FAILED or SWITCHING_CHANNEL.
+	Metadata        string           `json:"metadata,omitempty"`
+	ProcessingMode  string           `json:"processing_mode,omitempty"`
+}
+
+// SubmitNotification is sent when a message is submitted to a channel.
+type SubmitNotification struct {
+	MessageID        string           `json:"message_id"`
+	ConversationID   string           `json:"conversation_id"`
+	ContactID        string           `json:"contact_id"`
+	ChannelIdentity  *ChannelIdentity `json:"channel_identity,omitempty"`
+	SubmittedMessage *AppMessage      `json:"submitted_message,omitempty"`
+	Metadata         string           `json:"metadata,omitempty"`
+	ProcessingMode   string           `json:"processing_mode,omitempty"`
+}
+
+// InboundEvent is an event from a contact that is not a message, like a typing indicator.
+type InboundEvent struct {
+	ID              string           `json:"id"`
+	Direction       string           `json:"direction"`
+	ContactEvent    json.RawMessage  `json:"contact_event"` // E.g. {"composing_event": {}}.
+	ChannelIdentity *ChannelIdentity `json:"channel_identity,omitempty"`
+	ContactID       string           `json:"contact_id"`
+	ConversationID  string           `json:"conversation_id"`
+	AcceptTime      sinch.Time       `json:"accept_time"`
+	ProcessingMode  string           `json:"processing_mode,omitempty"`
+}
+
+type ConversationNotification struct {
+	Conversation Conversation `json:"conversation"`
+}
+
+type ContactNotification struct {
+	Contact Contact `json:"contact"`
+}
+
+type ContactMergeNotification struct {
+	PreservedContact Contact `json:"preserved_contact"`
+	DeletedContact   Contact `json:"deleted_contact"`
+}
+
+// CapabilityNotification is the result of a capability query for a contact on a channel.
+type CapabilityNotification struct {
+	ContactID        string   `json:"contact_id"`
+	Identity         string   `json:"identity"`
+	Channel          Channel  `json:"channel"`
+	CapabilityStatus string   `json:"capability_status"` // CAPABILITY_FULL, CAPABILITY_PARTIAL or NO_CAPABILITY.
+	Capabilities     []string `json:"channel_capabilities,omitempty"`
+	RequestID        string   `json:"request_id"`
+	Reason           *Reason  `json:"reason,omitempty"`
+}
+
+// OptNotification is the result of an opt-in or opt-out request.
+type OptNotification struct {
+	RequestID    string  `json:"request_id"`
+	ContactID    string  `json:"contact_id"`
+	Channel      Channel `json:"channel"`
+	Identity     string  `json:"identity"`
+	Status       string  `json:"status"` // OPT_IN_SUCCEEDED, OPT_IN_FAILED, OPT_OUT_SUCCEEDED or OPT_OUT_FAILED.
+	ErrorDetails *Reason `json:"error_details,omitempty"`
+}
+
+// ChannelEventNotification is an event of a channel, like a change of the quality rating of a WhatsApp number.
+type ChannelEventNotification struct {
+	Channel        Channel         `json:"channel"`
+	EventType      string          `json:"event_type"`
+	AdditionalData json.RawMessage `json:"additional_data,omitempty"`
+}
+
+// UnsupportedCallback is a channel callback that the API could not transcode, with its raw payload.
+type UnsupportedCallback struct {
+	Channel          Channel `json:"channel"`
+	Payload          string  `json:"payload"`
+	ID               string  `json:"id"`
+	ContactID        string  `json:"contact_id"`
+	ConversationID   string  `json:"conversation_id"`
+	ChannelMessageID string  `json:"channel_message_id"`
+}
+
+type MessageInboundEvent struct {
+	Callback
+	Message Message `json:"message"`
+}
+
+type MessageDeliveryEvent struct {
+	Callback
+	Report DeliveryReport `json:"message_delivery_report"`
+}
+
+type MessageSubmitEvent struct {
+	Callback
+	Notification SubmitNotification `json:"message_submit_notification"`
+}
+
+type EventInboundEvent struct {
+	Callback
+	Event InboundEvent `json:"event"`
+}
+
+type EventDeliveryEvent struct {
+	Callback
+	Report DeliveryReport `json:"event_delivery_report"`
+}
+
+type ConversationStartEvent struct {
+	Callback
+	Notification ConversationNotification `json:"conversation_start_notification"`
+}
+
+type ConversationStopEvent struct {
+	Callback
+	Notification ConversationNotification `json:"conversation_stop_notification"`
+}
+
+type ConversationDeleteEvent struct {
+	Callback
+	Notification ConversationNotification `json:"conversation_delete_notification"`
+}
+
+type ContactCreateEvent struct {
+	Callback
+	Notification ContactNotification `json:"contact_create_notification"`
+}
+
+type ContactUpdateEvent struct {
+	Callback
+	Notification ContactNotification `json:"contact_update_notification"`
+}
+
+type ContactDeleteEvent struct {
+	Callback
+	Notification ContactNotification `json:"contact_delete_notification"`
+}
+
+type ContactMergeEvent struct {
+	Callback
+	Notification ContactMergeNotification `json:"contact_merge_notification"`
+}
+
+type CapabilityEvent struct {
+	Callback
+	Notification CapabilityNotification `json:"capability_notification"`
+}
+
+type OptInEvent struct {
+	Callback
+	Notification OptNotification `json:"opt_in_notification"`
+}
+
+type OptOutEvent struct {
+	Callback
+	Notification OptNotification `json:"opt_out_notification"`
+}
+
+type ChannelEvent struct {
+	Callback
+	Notification ChannelEventNotification `json:"channel_event_notification"`
+}
+
+type UnsupportedEvent struct {
+	Callback
+	Unsupported UnsupportedCallback `json:"unsupported_callback"`
+}
+
+// FallbackHandlerFunc handles the callbacks of triggers without a handler of their own, with the raw callback body.
+type FallbackHandlerFunc func(ctx context.Context, trigger Trigger, body []byte) error
+
+// WebhookHandler is an http.Handler that receives Conversation API callbacks, verifies their signature with the
+// secret of the webhook, rejects callbacks signed outside the tolerance window and dispatches them to the handler
+// registered for their trigger. Callbacks that do not decode get a 400. Returning an error from a handler makes the
+// webhook respond with a 500 so Sinch retries the callback.
+type WebhookHandler struct {
+	Secret    string
+	Tolerance time.Duration // Defaults to DefaultTolerance.
+	handlers  map[Trigger]decoder
+	fallback  FallbackHandlerFunc
+	now       func() time.Time
+}
+
+// WithSecret sets the secret of the webhook the callbacks are signed with.
+func (wh *WebhookHandler) WithSecret(secret string) *WebhookHandler {
+	wh.Secret = secret
+	return wh
+}
+
+// WithTolerance sets how far the signature timestamp may be from the current time.
+func (wh *WebhookHandler) WithTolerance(tolerance time.Duration) *WebhookHandler {
+	wh.Tolerance = tolerance
+	return wh
+}
+
+// decoder decodes the body of a callback and returns the call of its handler with the decoded event.
+type decoder func(body []byte) (func(context.Context) error, error)
+
+// handle registers fn as the handler of trigger, decoding the callbacks into E.
+func handle[E any](wh *WebhookHandler, trigger Trigger, fn func(context.Context, *E) error) *WebhookHandler {
+	if wh.handlers == nil {
+		wh.handlers = make(map[Trigger]decoder)
+	}
+	wh.handlers[trigger] = func(body []byte) (func(context.Context) error, error) {
+		event := new(E)
+		if err := json.Unmarshal(body, event); err != nil {
+			return nil, err
+		}
+		return func(ctx context.Context) error {
+			return fn(ctx, event)
+		}, nil
+	}
+	return wh
+}
+
+func (wh *WebhookHandler) OnMessageInbound(fn func(context.Context, *MessageInboundEvent) error) *WebhookHandler {
+	return handle(wh, TriggerMessageInbound, fn)
+}
+
+func (wh *WebhookHandler) OnMessageDelivery(fn func(context.Context, *MessageDeliveryEvent) error) *WebhookHandler {
+	return handle(wh, TriggerMessageDelivery, fn)
+}
+
+func (wh *WebhookHandler) OnMessageSubmit(fn func(context.Context, *MessageSubmitEvent) error) *WebhookHandler {
+	return handle(wh, TriggerMessageSubmit, fn)
+}
+
+func (wh *WebhookHandler) OnEventInbound(fn func(context.Context, *EventInboundEvent) error) *WebhookHandler {
+	return handle(wh, TriggerEventInbound, fn)
+}
+
+func (wh *WebhookHandler) OnEventDelivery(fn func(context.Context, *EventDeliveryEvent) error) *WebhookHandler {
+	return handle(wh, TriggerEventDelivery, fn)
+}
+
+func (wh *WebhookHandler) OnConversationStart(fn func(context.Context, *ConversationStartEvent) error) *WebhookHandler {
+	return handle(wh, TriggerConversationStart, fn)
+}
+
+func (wh *WebhookHandler) OnConversationStop(fn func(context.Context, *ConversationStopEvent) error) *WebhookHandler {
+	return handle(wh, TriggerConversationStop, fn)
+}
+
+func (wh *WebhookHandler) OnConversationDelete(fn func(context.Context, *ConversationDeleteEvent) error) *WebhookHandler {
+	return handle(wh, TriggerConversationDelete, fn)
+}
+
+func (wh *WebhookHandler) OnContactCreate(fn func(context.Context, *ContactCreateEvent) error) *WebhookHandler {
+	return handle(wh, TriggerContactCreate, fn)
+}
+
+func (wh *WebhookHandler) OnContactUpdate(fn func(context.Context, *ContactUpdateEvent) error) *WebhookHandler {
+	return handle(wh, TriggerContactUpdate, fn)
+}
+
+func (wh *WebhookHandler) OnContactDelete(fn func(context.Context, *ContactDeleteEvent) error) *WebhookHandler {
+	return handle(wh, TriggerContactDelete, fn)
+}
+
+func (wh *WebhookHandler) OnContactMerge(fn func(context.Context, *ContactMergeEvent) error) *WebhookHandler {
+	return handle(wh, TriggerContactMerge, fn)
+}
+
+func (wh *WebhookHandler) OnCapability(fn func(context.Context, *CapabilityEvent) error) *WebhookHandler {
+	return handle(wh, TriggerCapability, fn)
+}
+
+func (wh *WebhookHandler) OnOptIn(fn func(context.Context, *OptInEvent) error) *WebhookHandler {
+	return handle(wh, TriggerOptIn, fn)
+}
+
+func (wh *WebhookHandler) OnOptOut(fn func(context.Context, *OptOutEvent) error) *WebhookHandler {
+	return handle(wh, TriggerOptOut, fn)
+}
+
+func (wh *WebhookHandler) OnChannelEvent(fn func(context.Context, *ChannelEvent) error) *WebhookHandler {
+	return handle(wh, TriggerChannelEvent, fn)
+}
+
+func (wh *WebhookHandler) OnUnsupported(fn func(context.Context, *UnsupportedEvent) error) *WebhookHandler {
+	return handle(wh, TriggerUnsupported, fn)
+}
+
+// HandleAll registers fn as the handler for callbacks without a handler of their own, including callbacks of triggers
+// this package does not know.
+func (wh *WebhookHandler) HandleAll(fn FallbackHandlerFunc) *WebhookHandler {
+	wh.fallback = fn
+	return wh
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxWebhookBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	nonce := r.Header.Get(SignatureNonceHeader)
+	timestamp := r.Header.Get(SignatureTimestampHeader)
+	if !VerifySignature(wh.Secret, body, nonce, timestamp, r.Header.Get(SignatureHeader)) || !wh.fresh(timestamp) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	trigger, ok := TriggerOf(body)
+	if !ok {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	if decode, ok := wh.handlers[trigger]; ok {
+		call, decodeErr := decode(body)
+		if decodeErr != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		err = call(ctx)
+	} else if wh.fallback != nil {
+		err = wh.fallback(ctx, trigger, body)
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// fresh reports whether the unix timestamp is within the tolerance window around the current time.
+func (wh *WebhookHandler) fresh(timestamp string) bool {
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	now := time.Now
+	if wh.now != nil {
+		now = wh.now
+	}
+	tolerance := wh.Tolerance
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	age := now().Sub(time.Unix(seconds, 0))
+	return age <= tolerance && age >= -tolerance
+}
+
+// TriggerOf returns the trigger of a callback body. Callbacks of unknown triggers return an empty trigger, ok is false
+// only if body is not a JSON object.
+func TriggerOf(body []byte) (trigger Trigger, ok bool) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return "", false
+	}
+	for field := range fields {
+		if trigger, ok := triggerFields[field]; ok {
+			return trigger, true
+		}
+	}
+	return "", true
+}
+
+// Sign returns the base 64 encoded HMAC-SHA256 signature of a callback, as sent by Sinch in the
+// X-Sinch-Webhook-Signature header. The signed data is the body, the nonce and the timestamp joined by dots.
+func Sign(secret string, body []byte, nonce, timestamp string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	mac.Write([]byte("." + nonce + "." + timestamp))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if signature is the signature of the callback with the given secret.
+func VerifySignature(secret string, body []byte, nonce, timestamp, signature string) bool {
+	if secret == "" || signature == "" || nonce == "" || timestamp == "" {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body, nonce, timestamp)), []byte(signature))
+}
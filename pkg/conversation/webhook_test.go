@@ -0,0 +1,196 @@
+package conversation
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Sign(t *testing.T) {
+	body := []byte(`{"app_id":"app","contact_create_notification":{"contact":{"id":"contact"}}}`)
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte(string(body) + ".nonce.1634579353"))
+	want := base64.StdEncoding.EncodeToString(mac.Sum(nil))
+
+	signature := Sign("secret", body, "nonce", "1634579353")
+	assert.Equal(t, want, signature)
+	assert.True(t, VerifySignature("secret", body, "nonce", "1634579353", signature))
+	assert.False(t, VerifySignature("secret", body, "other", "1634579353", signature))
+	assert.False(t, VerifySignature("secret", body, "nonce", "1634579354", signature))
+	assert.False(t, VerifySignature("", body, "nonce", "1634579353", signature))
+}
+
+func Test_TriggerOf(t *testing.T) {
+	tests := map[string]struct {
+		body    string
+		want    Trigger
+		wantBad bool
+	}{
+		"inbound message":   {body: `{"app_id": "app", "message": {"id": "1"}}`, want: TriggerMessageInbound},
+		"delivery report":   {body: `{"message_delivery_report": {"status": "DELIVERED"}}`, want: TriggerMessageDelivery},
+		"inbound event":     {body: `{"event": {"id": "1"}}`, want: TriggerEventInbound},
+		"contact merge":     {body: `{"contact_merge_notification": {}}`, want: TriggerContactMerge},
+		"unknown trigger":   {body: `{"app_id": "app", "smart_conversation_notification": {}}`},
+		"not a JSON object": {body: `[]`, wantBad: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			trigger, ok := TriggerOf([]byte(tt.body))
+			assert.Equal(t, !tt.wantBad, ok)
+			assert.Equal(t, tt.want, trigger)
+		})
+	}
+}
+
+func Test_WebhookHandler(t *testing.T) {
+	const secret = "secret"
+	now := time.Unix(1700000000, 0)
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	const inbound = `{"app_id": "app", "accepted_time": "2023-11-14T22:13:20.123Z", "message": {"id": "1", "direction": "TO_APP",
+		"channel_identity": {"channel": "SMS", "identity": "46700000000"}, "contact_message": {"text_message": {"text": "Hi"}}}}`
+	const delivery = `{"app_id": "app", "message_delivery_report": {"message_id": "2", "status": "FAILED", "reason": {"code": "RECIPIENT_NOT_REACHABLE"}}}`
+	const merge = `{"app_id": "app", "contact_merge_notification": {"preserved_contact": {"id": "a"}, "deleted_contact": {"id": "b"}}}`
+	const unknown = `{"app_id": "app", "smart_conversation_notification": {}}`
+	const badMerge = `{"app_id": "app", "contact_merge_notification": {"preserved_contact": {"id": 1}}}`
+
+	var inboundEvent *MessageInboundEvent
+	var mergeEvent *ContactMergeEvent
+	var fallbackTrigger Trigger
+	handler := new(WebhookHandler).
+		WithSecret(secret).
+		OnMessageInbound(func(ctx context.Context, event *MessageInboundEvent) error {
+			inboundEvent = event
+			return nil
+		}).
+		OnMessageDelivery(func(ctx context.Context, event *MessageDeliveryEvent) error {
+			return assert.AnError
+		}).
+		OnContactMerge(func(ctx context.Context, event *ContactMergeEvent) error {
+			mergeEvent = event
+			return nil
+		}).
+		HandleAll(func(ctx context.Context, trigger Trigger, body []byte) error {
+			fallbackTrigger = trigger
+			return nil
+		})
+	handler.now = func() time.Time { return now }
+
+	tests := map[string]struct {
+		method         string
+		body           string
+		nonce          string
+		timestamp      string
+		signature      string
+		expectedStatus int
+	}{
+		"wrong method": {
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"missing signature": {
+			method:         http.MethodPost,
+			body:           inbound,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"bad signature": {
+			method:         http.MethodPost,
+			body:           inbound,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign("wrong", []byte(inbound), "nonce", timestamp),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"replayed": {
+			method:         http.MethodPost,
+			body:           inbound,
+			nonce:          "nonce",
+			timestamp:      "1699999000",
+			signature:      Sign(secret, []byte(inbound), "nonce", "1699999000"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"bad body": {
+			method:         http.MethodPost,
+			body:           "{",
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign(secret, []byte("{"), "nonce", timestamp),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"undecodable event": {
+			method:         http.MethodPost,
+			body:           badMerge,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign(secret, []byte(badMerge), "nonce", timestamp),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"inbound message": {
+			method:         http.MethodPost,
+			body:           inbound,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign(secret, []byte(inbound), "nonce", timestamp),
+			expectedStatus: http.StatusOK,
+		},
+		"handler error": {
+			method:         http.MethodPost,
+			body:           delivery,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign(secret, []byte(delivery), "nonce", timestamp),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"contact merge": {
+			method:         http.MethodPost,
+			body:           merge,
+			nonce:          "nonce",
+			timestamp:      "1700000100",
+			signature:      Sign(secret, []byte(merge), "nonce", "1700000100"),
+			expectedStatus: http.StatusOK,
+		},
+		"fallback": {
+			method:         http.MethodPost,
+			body:           unknown,
+			nonce:          "nonce",
+			timestamp:      timestamp,
+			signature:      Sign(secret, []byte(unknown), "nonce", timestamp),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/webhook", strings.NewReader(tt.body))
+			req.Header.Set(SignatureHeader, tt.signature)
+			req.Header.Set(SignatureNonceHeader, tt.nonce)
+			req.Header.Set(SignatureTimestampHeader, tt.timestamp)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+
+	if assert.NotNil(t, inboundEvent) {
+		assert.Equal(t, "app", inboundEvent.AppID)
+		assert.Equal(t, 2023, inboundEvent.AcceptedTime.Year())
+		assert.Equal(t, ChannelSMS, inboundEvent.Message.ChannelIdentity.Channel)
+		assert.Equal(t, "Hi", inboundEvent.Message.ContactMessage.TextMessage.Text)
+	}
+	if assert.NotNil(t, mergeEvent) {
+		assert.Equal(t, "a", mergeEvent.Notification.PreservedContact.ID)
+		assert.Equal(t, "b", mergeEvent.Notification.DeletedContact.ID)
+	}
+	assert.Equal(t, Trigger(""), fallbackTrigger)
+}
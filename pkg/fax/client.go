@@ -0,0 +1,55 @@
+// Package fax is a client for the Sinch Fax API, which sends and receives faxes and manages fax services and their
+// cover pages.
+package fax
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Client struct {
+	api.ProjectClient
+}
+
+const BaseURLv3 = "https://fax.api.sinch.com/v3/projects"
+
+// Send sends a fax, see SendFaxRequest.
+func (c *Client) Send(ctx context.Context, req *SendFaxRequest) (*Fax, error) {
+	resp := new(Fax)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Download returns the content of a fax as a PDF document.
+func (c *Client) Download(ctx context.Context, faxID string) ([]byte, error) {
+	resp := new(Document)
+	if err := c.DoContext(ctx, new(DownloadFaxRequest).WithFaxID(faxID), resp); err != nil {
+		return nil, err
+	}
+	return resp.Data, nil
+}
+
+// ListFaxes returns a pager over the faxes that match the request.
+func (c *Client) ListFaxes(req *ListFaxesRequest) *api.Pager[Fax] {
+	return api.NewPager[Fax](c, req, func() sinch.ListResponse[Fax] {
+		return new(ListFaxesResponse)
+	})
+}
+
+// ListServices returns a pager over the fax services of the project.
+func (c *Client) ListServices(req *ListServicesRequest) *api.Pager[Service] {
+	return api.NewPager[Service](c, req, func() sinch.ListResponse[Service] {
+		return new(ListServicesResponse)
+	})
+}
+
+// ListCoverPages returns a pager over the cover pages of a service.
+func (c *Client) ListCoverPages(req *ListCoverPagesRequest) *api.Pager[CoverPage] {
+	return api.NewPager[CoverPage](c, req, func() sinch.ListResponse[CoverPage] {
+		return new(ListCoverPagesResponse)
+	})
+}
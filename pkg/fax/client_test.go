@@ -0,0 +1,119 @@
+package fax
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing project": {opts: []Option{WithKey("key", "secret")}, wantErr: ProjectIDRequiredError},
+		"missing key id":  {opts: []Option{WithProjectID("project"), WithKey("", "secret")}, wantErr: KeyIDRequiredError},
+		"missing secret":  {opts: []Option{WithProjectID("project"), WithKey("key", "")}, wantErr: KeySecretRequiredError},
+		"basic auth":      {opts: []Option{WithProjectID("project"), WithKey("key", "secret")}},
+		"authenticator":   {opts: []Option{WithProjectID("project"), WithAuthenticator(auth.NewBearerToken("token"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, BaseURLv3+"/project", c.URL())
+	assert.Same(t, api.DefaultHTTPClient, c.API().HTTPClient)
+
+	shared := &api.Client{BaseURL: BaseURLv3, HTTPClient: http.DefaultClient}
+	c, err = New(WithProjectID("project"), WithKey("key", "secret"), WithSinchAPI(shared), WithBaseURL("http://localhost"))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/project", c.URL())
+	assert.Equal(t, BaseURLv3, shared.BaseURL)
+
+	_, err = New(WithKey("key", "secret"))
+	assert.ErrorIs(t, err, ProjectIDRequiredError)
+}
+
+func Test_Client_Send(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/project/faxes", r.URL.Path)
+		assert.NoError(t, r.ParseMultipartForm(1<<20))
+		assert.Equal(t, "+12025550101", r.FormValue("to"))
+		assert.Equal(t, "invoice", r.FormValue("labels[type]"))
+		file, header, err := r.FormFile("file")
+		if assert.NoError(t, err) {
+			data, _ := io.ReadAll(file)
+			assert.Equal(t, "%PDF-1.4", string(data))
+			assert.Equal(t, "invoice.pdf", header.Filename)
+			assert.Equal(t, "application/pdf", header.Header.Get("Content-Type"))
+		}
+		io.WriteString(w, `{"id": "fax", "direction": "OUTBOUND", "to": "+12025550101", "status": "QUEUED", "createTime": "2023-04-01T10:00:00Z"}`)
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	fax, err := c.Send(context.Background(), new(SendFaxRequest).WithTo("+12025550101").WithFile("invoice.pdf", []byte("%PDF-1.4")).WithLabel("type", "invoice"))
+	assert.NoError(t, err)
+	assert.Equal(t, "fax", fax.ID)
+	assert.Equal(t, StatusQueued, fax.Status)
+}
+
+func Test_Client_Download(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/project/faxes/fax/file.pdf", r.URL.Path)
+		w.Header().Set("Content-Type", "application/pdf")
+		io.WriteString(w, "%PDF-1.4")
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	data, err := c.Download(context.Background(), "fax")
+	assert.NoError(t, err)
+	assert.Equal(t, "%PDF-1.4", string(data))
+}
+
+func Test_Client_ListFaxes(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"faxes": [{"id": "1"}, {"id": "2"}], "pageNumber": 1, "pageSize": 2, "totalItems": 3, "totalPages": 2}`,
+		"2": `{"faxes": [{"id": "3"}], "pageNumber": 2, "pageSize": 2, "totalItems": 3, "totalPages": 2}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "INBOUND", r.URL.Query().Get("direction"))
+		io.WriteString(w, pages[r.URL.Query().Get("page")])
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	faxes, err := c.ListFaxes(new(ListFaxesRequest).WithDirection(DirectionInbound).WithPageSize(2)).All(context.Background())
+	assert.NoError(t, err)
+	var ids []string
+	for _, fax := range faxes {
+		ids = append(ids, fax.ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
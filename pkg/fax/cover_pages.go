@@ -0,0 +1,264 @@
+package fax
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// CoverPage is a document of a service that is sent before the content of faxes that reference it. Its placeholders are
+// filled with the cover page data of the fax.
+type CoverPage struct {
+	ID       string `json:"id,omitempty"`
+	Name     string `json:"name"`
+	File     []byte `json:"file,omitempty"`     // The document, sent base64 encoded. Only set when creating the cover page.
+	FileType string `json:"fileType,omitempty"` // Always PDF.
+}
+
+func (cp *CoverPage) FromJSON(data []byte) error {
+	return json.Unmarshal(data, cp)
+}
+
+type CreateCoverPageAction struct {
+	request  *CreateCoverPageRequest
+	response *CoverPage
+}
+
+func (ccpa *CreateCoverPageAction) Request() *CreateCoverPageRequest {
+	return ccpa.request
+}
+
+func (ccpa *CreateCoverPageAction) Response() *CoverPage {
+	return ccpa.response
+}
+
+// CreateCoverPageRequest adds a PDF cover page to a service. The response is the created cover page.
+type CreateCoverPageRequest struct {
+	ServiceID string
+	CoverPage CoverPage
+}
+
+func (ccpr *CreateCoverPageRequest) WithServiceID(serviceID string) *CreateCoverPageRequest {
+	ccpr.ServiceID = serviceID
+	return ccpr
+}
+
+// WithPDF sets the name and PDF document of the cover page.
+func (ccpr *CreateCoverPageRequest) WithPDF(name string, data []byte) *CreateCoverPageRequest {
+	ccpr.CoverPage = CoverPage{Name: name, File: data, FileType: "PDF"}
+	return ccpr
+}
+
+func (ccpr *CreateCoverPageRequest) Validate() error {
+	if ccpr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	if ccpr.CoverPage.Name == "" || len(ccpr.CoverPage.File) == 0 {
+		return CoverPageRequiredError
+	}
+	return nil
+}
+
+func (ccpr *CreateCoverPageRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ccpr *CreateCoverPageRequest) Method() string {
+	return http.MethodPost
+}
+
+func (ccpr *CreateCoverPageRequest) Path() string {
+	return "/services/" + url.PathEscape(ccpr.ServiceID) + "/coverPages"
+}
+
+func (ccpr *CreateCoverPageRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ccpr *CreateCoverPageRequest) Body() ([]byte, error) {
+	return json.Marshal(ccpr.CoverPage)
+}
+
+type GetCoverPageAction struct {
+	request  *GetCoverPageRequest
+	response *CoverPage
+}
+
+func (gcpa *GetCoverPageAction) Request() *GetCoverPageRequest {
+	return gcpa.request
+}
+
+func (gcpa *GetCoverPageAction) Response() *CoverPage {
+	return gcpa.response
+}
+
+// GetCoverPageRequest fetches a cover page of a service.
+type GetCoverPageRequest struct {
+	ServiceID   string
+	CoverPageID string
+}
+
+func (gcpr *GetCoverPageRequest) WithCoverPage(serviceID, coverPageID string) *GetCoverPageRequest {
+	gcpr.ServiceID = serviceID
+	gcpr.CoverPageID = coverPageID
+	return gcpr
+}
+
+func (gcpr *GetCoverPageRequest) Validate() error {
+	if gcpr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	if gcpr.CoverPageID == "" {
+		return CoverPageIDRequiredError
+	}
+	return nil
+}
+
+func (gcpr *GetCoverPageRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gcpr *GetCoverPageRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gcpr *GetCoverPageRequest) Path() string {
+	return "/services/" + url.PathEscape(gcpr.ServiceID) + "/coverPages/" + url.PathEscape(gcpr.CoverPageID)
+}
+
+func (gcpr *GetCoverPageRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gcpr *GetCoverPageRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListCoverPagesAction struct {
+	request  *ListCoverPagesRequest
+	response *ListCoverPagesResponse
+}
+
+func (lcpa *ListCoverPagesAction) Request() *ListCoverPagesRequest {
+	return lcpa.request
+}
+
+func (lcpa *ListCoverPagesAction) Response() *ListCoverPagesResponse {
+	return lcpa.response
+}
+
+// ListCoverPagesRequest lists the cover pages of a service.
+type ListCoverPagesRequest struct {
+	ServiceID string `url:"-"`
+	PageSize  int    `url:"pageSize,omitempty"`
+	Page      int    `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListCoverPagesResponse struct {
+	CoverPages []CoverPage `json:"coverPages"`
+	PageNumber int         `json:"pageNumber"`
+	PageSize   int         `json:"pageSize"`
+	TotalItems int         `json:"totalItems"`
+	TotalPages int         `json:"totalPages"`
+}
+
+func (lcpr *ListCoverPagesRequest) WithServiceID(serviceID string) *ListCoverPagesRequest {
+	lcpr.ServiceID = serviceID
+	return lcpr
+}
+
+func (lcpr *ListCoverPagesRequest) WithPageSize(pageSize int) *ListCoverPagesRequest {
+	lcpr.PageSize = pageSize
+	return lcpr
+}
+
+// SetPageToken sets the page number from a token returned by ListCoverPagesResponse.NextPageToken.
+func (lcpr *ListCoverPagesRequest) SetPageToken(token string) {
+	lcpr.Page, _ = strconv.Atoi(token)
+}
+
+func (lcpr *ListCoverPagesRequest) Validate() error {
+	if lcpr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	if lcpr.PageSize < 0 || lcpr.PageSize > 1000 {
+		return InvalidPageSizeError
+	}
+	return nil
+}
+
+func (lcpr *ListCoverPagesRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lcpr *ListCoverPagesRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lcpr *ListCoverPagesRequest) Path() string {
+	return "/services/" + url.PathEscape(lcpr.ServiceID) + "/coverPages"
+}
+
+func (lcpr *ListCoverPagesRequest) QueryString() (string, error) {
+	return queryString(lcpr)
+}
+
+func (lcpr *ListCoverPagesRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lcpr *ListCoverPagesResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lcpr)
+}
+
+func (lcpr *ListCoverPagesResponse) Items() []CoverPage {
+	return lcpr.CoverPages
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (lcpr *ListCoverPagesResponse) NextPageToken() string {
+	return nextPage(lcpr.PageNumber, lcpr.TotalPages)
+}
+
+// DeleteCoverPageRequest deletes a cover page of a service. The response has no content, use sinch.NoContent.
+type DeleteCoverPageRequest struct {
+	ServiceID   string
+	CoverPageID string
+}
+
+func (dcpr *DeleteCoverPageRequest) WithCoverPage(serviceID, coverPageID string) *DeleteCoverPageRequest {
+	dcpr.ServiceID = serviceID
+	dcpr.CoverPageID = coverPageID
+	return dcpr
+}
+
+func (dcpr *DeleteCoverPageRequest) Validate() error {
+	if dcpr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	if dcpr.CoverPageID == "" {
+		return CoverPageIDRequiredError
+	}
+	return nil
+}
+
+func (dcpr *DeleteCoverPageRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dcpr *DeleteCoverPageRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dcpr *DeleteCoverPageRequest) Path() string {
+	return "/services/" + url.PathEscape(dcpr.ServiceID) + "/coverPages/" + url.PathEscape(dcpr.CoverPageID)
+}
+
+func (dcpr *DeleteCoverPageRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dcpr *DeleteCoverPageRequest) Body() ([]byte, error) {
+	return nil, nil
+}
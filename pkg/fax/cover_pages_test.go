@@ -0,0 +1,89 @@
+package fax
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_CoverPages_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateCoverPageRequest, *CoverPage] = new(CreateCoverPageAction)
+	var _ sinch.Action[*GetCoverPageRequest, *CoverPage] = new(GetCoverPageAction)
+	var _ sinch.Action[*ListCoverPagesRequest, *ListCoverPagesResponse] = new(ListCoverPagesAction)
+	var _ sinch.ListRequest = new(ListCoverPagesRequest)
+	var _ sinch.ListResponse[CoverPage] = new(ListCoverPagesResponse)
+	var _ sinch.APIRequest = new(DeleteCoverPageRequest)
+}
+
+func Test_CoverPageRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		"create without service": {
+			req:     new(CreateCoverPageRequest).WithPDF("Cover", []byte("%PDF")),
+			wantErr: ServiceIDRequiredError,
+		},
+		"create without file": {
+			req:     new(CreateCoverPageRequest).WithServiceID("service").WithPDF("Cover", nil),
+			wantErr: CoverPageRequiredError,
+		},
+		"create": {
+			req:        new(CreateCoverPageRequest).WithServiceID("service").WithPDF("Cover", []byte("%PDF")),
+			wantMethod: http.MethodPost,
+			wantPath:   "/services/service/coverPages",
+			wantBody:   `{"name":"Cover","file":"JVBERg==","fileType":"PDF"}`,
+		},
+		"get without cover page": {
+			req:     new(GetCoverPageRequest).WithCoverPage("service", ""),
+			wantErr: CoverPageIDRequiredError,
+		},
+		"get": {
+			req:        new(GetCoverPageRequest).WithCoverPage("service", "cover"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/services/service/coverPages/cover",
+		},
+		"list without service": {
+			req:     new(ListCoverPagesRequest),
+			wantErr: ServiceIDRequiredError,
+		},
+		"list": {
+			req:        new(ListCoverPagesRequest).WithServiceID("service").WithPageSize(5),
+			wantMethod: http.MethodGet,
+			wantPath:   "/services/service/coverPages",
+			wantQuery:  "?pageSize=5",
+		},
+		"delete": {
+			req:        new(DeleteCoverPageRequest).WithCoverPage("service", "cover"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/services/service/coverPages/cover",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Nil(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
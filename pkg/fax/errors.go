@@ -0,0 +1,22 @@
+package fax
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ProjectIDRequiredError          = api.ProjectIDRequiredError
+	KeyIDRequiredError              = api.KeyIDRequiredError
+	KeySecretRequiredError          = api.KeySecretRequiredError
+	InvalidNumberError              = sinch.Error("to must be a phone number in E.164 format")
+	ContentRequiredError            = sinch.Error("either content URLs or files are required")
+	MixedContentError               = sinch.Error("content URLs and files cannot be sent together")
+	InvalidCallbackContentTypeError = sinch.Error("callback content type must be application/json or multipart/form-data")
+	FaxIDRequiredError              = sinch.Error("fax ID is required")
+	ServiceIDRequiredError          = sinch.Error("service ID is required")
+	ServiceRequiredError            = sinch.Error("a service is required")
+	CoverPageIDRequiredError        = sinch.Error("cover page ID is required")
+	CoverPageRequiredError          = sinch.Error("a cover page name and file are required")
+	InvalidPageSizeError            = sinch.Error("page size must be between 0 and 1000")
+)
@@ -0,0 +1,98 @@
+package fax
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Direction string
+
+const (
+	DirectionOutbound Direction = "OUTBOUND"
+	DirectionInbound  Direction = "INBOUND"
+)
+
+type Status string
+
+const (
+	StatusQueued     Status = "QUEUED"
+	StatusInProgress Status = "IN_PROGRESS"
+	StatusCompleted  Status = "COMPLETED"
+	StatusFailure    Status = "FAILURE"
+)
+
+// Callback content types, see SendFaxRequest.WithCallbackURL.
+const (
+	CallbackContentTypeJSON      = "application/json"
+	CallbackContentTypeMultipart = "multipart/form-data"
+)
+
+// Fax is a fax sent or received by the project.
+type Fax struct {
+	ID                  string            `json:"id"`
+	Direction           Direction         `json:"direction"`
+	From                string            `json:"from,omitempty"`
+	To                  string            `json:"to"`
+	ContentURLs         []string          `json:"contentUrl,omitempty"`
+	NumberOfPages       int               `json:"numberOfPages"`
+	Status              Status            `json:"status"`
+	Price               *Money            `json:"price,omitempty"`
+	CreateTime          sinch.Time        `json:"createTime"`
+	CompletedTime       *sinch.Time       `json:"completedTime,omitempty"`
+	HeaderText          string            `json:"headerText,omitempty"`
+	HeaderPageNumbers   bool              `json:"headerPageNumbers,omitempty"`
+	HeaderTimeZone      string            `json:"headerTimeZone,omitempty"`
+	RetryDelaySeconds   int               `json:"retryDelaySeconds,omitempty"`
+	CoverPageID         string            `json:"coverPageId,omitempty"`
+	CoverPageData       map[string]string `json:"coverPageData,omitempty"`
+	MaxRetries          int               `json:"maxRetries,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	CallbackURL         string            `json:"callbackUrl,omitempty"`
+	CallbackContentType string            `json:"callbackUrlContentType,omitempty"`
+	ImageConversion     string            `json:"imageConversionMethod,omitempty"` // HALFTONE or MONOCHROME.
+	ErrorType           string            `json:"errorType,omitempty"`             // Set when Status is FAILURE.
+	ErrorID             int               `json:"errorId,omitempty"`
+	ErrorCode           string            `json:"errorCode,omitempty"`
+	ErrorMessage        string            `json:"errorMessage,omitempty"`
+	ProjectID           string            `json:"projectId"`
+	ServiceID           string            `json:"serviceId"`
+	MaxPages            int               `json:"maxNumberOfPages,omitempty"`
+	HasFile             bool              `json:"hasFile"` // False once the content of the fax has been deleted.
+}
+
+type Money struct {
+	Amount       string `json:"amount"`
+	CurrencyCode string `json:"currencyCode"`
+}
+
+// Failed returns true if the fax could not be delivered.
+func (f *Fax) Failed() bool {
+	return f.Status == StatusFailure
+}
+
+func (f *Fax) FromJSON(data []byte) error {
+	return json.Unmarshal(data, f)
+}
+
+func queryString(v interface{}) (string, error) {
+	values, err := query.Values(v)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
+
+// nextPage returns the token of the page after page, or an empty string if it is the last one. Pages are numbered from
+// 1.
+func nextPage(page, totalPages int) string {
+	if page >= totalPages {
+		return ""
+	}
+	return strconv.Itoa(page + 1)
+}
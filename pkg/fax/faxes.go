@@ -0,0 +1,251 @@
+package fax
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type GetFaxAction struct {
+	request  *GetFaxRequest
+	response *Fax
+}
+
+func (gfa *GetFaxAction) Request() *GetFaxRequest {
+	return gfa.request
+}
+
+func (gfa *GetFaxAction) Response() *Fax {
+	return gfa.response
+}
+
+// GetFaxRequest fetches a fax.
+type GetFaxRequest struct {
+	FaxID string
+}
+
+func (gfr *GetFaxRequest) WithFaxID(faxID string) *GetFaxRequest {
+	gfr.FaxID = faxID
+	return gfr
+}
+
+func (gfr *GetFaxRequest) Validate() error {
+	if gfr.FaxID == "" {
+		return FaxIDRequiredError
+	}
+	return nil
+}
+
+func (gfr *GetFaxRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gfr *GetFaxRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gfr *GetFaxRequest) Path() string {
+	return "/faxes/" + url.PathEscape(gfr.FaxID)
+}
+
+func (gfr *GetFaxRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gfr *GetFaxRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListFaxesAction struct {
+	request  *ListFaxesRequest
+	response *ListFaxesResponse
+}
+
+func (lfa *ListFaxesAction) Request() *ListFaxesRequest {
+	return lfa.request
+}
+
+func (lfa *ListFaxesAction) Response() *ListFaxesResponse {
+	return lfa.response
+}
+
+// ListFaxesRequest lists the faxes of the project, optionally filtered by direction, status, numbers and service.
+type ListFaxesRequest struct {
+	Direction Direction `url:"direction,omitempty"`
+	Status    Status    `url:"status,omitempty"`
+	To        string    `url:"to,omitempty"`
+	From      string    `url:"from,omitempty"`
+	ServiceID string    `url:"serviceId,omitempty"`
+	PageSize  int       `url:"pageSize,omitempty"`
+	Page      int       `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListFaxesResponse struct {
+	Faxes      []Fax `json:"faxes"`
+	PageNumber int   `json:"pageNumber"`
+	PageSize   int   `json:"pageSize"`
+	TotalItems int   `json:"totalItems"`
+	TotalPages int   `json:"totalPages"`
+}
+
+func (lfr *ListFaxesRequest) WithDirection(direction Direction) *ListFaxesRequest {
+	lfr.Direction = direction
+	return lfr
+}
+
+func (lfr *ListFaxesRequest) WithStatus(status Status) *ListFaxesRequest {
+	lfr.Status = status
+	return lfr
+}
+
+func (lfr *ListFaxesRequest) WithTo(to string) *ListFaxesRequest {
+	lfr.To = to
+	return lfr
+}
+
+func (lfr *ListFaxesRequest) WithFrom(from string) *ListFaxesRequest {
+	lfr.From = from
+	return lfr
+}
+
+func (lfr *ListFaxesRequest) WithServiceID(serviceID string) *ListFaxesRequest {
+	lfr.ServiceID = serviceID
+	return lfr
+}
+
+func (lfr *ListFaxesRequest) WithPageSize(pageSize int) *ListFaxesRequest {
+	lfr.PageSize = pageSize
+	return lfr
+}
+
+// SetPageToken sets the page number from a token returned by ListFaxesResponse.NextPageToken.
+func (lfr *ListFaxesRequest) SetPageToken(token string) {
+	lfr.Page, _ = strconv.Atoi(token)
+}
+
+func (lfr *ListFaxesRequest) Validate() error {
+	if lfr.PageSize < 0 || lfr.PageSize > 1000 {
+		return InvalidPageSizeError
+	}
+	return nil
+}
+
+func (lfr *ListFaxesRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lfr *ListFaxesRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lfr *ListFaxesRequest) Path() string {
+	return "/faxes"
+}
+
+func (lfr *ListFaxesRequest) QueryString() (string, error) {
+	return queryString(lfr)
+}
+
+func (lfr *ListFaxesRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lfr *ListFaxesResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lfr)
+}
+
+func (lfr *ListFaxesResponse) Items() []Fax {
+	return lfr.Faxes
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (lfr *ListFaxesResponse) NextPageToken() string {
+	return nextPage(lfr.PageNumber, lfr.TotalPages)
+}
+
+// Document is the content of a fax. It is the response of DownloadFaxRequest, which is not JSON.
+type Document struct {
+	Data []byte
+}
+
+// FromJSON stores data as is, since the response is a PDF document.
+func (d *Document) FromJSON(data []byte) error {
+	d.Data = append([]byte(nil), data...)
+	return nil
+}
+
+// DownloadFaxRequest downloads the content of a fax as a PDF document. The response is a Document.
+type DownloadFaxRequest struct {
+	FaxID string
+}
+
+func (dfr *DownloadFaxRequest) WithFaxID(faxID string) *DownloadFaxRequest {
+	dfr.FaxID = faxID
+	return dfr
+}
+
+func (dfr *DownloadFaxRequest) Validate() error {
+	if dfr.FaxID == "" {
+		return FaxIDRequiredError
+	}
+	return nil
+}
+
+func (dfr *DownloadFaxRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (dfr *DownloadFaxRequest) Method() string {
+	return http.MethodGet
+}
+
+func (dfr *DownloadFaxRequest) Path() string {
+	return "/faxes/" + url.PathEscape(dfr.FaxID) + "/file.pdf"
+}
+
+func (dfr *DownloadFaxRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dfr *DownloadFaxRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+// DeleteFaxContentRequest deletes the content of a fax from Sinch's storage. The fax itself is kept. The response has no
+// content, use sinch.NoContent.
+type DeleteFaxContentRequest struct {
+	FaxID string
+}
+
+func (dfcr *DeleteFaxContentRequest) WithFaxID(faxID string) *DeleteFaxContentRequest {
+	dfcr.FaxID = faxID
+	return dfcr
+}
+
+func (dfcr *DeleteFaxContentRequest) Validate() error {
+	if dfcr.FaxID == "" {
+		return FaxIDRequiredError
+	}
+	return nil
+}
+
+func (dfcr *DeleteFaxContentRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dfcr *DeleteFaxContentRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dfcr *DeleteFaxContentRequest) Path() string {
+	return "/faxes/" + url.PathEscape(dfcr.FaxID) + "/file"
+}
+
+func (dfcr *DeleteFaxContentRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dfcr *DeleteFaxContentRequest) Body() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,119 @@
+package fax
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Faxes_Implementations(t *testing.T) {
+	var _ sinch.Action[*GetFaxRequest, *Fax] = new(GetFaxAction)
+	var _ sinch.Action[*ListFaxesRequest, *ListFaxesResponse] = new(ListFaxesAction)
+	var _ sinch.ListRequest = new(ListFaxesRequest)
+	var _ sinch.ListResponse[Fax] = new(ListFaxesResponse)
+	var _ sinch.APIRequest = new(DownloadFaxRequest)
+	var _ sinch.APIResponse = new(Document)
+	var _ sinch.APIRequest = new(DeleteFaxContentRequest)
+}
+
+func Test_FaxRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantStatus int
+	}{
+		"get without id": {
+			req:     new(GetFaxRequest),
+			wantErr: FaxIDRequiredError,
+		},
+		"get": {
+			req:        new(GetFaxRequest).WithFaxID("fax"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/faxes/fax",
+			wantStatus: http.StatusOK,
+		},
+		"list": {
+			req:        new(ListFaxesRequest).WithStatus(StatusFailure).WithTo("+12025550101").WithPageSize(50),
+			wantMethod: http.MethodGet,
+			wantPath:   "/faxes",
+			wantQuery:  "?pageSize=50&status=FAILURE&to=%2B12025550101",
+			wantStatus: http.StatusOK,
+		},
+		"list with invalid page size": {
+			req:     new(ListFaxesRequest).WithPageSize(1001),
+			wantErr: InvalidPageSizeError,
+		},
+		"download without id": {
+			req:     new(DownloadFaxRequest),
+			wantErr: FaxIDRequiredError,
+		},
+		"download": {
+			req:        new(DownloadFaxRequest).WithFaxID("fax"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/faxes/fax/file.pdf",
+			wantStatus: http.StatusOK,
+		},
+		"delete content": {
+			req:        new(DeleteFaxContentRequest).WithFaxID("fax"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/faxes/fax/file",
+			wantStatus: http.StatusNoContent,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			assert.Equal(t, tt.wantStatus, tt.req.ExpectedStatusCode())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+		})
+	}
+}
+
+func Test_ListFaxesResponse_NextPageToken(t *testing.T) {
+	tests := map[string]struct {
+		resp *ListFaxesResponse
+		want string
+	}{
+		"first of two": {resp: &ListFaxesResponse{PageNumber: 1, TotalPages: 2}, want: "2"},
+		"last":         {resp: &ListFaxesResponse{PageNumber: 2, TotalPages: 2}, want: ""},
+		"empty":        {resp: &ListFaxesResponse{PageNumber: 1, TotalPages: 0}, want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resp.NextPageToken())
+		})
+	}
+}
+
+func Test_Fax_FromJSON(t *testing.T) {
+	f := new(Fax)
+	assert.NoError(t, f.FromJSON([]byte(`{"id": "fax", "direction": "OUTBOUND", "to": "+12025550101", "numberOfPages": 2, "status": "FAILURE",
+		"price": {"amount": "0.14", "currencyCode": "USD"}, "createTime": "2023-04-01T10:00:00Z", "completedTime": "2023-04-01T10:02:00Z",
+		"errorType": "CALL_ERROR", "errorCode": "NO_ANSWER", "hasFile": true}`)))
+	assert.True(t, f.Failed())
+	assert.Equal(t, "0.14", f.Price.Amount)
+	assert.Equal(t, "NO_ANSWER", f.ErrorCode)
+	assert.Equal(t, 2, f.CompletedTime.Minute())
+}
+
+func Test_Document_FromJSON(t *testing.T) {
+	data := []byte("%PDF-1.4")
+	d := new(Document)
+	assert.NoError(t, d.FromJSON(data))
+	data[0] = 'x'
+	assert.Equal(t, "%PDF-1.4", string(d.Data))
+}
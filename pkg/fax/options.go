@@ -0,0 +1,29 @@
+package fax
+
+import "github.com/thezmc/go-sinch/pkg/api"
+
+// Option configures a Client created with New.
+type Option = api.ServiceOption[*Client]
+
+// New returns a client that sends requests to BaseURLv3 with api.DefaultHTTPClient unless configured otherwise. It
+// returns an error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	return api.NewService(WithBaseURL(BaseURLv3), opts...)
+}
+
+var (
+	// WithProjectID sets the project ID.
+	WithProjectID = api.WithServiceProjectID[*Client]
+	// WithKey sets the ID and secret of the access key used for basic authentication.
+	WithKey = api.WithServiceKey[*Client]
+	// WithAuthenticator authenticates requests with a instead of the access key.
+	WithAuthenticator = api.WithServiceAuthenticator[*Client]
+	// WithTokenSource authenticates requests with OAuth2 access tokens from ts instead of the access key.
+	WithTokenSource = api.WithServiceTokenSource[*Client]
+	// WithSinchAPI sends requests with sinchAPI, see api.WithServiceSinchAPI.
+	WithSinchAPI = api.WithServiceSinchAPI[*Client]
+	// WithBaseURL sets the base URL.
+	WithBaseURL = api.WithServiceBaseURL[*Client]
+	// WithHTTPClient sets the HTTP client used to send requests.
+	WithHTTPClient = api.WithServiceHTTPClient[*Client]
+)
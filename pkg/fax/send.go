@@ -0,0 +1,250 @@
+package fax
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type SendFaxAction struct {
+	request  *SendFaxRequest
+	response *Fax
+}
+
+func (sfa *SendFaxAction) Request() *SendFaxRequest {
+	return sfa.request
+}
+
+func (sfa *SendFaxAction) Response() *Fax {
+	return sfa.response
+}
+
+// File is a document uploaded with a fax, like a PDF or a Word document.
+type File struct {
+	Name        string
+	ContentType string // Detected from Data when empty.
+	Data        []byte
+}
+
+// SendFaxRequest sends a fax. The content is either fetched by Sinch from ContentURLs or uploaded with Files, in which
+// case the request is sent as multipart/form-data. The response is the queued fax.
+type SendFaxRequest struct {
+	To                  string            `json:"to"`
+	From                string            `json:"from,omitempty"`
+	ContentURLs         []string          `json:"contentUrl,omitempty"`
+	Files               []File            `json:"-"`
+	ServiceID           string            `json:"serviceId,omitempty"`
+	HeaderText          string            `json:"headerText,omitempty"`
+	HeaderPageNumbers   bool              `json:"headerPageNumbers,omitempty"`
+	HeaderTimeZone      string            `json:"headerTimeZone,omitempty"` // IANA time zone, e.g. America/New_York.
+	CoverPageID         string            `json:"coverPageId,omitempty"`
+	CoverPageData       map[string]string `json:"coverPageData,omitempty"` // Values of the cover page's placeholders.
+	MaxRetries          int               `json:"maxRetries,omitempty"`
+	RetryDelaySeconds   int               `json:"retryDelaySeconds,omitempty"`
+	Labels              map[string]string `json:"labels,omitempty"`
+	CallbackURL         string            `json:"callbackUrl,omitempty"`
+	CallbackContentType string            `json:"callbackUrlContentType,omitempty"`
+	ImageConversion     string            `json:"imageConversionMethod,omitempty"`
+
+	boundary string
+}
+
+func (sfr *SendFaxRequest) WithTo(to string) *SendFaxRequest {
+	sfr.To = to
+	return sfr
+}
+
+func (sfr *SendFaxRequest) WithFrom(from string) *SendFaxRequest {
+	sfr.From = from
+	return sfr
+}
+
+// WithContentURLs adds URLs of documents for Sinch to fetch and send. Basic auth credentials may be included in the
+// URLs.
+func (sfr *SendFaxRequest) WithContentURLs(urls ...string) *SendFaxRequest {
+	sfr.ContentURLs = append(sfr.ContentURLs, urls...)
+	return sfr
+}
+
+// WithFile adds a document to upload with the fax.
+func (sfr *SendFaxRequest) WithFile(name string, data []byte) *SendFaxRequest {
+	sfr.Files = append(sfr.Files, File{Name: name, Data: data})
+	return sfr
+}
+
+func (sfr *SendFaxRequest) WithServiceID(serviceID string) *SendFaxRequest {
+	sfr.ServiceID = serviceID
+	return sfr
+}
+
+func (sfr *SendFaxRequest) WithHeaderText(text string) *SendFaxRequest {
+	sfr.HeaderText = text
+	return sfr
+}
+
+// WithCoverPage sends the fax with a cover page of the service, filling its placeholders with data.
+func (sfr *SendFaxRequest) WithCoverPage(coverPageID string, data map[string]string) *SendFaxRequest {
+	sfr.CoverPageID = coverPageID
+	sfr.CoverPageData = data
+	return sfr
+}
+
+func (sfr *SendFaxRequest) WithMaxRetries(maxRetries int) *SendFaxRequest {
+	sfr.MaxRetries = maxRetries
+	return sfr
+}
+
+func (sfr *SendFaxRequest) WithLabel(key, value string) *SendFaxRequest {
+	if sfr.Labels == nil {
+		sfr.Labels = make(map[string]string)
+	}
+	sfr.Labels[key] = value
+	return sfr
+}
+
+// WithCallbackURL sets the URL the FAX_COMPLETED event is posted to, with CallbackContentTypeJSON or
+// CallbackContentTypeMultipart. An empty content type leaves the choice to the API.
+func (sfr *SendFaxRequest) WithCallbackURL(callbackURL, contentType string) *SendFaxRequest {
+	sfr.CallbackURL = callbackURL
+	sfr.CallbackContentType = contentType
+	return sfr
+}
+
+func (sfr *SendFaxRequest) Validate() error {
+	if !sinch.IsE164(sfr.To) {
+		return InvalidNumberError
+	}
+	if len(sfr.ContentURLs) == 0 && len(sfr.Files) == 0 {
+		return ContentRequiredError
+	}
+	if len(sfr.ContentURLs) > 0 && len(sfr.Files) > 0 {
+		return MixedContentError
+	}
+	switch sfr.CallbackContentType {
+	case "", CallbackContentTypeJSON, CallbackContentTypeMultipart:
+	default:
+		return InvalidCallbackContentTypeError
+	}
+	return nil
+}
+
+func (sfr *SendFaxRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (sfr *SendFaxRequest) Method() string {
+	return http.MethodPost
+}
+
+func (sfr *SendFaxRequest) Path() string {
+	return "/faxes"
+}
+
+func (sfr *SendFaxRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+// ContentType returns the multipart content type when the request uploads files.
+func (sfr *SendFaxRequest) ContentType() string {
+	if len(sfr.Files) == 0 {
+		return "application/json"
+	}
+	return "multipart/form-data; boundary=" + sfr.multipartBoundary()
+}
+
+func (sfr *SendFaxRequest) multipartBoundary() string {
+	if sfr.boundary == "" {
+		sfr.boundary = multipart.NewWriter(nil).Boundary()
+	}
+	return sfr.boundary
+}
+
+func (sfr *SendFaxRequest) Body() ([]byte, error) {
+	if len(sfr.Files) == 0 {
+		return json.Marshal(sfr)
+	}
+
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	if err := w.SetBoundary(sfr.multipartBoundary()); err != nil {
+		return nil, err
+	}
+	fields := []struct{ name, value string }{
+		{"to", sfr.To},
+		{"from", sfr.From},
+		{"serviceId", sfr.ServiceID},
+		{"headerText", sfr.HeaderText},
+		{"headerTimeZone", sfr.HeaderTimeZone},
+		{"coverPageId", sfr.CoverPageID},
+		{"callbackUrl", sfr.CallbackURL},
+		{"callbackUrlContentType", sfr.CallbackContentType},
+		{"imageConversionMethod", sfr.ImageConversion},
+	}
+	if sfr.HeaderPageNumbers {
+		fields = append(fields, struct{ name, value string }{"headerPageNumbers", "true"})
+	}
+	if sfr.MaxRetries != 0 {
+		fields = append(fields, struct{ name, value string }{"maxRetries", strconv.Itoa(sfr.MaxRetries)})
+	}
+	if sfr.RetryDelaySeconds != 0 {
+		fields = append(fields, struct{ name, value string }{"retryDelaySeconds", strconv.Itoa(sfr.RetryDelaySeconds)})
+	}
+	for _, field := range fields {
+		if field.value == "" {
+			continue
+		}
+		if err := w.WriteField(field.name, field.value); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeMapFields(w, "coverPageData", sfr.CoverPageData); err != nil {
+		return nil, err
+	}
+	if err := writeMapFields(w, "labels", sfr.Labels); err != nil {
+		return nil, err
+	}
+	for _, file := range sfr.Files {
+		contentType := file.ContentType
+		if contentType == "" {
+			contentType = http.DetectContentType(file.Data)
+		}
+		header := make(textproto.MIMEHeader)
+		header.Set("Content-Disposition", `form-data; name="file"; filename="`+quoteEscaper.Replace(file.Name)+`"`)
+		header.Set("Content-Type", contentType)
+		part, err := w.CreatePart(header)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := part.Write(file.Data); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+var quoteEscaper = strings.NewReplacer("\\", "\\\\", `"`, "\\\"")
+
+// writeMapFields writes the entries of m as name[key] fields, sorted by key.
+func writeMapFields(w *multipart.Writer, name string, m map[string]string) error {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if err := w.WriteField(name+"["+key+"]", m[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
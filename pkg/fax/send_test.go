@@ -0,0 +1,87 @@
+package fax
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"mime/multipart"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Send_Implementations(t *testing.T) {
+	var _ sinch.Action[*SendFaxRequest, *Fax] = new(SendFaxAction)
+	var _ sinch.APIRequest = new(SendFaxRequest)
+	var _ sinch.ContentTyper = new(SendFaxRequest)
+}
+
+func Test_SendFaxRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		req     *SendFaxRequest
+		wantErr error
+	}{
+		"invalid number":    {req: new(SendFaxRequest).WithTo("12025550101").WithContentURLs("https://example.com/a.pdf"), wantErr: InvalidNumberError},
+		"no content":        {req: new(SendFaxRequest).WithTo("+12025550101"), wantErr: ContentRequiredError},
+		"urls and files":    {req: new(SendFaxRequest).WithTo("+12025550101").WithContentURLs("https://example.com/a.pdf").WithFile("b.pdf", []byte("b")), wantErr: MixedContentError},
+		"bad callback type": {req: new(SendFaxRequest).WithTo("+12025550101").WithFile("b.pdf", []byte("b")).WithCallbackURL("https://example.com", "text/xml"), wantErr: InvalidCallbackContentTypeError},
+		"urls":              {req: new(SendFaxRequest).WithTo("+12025550101").WithContentURLs("https://example.com/a.pdf")},
+		"files":             {req: new(SendFaxRequest).WithTo("+12025550101").WithFile("b.pdf", []byte("b")).WithCallbackURL("https://example.com", CallbackContentTypeMultipart)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func Test_SendFaxRequest_JSONBody(t *testing.T) {
+	req := new(SendFaxRequest).WithTo("+12025550101").WithContentURLs("https://example.com/a.pdf").WithCoverPage("cover", map[string]string{"name": "Ada"})
+	assert.Equal(t, "application/json", req.ContentType())
+
+	body, err := req.Body()
+	assert.NoError(t, err)
+	var got map[string]interface{}
+	assert.NoError(t, json.Unmarshal(body, &got))
+	assert.Equal(t, "+12025550101", got["to"])
+	assert.Equal(t, []interface{}{"https://example.com/a.pdf"}, got["contentUrl"])
+	assert.Equal(t, map[string]interface{}{"name": "Ada"}, got["coverPageData"])
+	assert.NotContains(t, got, "Files")
+}
+
+func Test_SendFaxRequest_MultipartBody(t *testing.T) {
+	req := new(SendFaxRequest).WithTo("+12025550101").WithFrom("+12025550100").WithMaxRetries(2).
+		WithCoverPage("cover", map[string]string{"name": "Ada"}).
+		WithFile("a.pdf", []byte("%PDF-1.4")).WithFile("b.txt", []byte("hello"))
+
+	mediaType, params, err := mime.ParseMediaType(req.ContentType())
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/form-data", mediaType)
+	assert.Equal(t, req.ContentType(), req.ContentType(), "the boundary must not change between calls")
+
+	body, err := req.Body()
+	assert.NoError(t, err)
+
+	form, err := multipart.NewReader(bytes.NewReader(body), params["boundary"]).ReadForm(1 << 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+12025550101"}, form.Value["to"])
+	assert.Equal(t, []string{"+12025550100"}, form.Value["from"])
+	assert.Equal(t, []string{"2"}, form.Value["maxRetries"])
+	assert.Equal(t, []string{"cover"}, form.Value["coverPageId"])
+	assert.Equal(t, []string{"Ada"}, form.Value["coverPageData[name]"])
+	assert.NotContains(t, form.Value, "headerText")
+
+	files := form.File["file"]
+	if assert.Len(t, files, 2) {
+		assert.Equal(t, "a.pdf", files[0].Filename)
+		assert.Equal(t, "application/pdf", files[0].Header.Get("Content-Type"))
+		assert.Equal(t, "b.txt", files[1].Filename)
+		f, err := files[1].Open()
+		assert.NoError(t, err)
+		data, _ := io.ReadAll(f)
+		assert.Equal(t, "hello", string(data))
+	}
+}
@@ -0,0 +1,338 @@
+package fax
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// Service holds the default settings of the faxes sent and received with its numbers.
+type Service struct {
+	ID                 string `json:"id,omitempty"`
+	Name               string `json:"name,omitempty"`
+	IncomingWebhookURL string `json:"incomingWebhookUrl,omitempty"` // Receives the INCOMING_FAX events of the service.
+	WebhookContentType string `json:"webhookContentType,omitempty"` // CallbackContentTypeJSON or CallbackContentTypeMultipart.
+	DefaultForProject  bool   `json:"defaultForProject,omitempty"`
+	DefaultFrom        string `json:"defaultFrom,omitempty"`
+	NumberOfRetries    int    `json:"numberOfRetries,omitempty"`
+	RetryDelaySeconds  int    `json:"retryDelaySeconds,omitempty"`
+	ImageConversion    string `json:"imageConversionMethod,omitempty"`
+	SaveOutboundFaxes  bool   `json:"saveOutboundFaxDocuments,omitempty"`
+	SaveInboundFaxes   bool   `json:"saveInboundFaxDocuments,omitempty"`
+}
+
+func (s *Service) WithID(id string) *Service {
+	s.ID = id
+	return s
+}
+
+func (s *Service) WithName(name string) *Service {
+	s.Name = name
+	return s
+}
+
+// WithIncomingWebhook sets the URL incoming faxes are posted to, with CallbackContentTypeJSON or
+// CallbackContentTypeMultipart.
+func (s *Service) WithIncomingWebhook(webhookURL, contentType string) *Service {
+	s.IncomingWebhookURL = webhookURL
+	s.WebhookContentType = contentType
+	return s
+}
+
+func (s *Service) WithDefaultFrom(from string) *Service {
+	s.DefaultFrom = from
+	return s
+}
+
+func (s *Service) WithRetries(numberOfRetries, retryDelaySeconds int) *Service {
+	s.NumberOfRetries = numberOfRetries
+	s.RetryDelaySeconds = retryDelaySeconds
+	return s
+}
+
+// AsDefault makes the service the one used by faxes sent without a service ID.
+func (s *Service) AsDefault() *Service {
+	s.DefaultForProject = true
+	return s
+}
+
+func (s *Service) validate() error {
+	switch s.WebhookContentType {
+	case "", CallbackContentTypeJSON, CallbackContentTypeMultipart:
+		return nil
+	}
+	return InvalidCallbackContentTypeError
+}
+
+func (s *Service) FromJSON(data []byte) error {
+	return json.Unmarshal(data, s)
+}
+
+type CreateServiceAction struct {
+	request  *CreateServiceRequest
+	response *Service
+}
+
+func (csa *CreateServiceAction) Request() *CreateServiceRequest {
+	return csa.request
+}
+
+func (csa *CreateServiceAction) Response() *Service {
+	return csa.response
+}
+
+// CreateServiceRequest creates a service. The response is the created service.
+type CreateServiceRequest struct {
+	Service *Service
+}
+
+func (csr *CreateServiceRequest) WithService(service *Service) *CreateServiceRequest {
+	csr.Service = service
+	return csr
+}
+
+func (csr *CreateServiceRequest) Validate() error {
+	if csr.Service == nil {
+		return ServiceRequiredError
+	}
+	return csr.Service.validate()
+}
+
+func (csr *CreateServiceRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (csr *CreateServiceRequest) Method() string {
+	return http.MethodPost
+}
+
+func (csr *CreateServiceRequest) Path() string {
+	return "/services"
+}
+
+func (csr *CreateServiceRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (csr *CreateServiceRequest) Body() ([]byte, error) {
+	return json.Marshal(csr.Service)
+}
+
+type GetServiceAction struct {
+	request  *GetServiceRequest
+	response *Service
+}
+
+func (gsa *GetServiceAction) Request() *GetServiceRequest {
+	return gsa.request
+}
+
+func (gsa *GetServiceAction) Response() *Service {
+	return gsa.response
+}
+
+// GetServiceRequest fetches a service.
+type GetServiceRequest struct {
+	ServiceID string
+}
+
+func (gsr *GetServiceRequest) WithServiceID(serviceID string) *GetServiceRequest {
+	gsr.ServiceID = serviceID
+	return gsr
+}
+
+func (gsr *GetServiceRequest) Validate() error {
+	if gsr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	return nil
+}
+
+func (gsr *GetServiceRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gsr *GetServiceRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gsr *GetServiceRequest) Path() string {
+	return "/services/" + url.PathEscape(gsr.ServiceID)
+}
+
+func (gsr *GetServiceRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gsr *GetServiceRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListServicesAction struct {
+	request  *ListServicesRequest
+	response *ListServicesResponse
+}
+
+func (lsa *ListServicesAction) Request() *ListServicesRequest {
+	return lsa.request
+}
+
+func (lsa *ListServicesAction) Response() *ListServicesResponse {
+	return lsa.response
+}
+
+// ListServicesRequest lists the services of the project.
+type ListServicesRequest struct {
+	PageSize int `url:"pageSize,omitempty"`
+	Page     int `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListServicesResponse struct {
+	Services   []Service `json:"services"`
+	PageNumber int       `json:"pageNumber"`
+	PageSize   int       `json:"pageSize"`
+	TotalItems int       `json:"totalItems"`
+	TotalPages int       `json:"totalPages"`
+}
+
+func (lsr *ListServicesRequest) WithPageSize(pageSize int) *ListServicesRequest {
+	lsr.PageSize = pageSize
+	return lsr
+}
+
+// SetPageToken sets the page number from a token returned by ListServicesResponse.NextPageToken.
+func (lsr *ListServicesRequest) SetPageToken(token string) {
+	lsr.Page, _ = strconv.Atoi(token)
+}
+
+func (lsr *ListServicesRequest) Validate() error {
+	if lsr.PageSize < 0 || lsr.PageSize > 1000 {
+		return InvalidPageSizeError
+	}
+	return nil
+}
+
+func (lsr *ListServicesRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lsr *ListServicesRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lsr *ListServicesRequest) Path() string {
+	return "/services"
+}
+
+func (lsr *ListServicesRequest) QueryString() (string, error) {
+	return queryString(lsr)
+}
+
+func (lsr *ListServicesRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lsr *ListServicesResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lsr)
+}
+
+func (lsr *ListServicesResponse) Items() []Service {
+	return lsr.Services
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (lsr *ListServicesResponse) NextPageToken() string {
+	return nextPage(lsr.PageNumber, lsr.TotalPages)
+}
+
+type UpdateServiceAction struct {
+	request  *UpdateServiceRequest
+	response *Service
+}
+
+func (usa *UpdateServiceAction) Request() *UpdateServiceRequest {
+	return usa.request
+}
+
+func (usa *UpdateServiceAction) Response() *Service {
+	return usa.response
+}
+
+// UpdateServiceRequest updates the fields set in Service of the service with its ID. The response is the updated
+// service.
+type UpdateServiceRequest struct {
+	Service *Service
+}
+
+func (usr *UpdateServiceRequest) WithService(service *Service) *UpdateServiceRequest {
+	usr.Service = service
+	return usr
+}
+
+func (usr *UpdateServiceRequest) Validate() error {
+	if usr.Service == nil {
+		return ServiceRequiredError
+	}
+	if usr.Service.ID == "" {
+		return ServiceIDRequiredError
+	}
+	return usr.Service.validate()
+}
+
+func (usr *UpdateServiceRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (usr *UpdateServiceRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (usr *UpdateServiceRequest) Path() string {
+	return "/services/" + url.PathEscape(usr.Service.ID)
+}
+
+func (usr *UpdateServiceRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (usr *UpdateServiceRequest) Body() ([]byte, error) {
+	return json.Marshal(usr.Service)
+}
+
+// DeleteServiceRequest deletes a service. The response has no content, use sinch.NoContent.
+type DeleteServiceRequest struct {
+	ServiceID string
+}
+
+func (dsr *DeleteServiceRequest) WithServiceID(serviceID string) *DeleteServiceRequest {
+	dsr.ServiceID = serviceID
+	return dsr
+}
+
+func (dsr *DeleteServiceRequest) Validate() error {
+	if dsr.ServiceID == "" {
+		return ServiceIDRequiredError
+	}
+	return nil
+}
+
+func (dsr *DeleteServiceRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dsr *DeleteServiceRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dsr *DeleteServiceRequest) Path() string {
+	return "/services/" + url.PathEscape(dsr.ServiceID)
+}
+
+func (dsr *DeleteServiceRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dsr *DeleteServiceRequest) Body() ([]byte, error) {
+	return nil, nil
+}
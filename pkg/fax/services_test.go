@@ -0,0 +1,96 @@
+package fax
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Services_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateServiceRequest, *Service] = new(CreateServiceAction)
+	var _ sinch.Action[*GetServiceRequest, *Service] = new(GetServiceAction)
+	var _ sinch.Action[*ListServicesRequest, *ListServicesResponse] = new(ListServicesAction)
+	var _ sinch.ListRequest = new(ListServicesRequest)
+	var _ sinch.ListResponse[Service] = new(ListServicesResponse)
+	var _ sinch.Action[*UpdateServiceRequest, *Service] = new(UpdateServiceAction)
+	var _ sinch.APIRequest = new(DeleteServiceRequest)
+}
+
+func Test_ServiceRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+		wantBody   string
+	}{
+		"create without service": {
+			req:     new(CreateServiceRequest),
+			wantErr: ServiceRequiredError,
+		},
+		"create with invalid webhook content type": {
+			req:     new(CreateServiceRequest).WithService(new(Service).WithIncomingWebhook("https://example.com", "text/xml")),
+			wantErr: InvalidCallbackContentTypeError,
+		},
+		"create": {
+			req:        new(CreateServiceRequest).WithService(new(Service).WithName("Billing").WithIncomingWebhook("https://example.com", CallbackContentTypeJSON).AsDefault()),
+			wantMethod: http.MethodPost,
+			wantPath:   "/services",
+			wantBody:   `{"name":"Billing","incomingWebhookUrl":"https://example.com","webhookContentType":"application/json","defaultForProject":true}`,
+		},
+		"get without id": {
+			req:     new(GetServiceRequest),
+			wantErr: ServiceIDRequiredError,
+		},
+		"get": {
+			req:        new(GetServiceRequest).WithServiceID("service"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/services/service",
+		},
+		"list": {
+			req:        new(ListServicesRequest).WithPageSize(10),
+			wantMethod: http.MethodGet,
+			wantPath:   "/services",
+			wantQuery:  "?pageSize=10",
+		},
+		"update without id": {
+			req:     new(UpdateServiceRequest).WithService(new(Service).WithName("Billing")),
+			wantErr: ServiceIDRequiredError,
+		},
+		"update": {
+			req:        new(UpdateServiceRequest).WithService(new(Service).WithID("service").WithRetries(3, 60)),
+			wantMethod: http.MethodPatch,
+			wantPath:   "/services/service",
+			wantBody:   `{"id":"service","numberOfRetries":3,"retryDelaySeconds":60}`,
+		},
+		"delete": {
+			req:        new(DeleteServiceRequest).WithServiceID("service"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/services/service",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Nil(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package fax
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// MaxWebhookBodySize limits the size of the events read by WebhookHandler. Events may include the fax document.
+const MaxWebhookBodySize = 32 << 20
+
+type EventType string
+
+const (
+	EventIncomingFax  EventType = "INCOMING_FAX"  // Posted to the incoming webhook URL of the service.
+	EventFaxCompleted EventType = "FAX_COMPLETED" // Posted to the callback URL of a sent fax.
+)
+
+// Event is the payload the Fax API posts to webhook URLs, as JSON or as multipart/form-data depending on the configured
+// content type.
+type Event struct {
+	Event     EventType  `json:"event"`
+	EventTime sinch.Time `json:"eventTime"`
+	Fax       Fax        `json:"fax"`
+	File      []byte     `json:"file,omitempty"`     // The fax document, if the service saves documents.
+	FileType  string     `json:"fileType,omitempty"` // Always PDF.
+}
+
+// EventHandlerFunc handles a Fax API event. Returning an error makes the webhook respond with a 500 so Sinch retries
+// the callback.
+type EventHandlerFunc func(ctx context.Context, event *Event) error
+
+// WebhookHandler is an http.Handler that receives Fax API events and dispatches them to the registered handlers. The
+// Fax API does not sign events, so the webhook URL should include basic auth credentials, see WithBasicAuth.
+type WebhookHandler struct {
+	Username string
+	Password string
+	handlers map[EventType]EventHandlerFunc
+	fallback EventHandlerFunc
+}
+
+// WithBasicAuth makes the handler reject events without these basic auth credentials, which Sinch sends when they are
+// part of the webhook URL.
+func (wh *WebhookHandler) WithBasicAuth(username, password string) *WebhookHandler {
+	wh.Username = username
+	wh.Password = password
+	return wh
+}
+
+// Handle registers fn as the handler for events of the given type.
+func (wh *WebhookHandler) Handle(eventType EventType, fn EventHandlerFunc) *WebhookHandler {
+	if wh.handlers == nil {
+		wh.handlers = make(map[EventType]EventHandlerFunc)
+	}
+	wh.handlers[eventType] = fn
+	return wh
+}
+
+// OnIncomingFax registers fn as the handler for received faxes.
+func (wh *WebhookHandler) OnIncomingFax(fn EventHandlerFunc) *WebhookHandler {
+	return wh.Handle(EventIncomingFax, fn)
+}
+
+// OnFaxCompleted registers fn as the handler for sent faxes that were delivered or failed.
+func (wh *WebhookHandler) OnFaxCompleted(fn EventHandlerFunc) *WebhookHandler {
+	return wh.Handle(EventFaxCompleted, fn)
+}
+
+// HandleAll registers fn as the handler for events without a handler of their own.
+func (wh *WebhookHandler) HandleAll(fn EventHandlerFunc) *WebhookHandler {
+	wh.fallback = fn
+	return wh
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	if !wh.authorized(r) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, MaxWebhookBodySize)
+	event, err := ParseEvent(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	fn, ok := wh.handlers[event.Event]
+	if !ok {
+		fn = wh.fallback
+	}
+	if fn != nil {
+		if err := fn(r.Context(), event); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+func (wh *WebhookHandler) authorized(r *http.Request) bool {
+	if wh.Username == "" && wh.Password == "" {
+		return true
+	}
+	username, password, ok := r.BasicAuth()
+	return ok &&
+		subtle.ConstantTimeCompare([]byte(username), []byte(wh.Username)) == 1 &&
+		subtle.ConstantTimeCompare([]byte(password), []byte(wh.Password)) == 1
+}
+
+// ParseEvent reads the event posted in r, either as JSON with a base64 encoded file or as multipart/form-data with the
+// fax as a JSON field and the document as a file part.
+func ParseEvent(r *http.Request) (*Event, error) {
+	mediaType, _, _ := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if mediaType != CallbackContentTypeMultipart {
+		event := new(Event)
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil {
+			return nil, err
+		}
+		return event, nil
+	}
+
+	if err := r.ParseMultipartForm(MaxWebhookBodySize); err != nil {
+		return nil, err
+	}
+	defer r.MultipartForm.RemoveAll()
+
+	event := &Event{
+		Event:    EventType(r.FormValue("event")),
+		FileType: r.FormValue("fileType"),
+	}
+	if eventTime := r.FormValue("eventTime"); eventTime != "" {
+		t, err := sinch.ParseTime(eventTime)
+		if err != nil {
+			return nil, err
+		}
+		event.EventTime = t
+	}
+	if err := json.Unmarshal([]byte(r.FormValue("fax")), &event.Fax); err != nil {
+		return nil, err
+	}
+	if file, _, err := r.FormFile("file"); err == nil {
+		defer file.Close()
+		if event.File, err = io.ReadAll(file); err != nil {
+			return nil, err
+		}
+	} else if err != http.ErrMissingFile {
+		return nil, err
+	}
+	return event, nil
+}
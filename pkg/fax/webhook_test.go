@@ -0,0 +1,183 @@
+package fax
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const faxJSON = `{"id": "fax", "direction": "INBOUND", "from": "+12025550100", "to": "+12025550101", "status": "COMPLETED", "numberOfPages": 1, "createTime": "2023-04-01T10:00:00Z"}`
+
+func multipartEvent(t *testing.T, event string, file []byte) (string, []byte) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	assert.NoError(t, w.WriteField("event", event))
+	assert.NoError(t, w.WriteField("eventTime", "2023-04-01T10:01:00Z"))
+	assert.NoError(t, w.WriteField("fax", faxJSON))
+	if file != nil {
+		part, err := w.CreateFormFile("file", "fax.pdf")
+		assert.NoError(t, err)
+		part.Write(file)
+	}
+	assert.NoError(t, w.Close())
+	return w.FormDataContentType(), buf.Bytes()
+}
+
+func Test_ParseEvent(t *testing.T) {
+	multipartType, multipartBody := multipartEvent(t, "INCOMING_FAX", []byte("%PDF-1.4"))
+	noFileType, noFileBody := multipartEvent(t, "FAX_COMPLETED", nil)
+
+	tests := map[string]struct {
+		contentType string
+		body        []byte
+		wantErr     bool
+		wantEvent   EventType
+		wantFile    string
+	}{
+		"json": {
+			contentType: "application/json",
+			body:        []byte(`{"event": "INCOMING_FAX", "eventTime": "2023-04-01T10:01:00Z", "fax": ` + faxJSON + `, "file": "JVBERi0xLjQ=", "fileType": "PDF"}`),
+			wantEvent:   EventIncomingFax,
+			wantFile:    "%PDF-1.4",
+		},
+		"json without content type": {
+			body:      []byte(`{"event": "FAX_COMPLETED", "eventTime": "2023-04-01T10:01:00Z", "fax": ` + faxJSON + `}`),
+			wantEvent: EventFaxCompleted,
+		},
+		"multipart": {
+			contentType: multipartType,
+			body:        multipartBody,
+			wantEvent:   EventIncomingFax,
+			wantFile:    "%PDF-1.4",
+		},
+		"multipart without file": {
+			contentType: noFileType,
+			body:        noFileBody,
+			wantEvent:   EventFaxCompleted,
+		},
+		"bad json": {
+			contentType: "application/json",
+			body:        []byte("{"),
+			wantErr:     true,
+		},
+		"bad multipart": {
+			contentType: "multipart/form-data; boundary=nope",
+			body:        []byte("garbage"),
+			wantErr:     true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodPost, "/fax", bytes.NewReader(tt.body))
+			if tt.contentType != "" {
+				r.Header.Set("Content-Type", tt.contentType)
+			}
+			event, err := ParseEvent(r)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantEvent, event.Event)
+			assert.Equal(t, "fax", event.Fax.ID)
+			assert.Equal(t, DirectionInbound, event.Fax.Direction)
+			assert.Equal(t, 1, event.EventTime.Minute())
+			assert.Equal(t, tt.wantFile, string(event.File))
+		})
+	}
+}
+
+func Test_WebhookHandler(t *testing.T) {
+	const body = `{"event": "INCOMING_FAX", "eventTime": "2023-04-01T10:01:00Z", "fax": ` + faxJSON + `}`
+
+	var received *Event
+	var fallbackCalled bool
+	handler := new(WebhookHandler).
+		WithBasicAuth("user", "pass").
+		OnIncomingFax(func(ctx context.Context, event *Event) error {
+			received = event
+			return nil
+		}).
+		HandleAll(func(ctx context.Context, event *Event) error {
+			fallbackCalled = true
+			return assert.AnError
+		})
+
+	tests := map[string]struct {
+		method         string
+		body           string
+		username       string
+		password       string
+		expectedStatus int
+	}{
+		"wrong method": {
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"missing credentials": {
+			method:         http.MethodPost,
+			body:           body,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"wrong credentials": {
+			method:         http.MethodPost,
+			body:           body,
+			username:       "user",
+			password:       "wrong",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"bad body": {
+			method:         http.MethodPost,
+			body:           "{",
+			username:       "user",
+			password:       "pass",
+			expectedStatus: http.StatusBadRequest,
+		},
+		"handler error": {
+			method:         http.MethodPost,
+			body:           strings.Replace(body, "INCOMING_FAX", "FAX_COMPLETED", 1),
+			username:       "user",
+			password:       "pass",
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"ok": {
+			method:         http.MethodPost,
+			body:           body,
+			username:       "user",
+			password:       "pass",
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			r := httptest.NewRequest(tt.method, "/fax", strings.NewReader(tt.body))
+			if tt.username != "" {
+				r.SetBasicAuth(tt.username, tt.password)
+			}
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	assert.True(t, fallbackCalled)
+	if assert.NotNil(t, received) {
+		assert.Equal(t, "+12025550100", received.Fax.From)
+	}
+}
+
+func Test_WebhookHandler_WithoutBasicAuth(t *testing.T) {
+	handler := new(WebhookHandler)
+	r := httptest.NewRequest(http.MethodPost, "/fax", strings.NewReader(`{"event": "INCOMING_FAX", "fax": `+faxJSON+`}`))
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+}
@@ -0,0 +1,24 @@
+// Package lookup is a client for the Sinch Number Lookup API, which returns the line type, carrier, porting and SIM
+// swap status of phone numbers.
+package lookup
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+type Client struct {
+	api.ProjectClient
+}
+
+const BaseURLv2 = "https://lookup.api.sinch.com/v2/projects"
+
+// Lookup looks up a number, see LookupRequest.
+func (c *Client) Lookup(ctx context.Context, req *LookupRequest) (*LookupResponse, error) {
+	resp := new(LookupResponse)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,73 @@
+package lookup
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing project": {opts: []Option{WithKey("key", "secret")}, wantErr: ProjectIDRequiredError},
+		"missing key id":  {opts: []Option{WithProjectID("project"), WithKey("", "secret")}, wantErr: KeyIDRequiredError},
+		"missing secret":  {opts: []Option{WithProjectID("project"), WithKey("key", "")}, wantErr: KeySecretRequiredError},
+		"basic auth":      {opts: []Option{WithProjectID("project"), WithKey("key", "secret")}},
+		"authenticator":   {opts: []Option{WithProjectID("project"), WithAuthenticator(auth.NewBearerToken("token"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, BaseURLv2+"/project", c.URL())
+	assert.Same(t, api.DefaultHTTPClient, c.API().HTTPClient)
+
+	shared := &api.Client{BaseURL: BaseURLv2, HTTPClient: http.DefaultClient}
+	c, err = New(WithProjectID("project"), WithKey("key", "secret"), WithSinchAPI(shared), WithBaseURL("http://localhost"))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/project", c.URL())
+	assert.Equal(t, BaseURLv2, shared.BaseURL)
+
+	_, err = New(WithKey("key", "secret"))
+	assert.ErrorIs(t, err, ProjectIDRequiredError)
+}
+
+func Test_Client_Lookup(t *testing.T) {
+	srv := newLookupServer(t, map[string]string{
+		"+12025550101": `{"number": "+12025550101", "countryCode": "US", "line": {"carrier": "T-Mobile USA", "type": "Mobile", "ported": true, "portingDate": "2021-05-04T00:00:00Z"}}`,
+	})
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	resp, err := c.Lookup(context.Background(), new(LookupRequest).WithNumber("+12025550101").WithFeatures(FeatureLineType))
+	assert.NoError(t, err)
+	assert.True(t, resp.IsMobile())
+	assert.Equal(t, "T-Mobile USA", resp.Line.Carrier)
+	assert.True(t, resp.Line.Ported)
+	assert.Equal(t, 2021, resp.Line.PortingDate.Year())
+
+	_, err = c.Lookup(context.Background(), new(LookupRequest).WithNumber("+12025550199"))
+	code, _ := api.StatusCode(err)
+	assert.Equal(t, http.StatusNotFound, code)
+}
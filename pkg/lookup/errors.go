@@ -0,0 +1,15 @@
+package lookup
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ProjectIDRequiredError = api.ProjectIDRequiredError
+	KeyIDRequiredError     = api.KeyIDRequiredError
+	KeySecretRequiredError = api.KeySecretRequiredError
+	NumberRequiredError    = sinch.Error("number is required")
+	InvalidNumberError     = sinch.Error("number must be in E.164 format")
+	InvalidFeatureError    = sinch.Error("feature must be one of LineType, SimSwap, VoIPDetection or RNDDetection")
+)
@@ -0,0 +1,135 @@
+package lookup
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const DefaultFilterConcurrency = 4
+
+// MobileFilter drops recipients whose line type is not mobile, so batches are not sent to landlines. It implements
+// sms.RecipientFilter:
+//
+//	smsClient.WithRecipientFilter(lookup.NewMobileFilter(lookupClient))
+//
+// Every recipient is looked up with FeatureLineType, which is billed per lookup. Recipients that are not phone
+// numbers, like group IDs, are kept.
+type MobileFilter struct {
+	Client      *Client
+	Concurrency int  // The maximum number of concurrent lookups. Defaults to DefaultFilterConcurrency.
+	KeepUnknown bool // Keep recipients whose line type cannot be looked up instead of failing the send.
+}
+
+// NewMobileFilter returns a filter that looks up recipients with c.
+func NewMobileFilter(c *Client) *MobileFilter {
+	return &MobileFilter{Client: c}
+}
+
+func (mf *MobileFilter) WithConcurrency(n int) *MobileFilter {
+	mf.Concurrency = n
+	return mf
+}
+
+// WithKeepUnknown keeps recipients whose line type cannot be looked up instead of failing the send.
+func (mf *MobileFilter) WithKeepUnknown() *MobileFilter {
+	mf.KeepUnknown = true
+	return mf
+}
+
+// FilterRecipients returns the recipients that are mobile numbers, in their original order. Unless KeepUnknown is set,
+// it fails with the first lookup error and starts no further lookups. It fails if ctx is done before every recipient
+// is looked up.
+func (mf *MobileFilter) FilterRecipients(ctx context.Context, recipients []string) ([]string, error) {
+	concurrency := mf.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultFilterConcurrency
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var failOnce sync.Once
+	var failErr error
+	fail := func(err error) {
+		failOnce.Do(func() {
+			failErr = err
+			cancel()
+		})
+	}
+
+	keep := make([]bool, len(recipients))
+	unknown := make([]bool, len(recipients))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, recipient := range recipients {
+		number, ok := phoneNumber(recipient)
+		if !ok {
+			keep[i] = true
+			continue
+		}
+		if !acquire(ctx, sem) {
+			fail(ctx.Err())
+			break
+		}
+		wg.Add(1)
+		go func(i int, number string) {
+			defer func() { <-sem; wg.Done() }()
+			mobile, err := mf.isMobile(ctx, number)
+			if err != nil && !mf.KeepUnknown {
+				fail(err)
+			}
+			keep[i], unknown[i] = mobile, err != nil
+		}(i, number)
+	}
+	wg.Wait()
+	if ctx.Err() != nil {
+		fail(ctx.Err())
+	}
+	if failErr != nil {
+		return nil, failErr
+	}
+
+	var kept []string
+	for i, recipient := range recipients {
+		if keep[i] || unknown[i] {
+			kept = append(kept, recipient)
+		}
+	}
+	return kept, nil
+}
+
+// acquire takes a slot of sem, or returns false if ctx is done first.
+func acquire(ctx context.Context, sem chan struct{}) bool {
+	select {
+	case sem <- struct{}{}:
+		if ctx.Err() != nil {
+			<-sem
+			return false
+		}
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (mf *MobileFilter) isMobile(ctx context.Context, number string) (bool, error) {
+	resp, err := mf.Client.Lookup(ctx, new(LookupRequest).WithNumber(number).WithFeatures(FeatureLineType))
+	if err != nil {
+		return false, err
+	}
+	if resp.Line != nil && resp.Line.Error != nil {
+		return false, resp.Line.Error
+	}
+	return resp.IsMobile(), nil
+}
+
+// phoneNumber returns recipient in E.164 format. The SMS API accepts numbers without the leading plus sign.
+func phoneNumber(recipient string) (string, bool) {
+	number := recipient
+	if !strings.HasPrefix(number, "+") {
+		number = "+" + number
+	}
+	return number, sinch.IsE164(number)
+}
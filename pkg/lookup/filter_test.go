@@ -0,0 +1,91 @@
+package lookup
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sms"
+)
+
+func Test_MobileFilter_Implementations(t *testing.T) {
+	var _ sms.RecipientFilter = new(MobileFilter)
+}
+
+func Test_MobileFilter(t *testing.T) {
+	srv := newLookupServer(t, map[string]string{
+		"+12025550101": `{"line": {"type": "Mobile"}}`,
+		"+12025550102": `{"line": {"type": "Landline"}}`,
+		"+12025550103": `{"line": {"type": "VoIP"}}`,
+		"+12025550104": `{"line": {"type": "Mobile"}}`,
+		"+12025550105": `{"line": {"error": {"status": 404, "title": "Not Found", "detail": "No data"}}}`,
+	})
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	kept, err := NewMobileFilter(c).WithConcurrency(2).FilterRecipients(ctx, []string{"+12025550101", "+12025550102", "12025550104", "+12025550103", "group-id"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+12025550101", "12025550104", "group-id"}, kept)
+
+	_, err = NewMobileFilter(c).FilterRecipients(ctx, []string{"+12025550101", "+12025550105"})
+	assert.Error(t, err)
+
+	_, err = NewMobileFilter(c).FilterRecipients(ctx, []string{"+12025550199"})
+	code, _ := api.StatusCode(err)
+	assert.Equal(t, http.StatusNotFound, code)
+
+	kept, err = NewMobileFilter(c).WithKeepUnknown().FilterRecipients(ctx, []string{"+12025550102", "+12025550105", "+12025550199"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"+12025550105", "+12025550199"}, kept)
+}
+
+func Test_MobileFilter_StopsOnError(t *testing.T) {
+	var requests int32
+	lookups := newLookupServer(t, map[string]string{"+12025550101": `{"line": {"type": "Mobile"}}`})
+	defer lookups.Close()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		lookups.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	_, err = NewMobileFilter(c).WithConcurrency(1).FilterRecipients(context.Background(), []string{"+12025550199", "+12025550101", "+12025550101"})
+	code, _ := api.StatusCode(err)
+	assert.Equal(t, http.StatusNotFound, code)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "no lookups may start after the first error")
+}
+
+func Test_MobileFilter_Cancelled(t *testing.T) {
+	var requests int32
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		started <- struct{}{}
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		<-started
+		cancel()
+	}()
+	_, err = NewMobileFilter(c).WithConcurrency(1).WithKeepUnknown().FilterRecipients(ctx, []string{"+12025550101", "+12025550102"})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests), "waiting for a free lookup slot must stop when ctx is done")
+}
@@ -0,0 +1,174 @@
+package lookup
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type LookupAction struct {
+	request  *LookupRequest
+	response *LookupResponse
+}
+
+func (la *LookupAction) Request() *LookupRequest {
+	return la.request
+}
+
+func (la *LookupAction) Response() *LookupResponse {
+	return la.response
+}
+
+// Feature is a kind of data to look up. Each feature is billed separately.
+type Feature string
+
+const (
+	FeatureLineType      Feature = "LineType"
+	FeatureSimSwap       Feature = "SimSwap"
+	FeatureVoIPDetection Feature = "VoIPDetection"
+	FeatureRNDDetection  Feature = "RNDDetection" // Reassigned number detection, US only.
+)
+
+func (f Feature) IsValid() bool {
+	switch f {
+	case FeatureLineType, FeatureSimSwap, FeatureVoIPDetection, FeatureRNDDetection:
+		return true
+	}
+	return false
+}
+
+// LineType is the type of line of a number.
+type LineType string
+
+const (
+	LineTypeMobile    LineType = "Mobile"
+	LineTypeLandline  LineType = "Landline"
+	LineTypeVoIP      LineType = "VoIP"
+	LineTypeSpecial   LineType = "Special"
+	LineTypeFreephone LineType = "Freephone"
+	LineTypeOther     LineType = "Other"
+)
+
+// LookupRequest looks up a number. Without features, only the line type is returned.
+type LookupRequest struct {
+	Number            string             `json:"number"`
+	Features          []Feature          `json:"features,omitempty"`
+	RNDFeatureOptions *RNDFeatureOptions `json:"rndFeatureOptions,omitempty"`
+}
+
+type RNDFeatureOptions struct {
+	ContactDate string `json:"contactDate"` // The date the number was last known to belong to the contact, as YYYY-MM-DD.
+}
+
+type LookupResponse struct {
+	Number        string         `json:"number"`
+	CountryCode   string         `json:"countryCode"` // ISO 3166-1 alpha-2 country code of the number.
+	TraceID       string         `json:"traceId"`
+	Line          *Line          `json:"line,omitempty"`
+	SimSwap       *SimSwap       `json:"simSwap,omitempty"`
+	VoIPDetection *VoIPDetection `json:"voIPDetection,omitempty"`
+	RND           *RND           `json:"rnd,omitempty"`
+}
+
+// Line is the result of FeatureLineType.
+type Line struct {
+	Carrier           string      `json:"carrier"`
+	Type              LineType    `json:"type"`
+	MobileCountryCode string      `json:"mobileCountryCode"`
+	MobileNetworkCode string      `json:"mobileNetworkCode"`
+	Ported            bool        `json:"ported"`
+	PortingDate       *sinch.Time `json:"portingDate,omitempty"`
+	Error             *Error      `json:"error,omitempty"`
+}
+
+// SimSwap is the result of FeatureSimSwap.
+type SimSwap struct {
+	Swapped    bool   `json:"swapped"`
+	SwapPeriod string `json:"swapPeriod"` // The period the SIM was swapped in, e.g. SP4H for the last 4 hours.
+	Error      *Error `json:"error,omitempty"`
+}
+
+// VoIPDetection is the result of FeatureVoIPDetection.
+type VoIPDetection struct {
+	Probability string `json:"probability"` // One of Unknown, Low, Likely or High.
+	Error       *Error `json:"error,omitempty"`
+}
+
+// RND is the result of FeatureRNDDetection.
+type RND struct {
+	Disconnected bool   `json:"disconnected"` // The number was disconnected after the contact date.
+	Error        *Error `json:"error,omitempty"`
+}
+
+// Error is set on the result of a feature that could not be looked up.
+type Error struct {
+	Status int    `json:"status"`
+	Title  string `json:"title"`
+	Detail string `json:"detail"`
+	Type   string `json:"type"`
+}
+
+func (e *Error) Error() string {
+	return e.Title + ": " + e.Detail
+}
+
+func (lr *LookupRequest) WithNumber(number string) *LookupRequest {
+	lr.Number = number
+	return lr
+}
+
+func (lr *LookupRequest) WithFeatures(features ...Feature) *LookupRequest {
+	lr.Features = append(lr.Features, features...)
+	return lr
+}
+
+// WithRNDContactDate adds FeatureRNDDetection, checking whether the number was reassigned since the given date.
+func (lr *LookupRequest) WithRNDContactDate(contactDate string) *LookupRequest {
+	lr.RNDFeatureOptions = &RNDFeatureOptions{ContactDate: contactDate}
+	return lr.WithFeatures(FeatureRNDDetection)
+}
+
+func (lr *LookupRequest) Validate() error {
+	if lr.Number == "" {
+		return NumberRequiredError
+	}
+	if !sinch.IsE164(lr.Number) {
+		return InvalidNumberError
+	}
+	for _, f := range lr.Features {
+		if !f.IsValid() {
+			return InvalidFeatureError
+		}
+	}
+	return nil
+}
+
+func (lr *LookupRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lr *LookupRequest) Method() string {
+	return http.MethodPost
+}
+
+func (lr *LookupRequest) Path() string {
+	return "/lookups"
+}
+
+func (lr *LookupRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (lr *LookupRequest) Body() ([]byte, error) {
+	return json.Marshal(lr)
+}
+
+func (lr *LookupResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lr)
+}
+
+// IsMobile reports whether the line type of the number is mobile.
+func (lr *LookupResponse) IsMobile() bool {
+	return lr.Line != nil && lr.Line.Error == nil && lr.Line.Type == LineTypeMobile
+}
@@ -0,0 +1,94 @@
+package lookup
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Lookup_Implementations(t *testing.T) {
+	var _ sinch.Action[*LookupRequest, *LookupResponse] = new(LookupAction)
+	var _ sinch.APIRequest = new(LookupRequest)
+	var _ sinch.APIResponse = new(LookupResponse)
+}
+
+func Test_LookupRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *LookupRequest
+		wantErr  error
+		wantBody string
+	}{
+		"missing number": {
+			req:     new(LookupRequest),
+			wantErr: NumberRequiredError,
+		},
+		"not e164": {
+			req:     new(LookupRequest).WithNumber("12025550101"),
+			wantErr: InvalidNumberError,
+		},
+		"unknown feature": {
+			req:     new(LookupRequest).WithNumber("+12025550101").WithFeatures("Everything"),
+			wantErr: InvalidFeatureError,
+		},
+		"line type": {
+			req:      new(LookupRequest).WithNumber("+12025550101").WithFeatures(FeatureLineType, FeatureSimSwap),
+			wantBody: `{"number":"+12025550101","features":["LineType","SimSwap"]}`,
+		},
+		"reassigned number": {
+			req:      new(LookupRequest).WithNumber("+12025550101").WithRNDContactDate("2023-01-01"),
+			wantBody: `{"number":"+12025550101","features":["RNDDetection"],"rndFeatureOptions":{"contactDate":"2023-01-01"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPost, tt.req.Method())
+			assert.Equal(t, "/lookups", tt.req.Path())
+		})
+	}
+}
+
+func Test_LookupResponse_FromJSON(t *testing.T) {
+	resp := new(LookupResponse)
+	assert.NoError(t, resp.FromJSON([]byte(`{"number": "+12025550101", "countryCode": "US", "traceId": "trace",
+		"line": {"carrier": "Verizon", "type": "Landline", "ported": false},
+		"simSwap": {"swapped": true, "swapPeriod": "SP24H"},
+		"voIPDetection": {"error": {"status": 400, "title": "Bad Request", "detail": "Feature not available", "type": "about:blank"}}}`)))
+	assert.False(t, resp.IsMobile())
+	assert.Equal(t, LineTypeLandline, resp.Line.Type)
+	assert.True(t, resp.SimSwap.Swapped)
+	assert.Equal(t, "Bad Request: Feature not available", resp.VoIPDetection.Error.Error())
+	assert.Nil(t, resp.RND)
+}
+
+// newLookupServer returns a server answering lookups of the given numbers with the given responses, and other
+// numbers with a 404.
+func newLookupServer(t *testing.T, responses map[string]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, pass, _ := r.BasicAuth(); user != "key" || pass != "secret" || r.URL.Path != "/project/lookups" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req LookupRequest
+		body, _ := io.ReadAll(r.Body)
+		assert.NoError(t, json.Unmarshal(body, &req))
+		resp, ok := responses[req.Number]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		io.WriteString(w, resp)
+	}))
+}
@@ -1,9 +1,11 @@
 package numbers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
+	"github.com/thezmc/go-sinch/pkg/api"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
@@ -16,6 +18,7 @@ type ActivationRequest struct {
 	PhoneNumber        string                     `url:"phoneNumber" json:"-"`
 	SMSConfiguration   *RequestSMSConfiguration   `url:"-" json:"smsConfiguration,omitempty"`
 	VoiceConfiguration *RequestVoiceConfiguration `url:"-" json:"voiceConfiguration,omitempty"`
+	CheckAvailability  bool                       `url:"-" json:"-"` // Check that the number is still available before renting it.
 }
 
 type ActivationResponse struct {
@@ -23,12 +26,12 @@ type ActivationResponse struct {
 	ProjectID             string                      `json:"projectId"`
 	DisplayName           string                      `json:"displayName"`
 	RegionCode            string                      `json:"regionCode"`
-	Type                  string                      `json:"type"`
-	Capability            []string                    `json:"capability"`
+	Type                  Type                        `json:"type"`
+	Capability            []Capability                `json:"capability"`
 	Money                 Price                       `json:"money"`
 	PaymentIntervalMonths int                         `json:"paymentIntervalMonths"`
-	NextChargeDate        string                      `json:"nextChargeDate"`
-	ExpireAt              string                      `json:"expireAt"`
+	NextChargeDate        sinch.Time                  `json:"nextChargeDate"`
+	ExpireAt              sinch.Time                  `json:"expireAt"`
 	SMSConfiguration      *ResponseSMSConfiguration   `json:"smsConfiguration"`
 	VoiceConfiguration    *ResponseVoiceConfiguration `json:"voiceConfiguration,omitempty"`
 }
@@ -75,6 +78,36 @@ func (ar *ActivationRequest) WithVoiceConfiguration(appID string) *ActivationReq
 	return ar
 }
 
+// WithSIPTrunk routes the calls to the number to an Elastic SIP Trunk instead of a Voice API application.
+func (ar *ActivationRequest) WithSIPTrunk(trunkID string) *ActivationRequest {
+	ar.VoiceConfiguration = &RequestVoiceConfiguration{Type: VoiceConfigurationEST, TrunkID: trunkID}
+	return ar
+}
+
+// WithFaxService routes the calls to the number to a Fax API service, so the number receives faxes.
+func (ar *ActivationRequest) WithFaxService(serviceID string) *ActivationRequest {
+	ar.VoiceConfiguration = &RequestVoiceConfiguration{Type: VoiceConfigurationFAX, ServiceID: serviceID}
+	return ar
+}
+
+// WithAvailabilityCheck makes the client check that the number is still available before renting it. If it is not, the
+// request fails with NumberNotAvailableError.
+func (ar *ActivationRequest) WithAvailabilityCheck() *ActivationRequest {
+	ar.CheckAvailability = true
+	return ar
+}
+
+func (ar *ActivationRequest) preflight(ctx context.Context, c *Client) error {
+	if !ar.CheckAvailability {
+		return nil
+	}
+	err := c.DoContext(ctx, new(AvailableNumberRequest).WithPhoneNumber(ar.PhoneNumber), new(AvailableNumber))
+	if code, ok := api.StatusCode(err); ok && code == http.StatusNotFound {
+		return NumberNotAvailableErr(ar.PhoneNumber)
+	}
+	return err
+}
+
 func (ar *ActivationRequest) Validate() error {
 	var errors sinch.Errors
 	if ar.SMSConfiguration == nil && ar.VoiceConfiguration == nil {
@@ -89,8 +122,8 @@ func (ar *ActivationRequest) Validate() error {
 		}
 	}
 	if ar.VoiceConfiguration != nil {
-		if ar.VoiceConfiguration.AppID == "" {
-			errors = append(errors, AppIDRequiredError)
+		if err := ar.VoiceConfiguration.validate(); err != nil {
+			errors = append(errors, err)
 		}
 	}
 	if len(errors) > 0 {
@@ -1,9 +1,12 @@
 package numbers
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
@@ -44,6 +47,31 @@ func Test_ActivationRequest_Validate(t *testing.T) {
 			},
 			expectedErr: AppIDRequiredError,
 		},
+		"missing trunk ID": {
+			configFn: func() {
+				ar = new(ActivationRequest).WithPhoneNumber("1234567890").WithSIPTrunk("")
+			},
+			expectedErr: TrunkIDRequiredError,
+		},
+		"missing fax service ID": {
+			configFn: func() {
+				ar = new(ActivationRequest).WithPhoneNumber("1234567890").WithFaxService("")
+			},
+			expectedErr: FaxServiceIDRequiredError,
+		},
+		"invalid voice type": {
+			configFn: func() {
+				ar = new(ActivationRequest).WithPhoneNumber("1234567890")
+				ar.VoiceConfiguration = &RequestVoiceConfiguration{Type: "PSTN"}
+			},
+			expectedErr: InvalidVoiceTypeError,
+		},
+		"sip trunk": {
+			configFn: func() {
+				ar = new(ActivationRequest).WithPhoneNumber("1234567890").WithSIPTrunk("trunk")
+			},
+			expectedErr: nil,
+		},
 		"no errors": {
 			configFn: func() {
 				ar = new(ActivationRequest).WithPhoneNumber("1234567890").WithSMSConfiguration("test", "test")
@@ -63,3 +91,75 @@ func Test_ActivationRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_ActivationRequest_AvailabilityCheck(t *testing.T) {
+	var rented bool
+	var status int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/test/availableNumbers/+12025550134":
+			w.WriteHeader(status)
+			_, _ = w.Write([]byte(`{"phoneNumber": "+12025550134"}`))
+		case "/test/availableNumbers/+12025550134:rent":
+			rented = true
+			_, _ = w.Write([]byte(`{"phoneNumber": "+12025550134"}`))
+		}
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithProjectID("test").
+		WithKeyID("test").
+		WithKeySecret("test").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+
+	tests := map[string]struct {
+		status      int
+		check       bool
+		expectedErr error
+		rented      bool
+	}{
+		"no check": {
+			status: http.StatusNotFound,
+			rented: true,
+		},
+		"available": {
+			status: http.StatusOK,
+			check:  true,
+			rented: true,
+		},
+		"not available": {
+			status:      http.StatusNotFound,
+			check:       true,
+			expectedErr: NumberNotAvailableError,
+		},
+		"check failed": {
+			status:      http.StatusInternalServerError,
+			check:       true,
+			expectedErr: api.UnexpectedStatusCodeError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rented, status = false, test.status
+			ar := new(ActivationRequest).WithPhoneNumber("+12025550134").WithSMSConfiguration("test", "")
+			if test.check {
+				ar.WithAvailabilityCheck()
+			}
+			err := client.Do(ar, new(ActivationResponse))
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, test.rented, rented)
+		})
+	}
+}
+
+func Test_ActivationRequest_SIPTrunkBody(t *testing.T) {
+	body, err := new(ActivationRequest).WithPhoneNumber("+12025550134").WithSIPTrunk("trunk").Body()
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"voiceConfiguration":{"type":"EST","trunkId":"trunk"}`)
+}
@@ -0,0 +1,95 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type ActiveNumberAction struct {
+	request  *ActiveNumberRequest
+	response *ActiveNumber
+}
+
+func (ana *ActiveNumberAction) IsNumbersAction() {}
+
+func (ana *ActiveNumberAction) Request() *ActiveNumberRequest {
+	return ana.request
+}
+
+func (ana *ActiveNumberAction) Response() *ActiveNumber {
+	return ana.response
+}
+
+// ActiveNumberRequest fetches a number rented by the project.
+type ActiveNumberRequest struct {
+	PhoneNumber string `url:"-" json:"-"` // The phone number in E.164 format with leading +. Example +12025550134.
+}
+
+type ActiveNumber struct {
+	PhoneNumber           string                      `json:"phoneNumber"`
+	ProjectID             string                      `json:"projectId"`
+	DisplayName           string                      `json:"displayName"`
+	RegionCode            string                      `json:"regionCode"`
+	Type                  Type                        `json:"type"`
+	Capability            []Capability                `json:"capability"`
+	Money                 Price                       `json:"money"`
+	PaymentIntervalMonths int                         `json:"paymentIntervalMonths"`
+	NextChargeDate        sinch.Time                  `json:"nextChargeDate"`
+	ExpireAt              sinch.Time                  `json:"expireAt"`
+	SMSConfiguration      *ResponseSMSConfiguration   `json:"smsConfiguration"`
+	VoiceConfiguration    *ResponseVoiceConfiguration `json:"voiceConfiguration,omitempty"`
+	TollFreeVerification  *ActiveNumberVerification   `json:"tollFreeVerification,omitempty"` // Only set for toll-free numbers submitted for verification.
+}
+
+// ActiveNumberVerification is the latest toll-free verification request of a number.
+type ActiveNumberVerification struct {
+	ID     string                     `json:"id"`
+	Status TollFreeVerificationStatus `json:"status"`
+}
+
+func (anr *ActiveNumberRequest) WithPhoneNumber(phoneNumber string) *ActiveNumberRequest {
+	anr.PhoneNumber = phoneNumber
+	return anr
+}
+
+func (anr *ActiveNumberRequest) Validate() error {
+	if anr.PhoneNumber == "" {
+		return PhoneNumberRequiredError
+	}
+	return nil
+}
+
+func (anr *ActiveNumberRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (anr *ActiveNumberRequest) Method() string {
+	return http.MethodGet
+}
+
+func (anr *ActiveNumberRequest) Path() string {
+	return "/activeNumbers/" + anr.PhoneNumber
+}
+
+func (anr *ActiveNumberRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (anr *ActiveNumberRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+// TollFreeVerificationStatus returns the status of the latest toll-free verification request of the number, or
+// TollFreeVerificationStatusUnspecified if it was never submitted for verification.
+func (an *ActiveNumber) TollFreeVerificationStatus() TollFreeVerificationStatus {
+	if an.TollFreeVerification == nil {
+		return TollFreeVerificationStatusUnspecified
+	}
+	return an.TollFreeVerification.Status
+}
+
+func (an *ActiveNumber) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, an)
+}
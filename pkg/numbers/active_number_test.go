@@ -0,0 +1,51 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_ActiveNumber_Implementations(t *testing.T) {
+	var _ sinch.Action[*ActiveNumberRequest, *ActiveNumber] = new(ActiveNumberAction)
+	var _ sinch.APIRequest = new(ActiveNumberRequest)
+	var _ sinch.APIResponse = new(ActiveNumber)
+}
+
+func Test_ActiveNumberRequest(t *testing.T) {
+	anr := new(ActiveNumberRequest)
+	assert.ErrorIs(t, anr.Validate(), PhoneNumberRequiredError)
+
+	anr.WithPhoneNumber("+12025550134")
+	assert.NoError(t, anr.Validate())
+	assert.Equal(t, http.MethodGet, anr.Method())
+	assert.Equal(t, "/activeNumbers/+12025550134", anr.Path())
+}
+
+func Test_ActiveNumber_Times(t *testing.T) {
+	an := new(ActiveNumber)
+	assert.NoError(t, an.FromJSON([]byte(`{"phoneNumber": "+12025550134", "nextChargeDate": "2022-08-25T19:07:14.123456Z", "expireAt": null,
+		"smsConfiguration": {"scheduledProvisioning": {"lastUpdatedTime": "2022-07-25T19:07:14Z"}}}`)))
+	assert.Equal(t, time.August, an.NextChargeDate.Month())
+	assert.True(t, an.ExpireAt.IsZero())
+	assert.Equal(t, 2022, an.SMSConfiguration.ScheduledProvisioning.LastUpdatedTime.Year())
+
+	data, err := json.Marshal(an.NextChargeDate)
+	assert.NoError(t, err)
+	assert.Equal(t, `"2022-08-25T19:07:14.123456Z"`, string(data))
+}
+
+func Test_ActiveNumber_TollFreeVerificationStatus(t *testing.T) {
+	an := new(ActiveNumber)
+	assert.NoError(t, an.FromJSON([]byte(`{"phoneNumber": "+12025550134", "type": "LOCAL"}`)))
+	assert.Equal(t, TollFreeVerificationStatusUnspecified, an.TollFreeVerificationStatus())
+
+	assert.NoError(t, an.FromJSON([]byte(`{"phoneNumber": "+18885550134", "type": "TOLL_FREE",
+		"tollFreeVerification": {"id": "tfv-1", "status": "IN_REVIEW"}}`)))
+	assert.Equal(t, TollFreeVerificationStatusInReview, an.TollFreeVerificationStatus())
+	assert.Equal(t, "tfv-1", an.TollFreeVerification.ID)
+}
@@ -1,6 +1,7 @@
 package numbers
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
@@ -28,10 +29,10 @@ func (aa *AvailabilityAction) Response() *AvailabilityResponse {
 type AvailabilityRequest struct {
 	// Sequence of digits to search for. If you prefer or need certain digits in sequential order, you can enter the sequence of numbers here. For example, 2020.
 	Pattern string `url:"numberPattern.pattern,omitempty"`
-	// Search pattern to apply. The options are, START, CONTAIN, and END.
+	// Search pattern to apply. The options are, START, CONTAINS, and END.
 	//	START
-	// Numbers that begin with the numberPattern.pattern entered. Often used to search for a specific area code. When using START, a plus sign (+) must be included and URL encoded, so %2B. For example, to search for area code 206 in the US, you would enter, %2b1206.
-	// 	CONTAIN
+	// Numbers that begin with the numberPattern.pattern entered. Often used to search for a specific area code. When using START, a plus sign (+) must be included. For example, to search for area code 206 in the US, you would enter, +1206.
+	// 	CONTAINS
 	// The number pattern entered is contained somewhere in the number, the location being undefined.
 	// 	END
 	// The number ends with the number pattern entered.
@@ -40,6 +41,7 @@ type AvailabilityRequest struct {
 	Type          string   `url:"type"`                   // Number type to filter by. Options include MOBILE, LOCAL or TOLL_FREE.
 	Capabilities  []string `url:"capabilities,omitempty"` // Capabilities to filter by. Options include SMS or VOICE.
 	Size          int      `url:"size,omitempty"`         // Number of available numbers to return.
+	regions       *RegionCache
 }
 
 type AvailabilityResponse struct {
@@ -56,57 +58,17 @@ type AvailableNumber struct {
 	// Numbers that are assigned to a specific geographic region.
 	//	TOLL_FREE
 	// Numbers that are free of charge for the calling party but billed for all arriving calls.
-	Type string `json:"type"`
+	Type Type `json:"type"`
 	// The capabilities of the number.
 	//	SMS
 	// The number can receive/send SMS messages.
 	//	VOICE
 	// The number can receive voice calls.
-	Capability                      []string `json:"capability"`
-	SetupPrice                      Price    `json:"setupPrice"`
-	MonthlyPrice                    Price    `json:"monthlyPrice"`
-	PaymentIntervalMonths           int      `json:"paymentIntervalMonths"`
-	SupportingDocumentationRequired bool     `json:"supportingDocumentationRequired"`
-}
-
-type Price struct {
-	Amount       string `json:"amount"`
-	CurrencyCode string `json:"currencyCode"`
-}
-
-type SearchPattern int
-
-const (
-	SearchPatternStart SearchPattern = iota
-	SearchPatternExact
-	SearchPatternEnd
-)
-
-func (sp SearchPattern) String() string {
-	return [...]string{"START", "EXACT", "END"}[sp]
-}
-
-type Type int
-
-const (
-	TypeLocal Type = iota
-	TypeTollFree
-	TypeMobile
-)
-
-func (t Type) String() string {
-	return [...]string{"LOCAL", "TOLL_FREE", "MOBILE"}[t]
-}
-
-type Capability int
-
-const (
-	CapabilitySMS Capability = iota
-	CapabilityVoice
-)
-
-func (c Capability) String() string {
-	return [...]string{"SMS", "VOICE"}[c]
+	Capability                      []Capability `json:"capability"`
+	SetupPrice                      Price        `json:"setupPrice"`
+	MonthlyPrice                    Price        `json:"monthlyPrice"`
+	PaymentIntervalMonths           int          `json:"paymentIntervalMonths"`
+	SupportingDocumentationRequired bool         `json:"supportingDocumentationRequired"`
 }
 
 func (anr *AvailabilityRequest) WithPattern(pattern string) *AvailabilityRequest {
@@ -130,8 +92,8 @@ func (anr *AvailabilityRequest) WithType(t Type) *AvailabilityRequest {
 }
 
 func (anr *AvailabilityRequest) WithCapability(c ...Capability) *AvailabilityRequest {
-	for _, cap := range c {
-		anr.Capabilities = append(anr.Capabilities, cap.String())
+	for _, capability := range c {
+		anr.Capabilities = append(anr.Capabilities, capability.String())
 	}
 	return anr
 }
@@ -141,6 +103,13 @@ func (anr *AvailabilityRequest) WithSize(size int) *AvailabilityRequest {
 	return anr
 }
 
+// WithRegionCache makes ValidateContext, and the client before sending the request, reject region and type combinations
+// that are not supported by the Numbers API.
+func (anr *AvailabilityRequest) WithRegionCache(rc *RegionCache) *AvailabilityRequest {
+	anr.regions = rc
+	return anr
+}
+
 func (ac *AvailabilityRequest) Validate() error {
 	var errs sinch.Errors
 	if ac.RegionCode == "" {
@@ -148,6 +117,22 @@ func (ac *AvailabilityRequest) Validate() error {
 	}
 	if ac.Type == "" {
 		errs = append(errs, TypeRequiredError)
+	} else if !validEnumName(types, ac.Type, int(TypeUnspecified)) {
+		errs = append(errs, InvalidTypeError)
+	}
+	if ac.SearchPattern == SearchPatternExact.String() {
+		errs = append(errs, SearchPatternExactError)
+	} else if ac.SearchPattern != "" && !validEnumName(searchPatterns, ac.SearchPattern, int(SearchPatternUnspecified)) {
+		errs = append(errs, InvalidSearchPatternError)
+	}
+	if ac.SearchPattern != "" && ac.Pattern == "" {
+		errs = append(errs, PatternRequiredError)
+	}
+	for _, c := range ac.Capabilities {
+		if !validEnumName(capabilities, c, int(CapabilityUnspecified)) {
+			errs = append(errs, InvalidCapabilityError)
+			break
+		}
 	}
 	if len(errs) > 0 {
 		return multierr.Combine(errs)
@@ -155,6 +140,22 @@ func (ac *AvailabilityRequest) Validate() error {
 	return nil
 }
 
+// ValidateContext validates the request like Validate and, when a region cache is set, also checks that the region
+// and type are supported by the Numbers API. Fetching the regions into the cache stops when ctx is done.
+func (ac *AvailabilityRequest) ValidateContext(ctx context.Context) error {
+	if err := ac.Validate(); err != nil {
+		return err
+	}
+	if ac.regions == nil {
+		return nil
+	}
+	return ac.regions.Supports(ctx, ac.RegionCode, Type(enumParse(types, ac.Type, int(TypeUnspecified))))
+}
+
+func (ac *AvailabilityRequest) preflight(ctx context.Context, c *Client) error {
+	return ac.ValidateContext(ctx)
+}
+
 func (ac *AvailabilityRequest) ExpectedStatusCode() int {
 	return http.StatusOK
 }
@@ -1,8 +1,12 @@
 package numbers
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"github.com/biter777/countries"
+	"github.com/stretchr/testify/assert"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
@@ -11,3 +15,110 @@ func Test_Availability_Implementations(t *testing.T) {
 	var _ sinch.APIRequest = &AvailabilityRequest{}
 	var _ sinch.APIResponse = &AvailabilityResponse{}
 }
+
+func Test_AvailabilityRequest_QueryString(t *testing.T) {
+	qs, err := new(AvailabilityRequest).
+		WithRegionCode(countries.US).
+		WithType(TypeLocal).
+		WithPattern("+1206").
+		WithSearchPattern(SearchPatternStart).
+		WithCapability(CapabilitySMS, CapabilityVoice).
+		QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "?capabilities=SMS&capabilities=VOICE&numberPattern.pattern=%2B1206&numberPattern.searchPattern=START&regionCode=US&type=LOCAL", qs)
+
+	qs, err = new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "?regionCode=US&type=LOCAL", qs, "an unset search pattern is not sent")
+}
+
+func Test_AvailabilityRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		request     *AvailabilityRequest
+		expectedErr error
+	}{
+		"missing region code": {
+			request:     new(AvailabilityRequest).WithType(TypeLocal),
+			expectedErr: RegionCodeRequiredError,
+		},
+		"missing type": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US),
+			expectedErr: TypeRequiredError,
+		},
+		"invalid type": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(Type(9)),
+			expectedErr: InvalidTypeError,
+		},
+		"invalid search pattern": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).WithPattern("1").WithSearchPattern(SearchPattern(9)),
+			expectedErr: InvalidSearchPatternError,
+		},
+		"exact search pattern": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).WithPattern("1").WithSearchPattern(SearchPatternExact),
+			expectedErr: SearchPatternExactError,
+		},
+		"search pattern without pattern": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).WithSearchPattern(SearchPatternEnd),
+			expectedErr: PatternRequiredError,
+		},
+		"invalid capability": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).WithCapability(CapabilityUnspecified),
+			expectedErr: InvalidCapabilityError,
+		},
+		"no errors": {
+			request: new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal).WithPattern("1").WithSearchPattern(SearchPatternEnd).WithCapability(CapabilitySMS),
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if test.expectedErr != nil {
+				assert.ErrorContains(t, test.request.Validate(), test.expectedErr.Error())
+			} else {
+				assert.NoError(t, test.request.Validate())
+			}
+		})
+	}
+}
+
+func Test_AvailabilityRequest_ValidateContext(t *testing.T) {
+	rc := &RegionCache{
+		regions:   map[string]AvailableRegion{"US": {RegionCode: "US", Types: []Type{TypeLocal}}},
+		fetchedAt: time.Now(),
+	}
+
+	tests := map[string]struct {
+		request     *AvailabilityRequest
+		expectedErr error
+	}{
+		"supported": {
+			request: new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeLocal),
+		},
+		"unsupported region": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.GB).WithType(TypeLocal),
+			expectedErr: UnsupportedRegionError,
+		},
+		"unsupported type": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeTollFree),
+			expectedErr: UnsupportedRegionTypeError,
+		},
+		"invalid request": {
+			request:     new(AvailabilityRequest).WithType(TypeLocal),
+			expectedErr: RegionCodeRequiredError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := test.request.WithRegionCache(rc).ValidateContext(context.Background())
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	assert.NoError(t, new(AvailabilityRequest).WithRegionCode(countries.GB).WithType(TypeLocal).ValidateContext(context.Background()),
+		"requests without a region cache are not checked against it")
+}
@@ -0,0 +1,63 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+type AvailableNumberAction struct {
+	request  *AvailableNumberRequest
+	response *AvailableNumber
+}
+
+func (ana *AvailableNumberAction) IsNumbersAction() {}
+
+func (ana *AvailableNumberAction) Request() *AvailableNumberRequest {
+	return ana.request
+}
+
+func (ana *AvailableNumberAction) Response() *AvailableNumber {
+	return ana.response
+}
+
+// AvailableNumberRequest checks whether a single phone number is available to rent and returns its pricing.
+type AvailableNumberRequest struct {
+	PhoneNumber string `url:"-" json:"-"` // The phone number in E.164 format with leading +. Example +12025550134.
+}
+
+func (anr *AvailableNumberRequest) WithPhoneNumber(phoneNumber string) *AvailableNumberRequest {
+	anr.PhoneNumber = phoneNumber
+	return anr
+}
+
+func (anr *AvailableNumberRequest) Validate() error {
+	if anr.PhoneNumber == "" {
+		return PhoneNumberRequiredError
+	}
+	return nil
+}
+
+func (anr *AvailableNumberRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (anr *AvailableNumberRequest) Method() string {
+	return http.MethodGet
+}
+
+func (anr *AvailableNumberRequest) Path() string {
+	return "/availableNumbers/" + url.PathEscape(anr.PhoneNumber)
+}
+
+func (anr *AvailableNumberRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (anr *AvailableNumberRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (an *AvailableNumber) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, an)
+}
@@ -0,0 +1,32 @@
+package numbers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_AvailableNumber_Implementations(t *testing.T) {
+	var _ sinch.Action[*AvailableNumberRequest, *AvailableNumber] = new(AvailableNumberAction)
+	var _ sinch.APIRequest = new(AvailableNumberRequest)
+	var _ sinch.APIResponse = new(AvailableNumber)
+}
+
+func Test_AvailableNumberRequest(t *testing.T) {
+	anr := new(AvailableNumberRequest)
+	assert.ErrorIs(t, anr.Validate(), PhoneNumberRequiredError)
+
+	anr.WithPhoneNumber("+12025550134")
+	assert.NoError(t, anr.Validate())
+	assert.Equal(t, http.MethodGet, anr.Method())
+	assert.Equal(t, http.StatusOK, anr.ExpectedStatusCode())
+	assert.Equal(t, "/availableNumbers/+12025550134", anr.Path())
+	assert.Equal(t, "/availableNumbers/+1%2F..%2Factive", anr.WithPhoneNumber("+1/../active").Path())
+
+	an := new(AvailableNumber)
+	assert.NoError(t, an.FromJSON([]byte(`{"phoneNumber": "+12025550134", "setupPrice": {"amount": "1.00", "currencyCode": "USD"}}`)))
+	assert.Equal(t, "+12025550134", an.PhoneNumber)
+	assert.Equal(t, "1.00 USD", an.SetupPrice.String())
+}
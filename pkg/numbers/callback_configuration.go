@@ -0,0 +1,109 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type CallbackConfigurationAction struct {
+	request  *CallbackConfigurationRequest
+	response *CallbackConfiguration
+}
+
+func (cca *CallbackConfigurationAction) IsNumbersAction() {}
+
+func (cca *CallbackConfigurationAction) Request() *CallbackConfigurationRequest {
+	return cca.request
+}
+
+func (cca *CallbackConfigurationAction) Response() *CallbackConfiguration {
+	return cca.response
+}
+
+type CallbackConfigurationUpdate struct {
+	request  *CallbackConfigurationUpdateRequest
+	response *CallbackConfiguration
+}
+
+func (ccu *CallbackConfigurationUpdate) IsNumbersAction() {}
+
+func (ccu *CallbackConfigurationUpdate) Request() *CallbackConfigurationUpdateRequest {
+	return ccu.request
+}
+
+func (ccu *CallbackConfigurationUpdate) Response() *CallbackConfiguration {
+	return ccu.response
+}
+
+// CallbackConfigurationRequest fetches the callback configuration of the project.
+type CallbackConfigurationRequest struct{}
+
+// CallbackConfigurationUpdateRequest updates the callback configuration of the project.
+type CallbackConfigurationUpdateRequest struct {
+	HMACSecret string `json:"hmacSecret"` // The secret used to sign the callbacks sent to the project's callback URL.
+}
+
+type CallbackConfiguration struct {
+	ProjectID  string `json:"projectId"`
+	HMACSecret string `json:"hmacSecret"`
+}
+
+func (ccr *CallbackConfigurationRequest) Validate() error {
+	return nil
+}
+
+func (ccr *CallbackConfigurationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ccr *CallbackConfigurationRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ccr *CallbackConfigurationRequest) Path() string {
+	return "/callbackConfiguration"
+}
+
+func (ccr *CallbackConfigurationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ccr *CallbackConfigurationRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) WithHMACSecret(secret string) *CallbackConfigurationUpdateRequest {
+	ccur.HMACSecret = secret
+	return ccur
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) Validate() error {
+	if ccur.HMACSecret == "" {
+		return HMACSecretRequiredError
+	}
+	return nil
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) Path() string {
+	return "/callbackConfiguration"
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ccur *CallbackConfigurationUpdateRequest) Body() ([]byte, error) {
+	return json.Marshal(ccur)
+}
+
+func (cc *CallbackConfiguration) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, cc)
+}
@@ -0,0 +1,31 @@
+package numbers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_CallbackConfiguration_Implementations(t *testing.T) {
+	var _ sinch.Action[*CallbackConfigurationRequest, *CallbackConfiguration] = new(CallbackConfigurationAction)
+	var _ sinch.Action[*CallbackConfigurationUpdateRequest, *CallbackConfiguration] = new(CallbackConfigurationUpdate)
+	var _ sinch.APIRequest = new(CallbackConfigurationRequest)
+	var _ sinch.APIRequest = new(CallbackConfigurationUpdateRequest)
+	var _ sinch.APIResponse = new(CallbackConfiguration)
+}
+
+func Test_CallbackConfigurationUpdateRequest(t *testing.T) {
+	ccur := new(CallbackConfigurationUpdateRequest)
+	assert.ErrorIs(t, ccur.Validate(), HMACSecretRequiredError)
+
+	ccur.WithHMACSecret("secret")
+	assert.NoError(t, ccur.Validate())
+	assert.Equal(t, http.MethodPatch, ccur.Method())
+	assert.Equal(t, "/callbackConfiguration", ccur.Path())
+
+	body, err := ccur.Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"hmacSecret": "secret"}`, string(body))
+}
@@ -1,28 +1,52 @@
 package numbers
 
 import (
+	"context"
 	"net/http"
+	"time"
 
 	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
 type Client struct {
-	SinchAPI  api.Client
-	ProjectID string
-	KeyID     string
-	KeySecret string
+	SinchAPI        api.Client // The API client requests are sent with, unless a shared one is set with WithSinchAPI.
+	ProjectID       string
+	KeyID           string
+	KeySecret       string
+	Authenticator   sinch.Authenticator // When set, authenticates requests instead of basic auth with KeyID and KeySecret.
+	PollInterval    time.Duration       // The initial interval between polls in WaitForProvisioning.
+	MaxPollInterval time.Duration       // The maximum interval between polls in WaitForProvisioning.
+	sharedAPI       *api.Client
 }
 
 const (
 	BaseURLv1 = "https://numbers.api.sinch.com/v1/projects"
+
+	DefaultPollInterval    = 2 * time.Second
+	DefaultMaxPollInterval = 30 * time.Second
 )
 
+// WithSinchAPI makes the client send requests with sinchAPI, which other clients may share. Later changes to sinchAPI
+// apply to all of them.
 func (c *Client) WithSinchAPI(sinchAPI *api.Client) *Client {
-	c.SinchAPI = *sinchAPI
+	c.ShareAPI(sinchAPI)
 	return c
 }
 
+// API returns the API client the client sends requests with.
+func (c *Client) API() *api.Client {
+	if c.sharedAPI != nil {
+		return c.sharedAPI
+	}
+	return &c.SinchAPI
+}
+
+func (c *Client) ShareAPI(sinchAPI *api.Client) {
+	c.sharedAPI = sinchAPI
+}
+
 func (c *Client) WithProjectID(projectID string) *Client {
 	c.ProjectID = projectID
 	return c
@@ -38,10 +62,34 @@ func (c *Client) WithKeySecret(KeySecret string) *Client {
 	return c
 }
 
+// WithAuthenticator authenticates requests with a instead of the key ID and secret. See the auth package for
+// implementations.
+func (c *Client) WithAuthenticator(a sinch.Authenticator) *Client {
+	c.Authenticator = a
+	return c
+}
+
+// WithTokenSource authenticates requests with OAuth2 access tokens from ts instead of the key ID and secret. See
+// auth.ClientCredentials.
+func (c *Client) WithTokenSource(ts auth.TokenSource) *Client {
+	return c.WithAuthenticator(auth.NewOAuth2(ts))
+}
+
+// WithProvisioningBackoff sets the initial and maximum intervals between polls in WaitForProvisioning. The interval
+// doubles after every poll until it reaches max.
+func (c *Client) WithProvisioningBackoff(initial, max time.Duration) *Client {
+	c.PollInterval = initial
+	c.MaxPollInterval = max
+	return c
+}
+
 func (c *Client) Validate() error {
 	if c.ProjectID == "" {
 		return ProjectIDRequiredError
 	}
+	if c.Authenticator != nil {
+		return nil
+	}
 	if c.KeyID == "" {
 		return KeyIDRequiredError
 	}
@@ -52,14 +100,35 @@ func (c *Client) Validate() error {
 }
 
 func (c *Client) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	if c.Authenticator != nil {
+		return c.Authenticator.Authenticate(httpReq)
+	}
 	httpReq.SetBasicAuth(c.KeyID, c.KeySecret)
 	return httpReq, nil
 }
 
 func (c *Client) URL() string {
-	return c.SinchAPI.BaseURL + "/" + c.ProjectID
+	return c.API().BaseURL + "/" + c.ProjectID
+}
+
+// preflighter is implemented by requests that need to make other API calls with the client before being sent. The
+// request and client are validated first, so invalid requests never trigger those calls.
+type preflighter interface {
+	preflight(ctx context.Context, c *Client) error
 }
 
 func (c *Client) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
-	return c.SinchAPI.Do(c, req, resp)
+	return c.DoContext(context.Background(), req, resp)
+}
+
+func (c *Client) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	if p, ok := req.(preflighter); ok {
+		if err := api.Validate(c, req); err != nil {
+			return err
+		}
+		if err := p.preflight(ctx, c); err != nil {
+			return err
+		}
+	}
+	return c.API().DoContext(ctx, c, req, resp)
 }
@@ -1,12 +1,16 @@
 package numbers
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
@@ -105,3 +109,19 @@ func Test_URL(t *testing.T) {
 		t.Errorf("expected URL to be https://numbers.api.sinch.com/v1/projects/foo, got %s", client.URL())
 	}
 }
+
+type staticTokenSource string
+
+func (ts staticTokenSource) Token(ctx context.Context) (*auth.Token, error) {
+	return &auth.Token{AccessToken: string(ts)}, nil
+}
+
+func Test_Client_TokenSource(t *testing.T) {
+	client := new(Client).WithProjectID("foo").WithTokenSource(staticTokenSource("token"))
+	assert.NoError(t, client.Validate())
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, err := client.Authenticate(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+}
@@ -0,0 +1,175 @@
+package numbers
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SearchPattern is where the numberPattern.pattern of an AvailabilityRequest must appear in the number.
+type SearchPattern int
+
+const (
+	SearchPatternStart SearchPattern = iota // Numbers that begin with the pattern. Often used to search for a specific area code.
+	// Deprecated: the Numbers API does not support EXACT, so requests using it fail validation with
+	// SearchPatternExactError. Use SearchPatternStart, SearchPatternEnd or SearchPatternContains instead.
+	SearchPatternExact
+	SearchPatternEnd      // Numbers that end with the pattern.
+	SearchPatternContains // Numbers that contain the pattern somewhere, the location being undefined.
+	SearchPatternUnspecified
+)
+
+var searchPatterns = []string{"START", "EXACT", "END", "CONTAINS", "SEARCH_PATTERN_UNSPECIFIED"}
+
+func (sp SearchPattern) String() string {
+	return enumString(searchPatterns, int(sp), "SearchPattern")
+}
+
+// IsValid returns true if sp is one of the defined search patterns, including SearchPatternUnspecified but not the
+// deprecated SearchPatternExact.
+func (sp SearchPattern) IsValid() bool {
+	return sp != SearchPatternExact && enumValid(searchPatterns, int(sp))
+}
+
+func (sp SearchPattern) MarshalText() ([]byte, error) {
+	if sp == SearchPatternExact {
+		return nil, SearchPatternExactError
+	}
+	if !sp.IsValid() {
+		return nil, InvalidSearchPatternError
+	}
+	return []byte(sp.String()), nil
+}
+
+func (sp *SearchPattern) UnmarshalText(text []byte) error {
+	*sp = SearchPattern(enumParse(searchPatterns, string(text), int(SearchPatternUnspecified)))
+	return nil
+}
+
+func (sp SearchPattern) EncodeValues(key string, v *url.Values) error {
+	if sp == SearchPatternExact {
+		return SearchPatternExactError
+	}
+	if !sp.IsValid() {
+		return InvalidSearchPatternError
+	}
+	v.Set(key, sp.String())
+	return nil
+}
+
+// Type is the type of a number.
+type Type int
+
+const (
+	TypeLocal    Type = iota // Numbers that are assigned to a specific geographic region.
+	TypeTollFree             // Numbers that are free of charge for the calling party but billed for all arriving calls.
+	TypeMobile               // Numbers that belong to a specific range.
+	TypeUnspecified
+)
+
+var types = []string{"LOCAL", "TOLL_FREE", "MOBILE", "NUMBER_TYPE_UNSPECIFIED"}
+
+func (t Type) String() string {
+	return enumString(types, int(t), "Type")
+}
+
+// IsValid returns true if t is one of the defined number types, including TypeUnspecified.
+func (t Type) IsValid() bool {
+	return enumValid(types, int(t))
+}
+
+func (t Type) MarshalText() ([]byte, error) {
+	if !t.IsValid() {
+		return nil, InvalidTypeError
+	}
+	return []byte(t.String()), nil
+}
+
+func (t *Type) UnmarshalText(text []byte) error {
+	*t = Type(enumParse(types, string(text), int(TypeUnspecified)))
+	return nil
+}
+
+func (t Type) EncodeValues(key string, v *url.Values) error {
+	if !t.IsValid() {
+		return InvalidTypeError
+	}
+	v.Set(key, t.String())
+	return nil
+}
+
+// Capability is something a number can be used for.
+type Capability int
+
+const (
+	CapabilitySMS   Capability = iota // The number can receive/send SMS messages.
+	CapabilityVoice                   // The number can receive voice calls.
+	CapabilityUnspecified
+)
+
+var capabilities = []string{"SMS", "VOICE", "CAPABILITY_UNSPECIFIED"}
+
+func (c Capability) String() string {
+	return enumString(capabilities, int(c), "Capability")
+}
+
+// IsValid returns true if c is one of the defined capabilities, including CapabilityUnspecified.
+func (c Capability) IsValid() bool {
+	return enumValid(capabilities, int(c))
+}
+
+func (c Capability) MarshalText() ([]byte, error) {
+	if !c.IsValid() {
+		return nil, InvalidCapabilityError
+	}
+	return []byte(c.String()), nil
+}
+
+func (c *Capability) UnmarshalText(text []byte) error {
+	*c = Capability(enumParse(capabilities, string(text), int(CapabilityUnspecified)))
+	return nil
+}
+
+func (c Capability) EncodeValues(key string, v *url.Values) error {
+	if !c.IsValid() {
+		return InvalidCapabilityError
+	}
+	v.Add(key, c.String())
+	return nil
+}
+
+func enumValid(names []string, i int) bool {
+	return i >= 0 && i < len(names) && names[i] != ""
+}
+
+func enumString(names []string, i int, typeName string) string {
+	if !enumValid(names, i) {
+		return fmt.Sprintf("%s(%d)", typeName, i)
+	}
+	return names[i]
+}
+
+// enumIndex returns the index of s in names, or -1 if s is not one of them.
+func enumIndex(names []string, s string) int {
+	for i, name := range names {
+		if name != "" && name == s {
+			return i
+		}
+	}
+	return -1
+}
+
+// enumParse returns the index of s in names. Values unknown to this version of the package parse to unspecified so new
+// values added by Sinch don't break decoding responses.
+func enumParse(names []string, s string, unspecified int) int {
+	if i := enumIndex(names, s); i >= 0 {
+		return i
+	}
+	return unspecified
+}
+
+// validEnumName returns true if s is one of names, other than the unspecified value. Requests keep enum values as
+// their names so an empty string means the value was not set.
+func validEnumName(names []string, s string, unspecified int) bool {
+	i := enumIndex(names, s)
+	return i >= 0 && i != unspecified
+}
@@ -0,0 +1,58 @@
+package numbers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Enums_String(t *testing.T) {
+	assert.Equal(t, "START", SearchPatternStart.String())
+	assert.Equal(t, "CONTAINS", SearchPatternContains.String())
+	assert.Equal(t, "END", SearchPatternEnd.String())
+	assert.Equal(t, "SearchPattern(42)", SearchPattern(42).String())
+	assert.Equal(t, "TOLL_FREE", TypeTollFree.String())
+	assert.Equal(t, "Type(-1)", Type(-1).String())
+	assert.Equal(t, "VOICE", CapabilityVoice.String())
+	assert.Equal(t, "Capability(3)", Capability(3).String())
+	assert.Equal(t, "EXACT", SearchPatternExact.String())
+}
+
+func Test_Enums_Values(t *testing.T) {
+	var (
+		sp SearchPattern
+		tp Type
+		c  Capability
+	)
+	assert.Equal(t, SearchPatternStart, sp)
+	assert.Equal(t, TypeLocal, tp)
+	assert.Equal(t, CapabilitySMS, c)
+	assert.Equal(t, SearchPatternEnd, SearchPattern(2))
+	assert.False(t, SearchPatternExact.IsValid(), "EXACT is not a Sinch search pattern")
+
+	_, err := json.Marshal(SearchPatternExact)
+	assert.ErrorIs(t, err, SearchPatternExactError)
+	_, err = json.Marshal(SearchPattern(9))
+	assert.ErrorIs(t, err, InvalidSearchPatternError)
+}
+
+func Test_Enums_JSON(t *testing.T) {
+	var an AvailableNumber
+	assert.NoError(t, json.Unmarshal([]byte(`{"type": "MOBILE", "capability": ["SMS", "VOICE", "FAX"]}`), &an))
+	assert.Equal(t, TypeMobile, an.Type)
+	assert.Equal(t, []Capability{CapabilitySMS, CapabilityVoice, CapabilityUnspecified}, an.Capability)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"type": "SATELLITE"}`), &an))
+	assert.Equal(t, TypeUnspecified, an.Type)
+
+	data, err := json.Marshal(struct {
+		Type          Type          `json:"type"`
+		SearchPattern SearchPattern `json:"searchPattern"`
+	}{TypeLocal, SearchPatternEnd})
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"type": "LOCAL", "searchPattern": "END"}`, string(data))
+
+	_, err = json.Marshal(Type(7))
+	assert.ErrorIs(t, err, InvalidTypeError)
+}
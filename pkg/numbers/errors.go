@@ -1,6 +1,10 @@
 package numbers
 
-import "github.com/thezmc/go-sinch/pkg/sinch"
+import (
+	"fmt"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
 
 const (
 	ProjectIDRequiredError     = sinch.Error("project ID is required")
@@ -12,4 +16,42 @@ const (
 	MissingConfigurationError  = sinch.Error("either smsConfiguration or voiceConfiguration or both must be set")
 	ServicePlanIDRequiredError = sinch.Error("service plan ID is required")
 	AppIDRequiredError         = sinch.Error("app ID is required")
+	TrunkIDRequiredError       = sinch.Error("SIP trunk ID is required")
+	FaxServiceIDRequiredError  = sinch.Error("fax service ID is required")
+	InvalidVoiceTypeError      = sinch.Error("voice configuration type must be one of RTC, EST or FAX")
+	NumberNotAvailableError    = sinch.Error("phone number is no longer available")
+	NilClientError             = sinch.Error("client cannot be nil")
+	UnsupportedRegionError     = sinch.Error("region is not supported by the numbers API")
+	UnsupportedRegionTypeError = sinch.Error("number type is not available in region")
+	HMACSecretRequiredError    = sinch.Error("HMAC secret is required")
+	ProvisioningFailedError    = sinch.Error("provisioning failed")
+	InvalidTypeError           = sinch.Error("type must be one of LOCAL, TOLL_FREE or MOBILE")
+	InvalidSearchPatternError  = sinch.Error("search pattern must be one of START, CONTAINS or END")
+	SearchPatternExactError    = sinch.Error("search pattern EXACT is not supported by the numbers API, use START, CONTAINS or END")
+	InvalidCapabilityError     = sinch.Error("capability must be one of SMS or VOICE")
+	PatternRequiredError       = sinch.Error("pattern is required when a search pattern is set")
+	InvalidDecimalError        = sinch.InvalidDecimalError
+	InvalidCurrencyError       = sinch.InvalidCurrencyError
+	CurrencyMismatchError      = sinch.CurrencyMismatchError
+)
+
+const (
+	TollFreeVerificationIDRequiredError = sinch.Error("toll-free verification ID is required")
+	BusinessNameRequiredError           = sinch.Error("business name is required")
+	BusinessWebsiteRequiredError        = sinch.Error("business website is required")
+	ContactEmailRequiredError           = sinch.Error("contact email is required")
+	UseCaseRequiredError                = sinch.Error("use case is required")
+	SampleMessageRequiredError          = sinch.Error("at least one sample message is required")
+	OptInRequiredError                  = sinch.Error("opt-in type and description are required")
+	InvalidTollFreeNumberError          = sinch.Error("toll-free numbers must be in E.164 format")
+	InvalidContactPhoneError            = sinch.Error("contact phone must be in E.164 format")
+	InvalidUseCaseError                 = sinch.Error("unknown toll-free use case")
+	InvalidOptInTypeError               = sinch.Error("opt-in type must be one of VERBAL, WEB_FORM, PAPER_FORM, VIA_TEXT or MOBILE_QR_CODE")
 )
+
+func NumberNotAvailableErr(phoneNumber string) error {
+	return sinch.Errors{
+		NumberNotAvailableError,
+		fmt.Errorf("phone number %s cannot be rented", phoneNumber),
+	}
+}
@@ -0,0 +1,142 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/biter777/countries"
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type ListActiveNumbersAction struct {
+	request  *ListActiveNumbersRequest
+	response *ListActiveNumbersResponse
+}
+
+func (lana *ListActiveNumbersAction) IsNumbersAction() {}
+
+func (lana *ListActiveNumbersAction) Request() *ListActiveNumbersRequest {
+	return lana.request
+}
+
+func (lana *ListActiveNumbersAction) Response() *ListActiveNumbersResponse {
+	return lana.response
+}
+
+type ListActiveNumbersRequest struct {
+	RegionCode    string   `url:"regionCode"`                            // Region code to filter by. ISO 3166-1 alpha-2 country code of the phone number. Example: US, GB or SE.
+	Type          string   `url:"type"`                                  // Number type to filter by. Options include MOBILE, LOCAL or TOLL_FREE.
+	Pattern       string   `url:"numberPattern.pattern,omitempty"`       // Sequence of digits to search for.
+	SearchPattern string   `url:"numberPattern.searchPattern,omitempty"` // Search pattern to apply. The options are, START, CONTAINS, and END.
+	Capabilities  []string `url:"capability,omitempty"`                  // Capabilities to filter by. Options include SMS or VOICE.
+	PageSize      int      `url:"pageSize,omitempty"`                    // The maximum number of items to return.
+	PageToken     string   `url:"pageToken,omitempty"`                   // The next page token value returned from a previous List request, if any.
+	OrderBy       string   `url:"orderBy,omitempty"`                     // Ordering of the returned values. Example: phoneNumber or displayName.
+}
+
+type ListActiveNumbersResponse struct {
+	ActiveNumbers []ActiveNumber `json:"activeNumbers"`
+	NextPage      string         `json:"nextPageToken"`
+	TotalSize     int            `json:"totalSize"`
+}
+
+func (lanr *ListActiveNumbersRequest) WithRegionCode(cc countries.CountryCode) *ListActiveNumbersRequest {
+	lanr.RegionCode = cc.Alpha2()
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) WithType(t Type) *ListActiveNumbersRequest {
+	lanr.Type = t.String()
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) WithPattern(pattern string, sp SearchPattern) *ListActiveNumbersRequest {
+	lanr.Pattern = pattern
+	lanr.SearchPattern = sp.String()
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) WithCapability(c ...Capability) *ListActiveNumbersRequest {
+	for _, capability := range c {
+		lanr.Capabilities = append(lanr.Capabilities, capability.String())
+	}
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) WithPageSize(pageSize int) *ListActiveNumbersRequest {
+	lanr.PageSize = pageSize
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) WithOrderBy(orderBy string) *ListActiveNumbersRequest {
+	lanr.OrderBy = orderBy
+	return lanr
+}
+
+func (lanr *ListActiveNumbersRequest) SetPageToken(token string) {
+	lanr.PageToken = token
+}
+
+func (lanr *ListActiveNumbersRequest) Validate() error {
+	var errs sinch.Errors
+	if lanr.RegionCode == "" {
+		errs = append(errs, RegionCodeRequiredError)
+	}
+	if lanr.Type == "" {
+		errs = append(errs, TypeRequiredError)
+	} else if !validEnumName(types, lanr.Type, int(TypeUnspecified)) {
+		errs = append(errs, InvalidTypeError)
+	}
+	if lanr.SearchPattern != "" && !validEnumName(searchPatterns, lanr.SearchPattern, int(SearchPatternUnspecified)) {
+		errs = append(errs, InvalidSearchPatternError)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (lanr *ListActiveNumbersRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lanr *ListActiveNumbersRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lanr *ListActiveNumbersRequest) Path() string {
+	return "/activeNumbers"
+}
+
+func (lanr *ListActiveNumbersRequest) QueryString() (string, error) {
+	v, err := query.Values(lanr)
+	if err != nil {
+		return "", err
+	}
+	return "?" + v.Encode(), nil
+}
+
+func (lanr *ListActiveNumbersRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lanr *ListActiveNumbersResponse) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, lanr)
+}
+
+func (lanr *ListActiveNumbersResponse) Items() []ActiveNumber {
+	return lanr.ActiveNumbers
+}
+
+func (lanr *ListActiveNumbersResponse) NextPageToken() string {
+	return lanr.NextPage
+}
+
+// ListActiveNumbers returns a pager over the numbers rented by the project that match the request.
+func (c *Client) ListActiveNumbers(req *ListActiveNumbersRequest) *api.Pager[ActiveNumber] {
+	return api.NewPager[ActiveNumber](c, req, func() sinch.ListResponse[ActiveNumber] {
+		return new(ListActiveNumbersResponse)
+	})
+}
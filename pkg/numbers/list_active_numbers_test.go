@@ -0,0 +1,50 @@
+package numbers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/biter777/countries"
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_ListActiveNumbers_Implementations(t *testing.T) {
+	var _ sinch.Action[*ListActiveNumbersRequest, *ListActiveNumbersResponse] = new(ListActiveNumbersAction)
+	var _ sinch.ListRequest = new(ListActiveNumbersRequest)
+	var _ sinch.ListResponse[ActiveNumber] = new(ListActiveNumbersResponse)
+}
+
+func Test_ListActiveNumbersRequest_Validate(t *testing.T) {
+	assert.ErrorContains(t, new(ListActiveNumbersRequest).Validate(), RegionCodeRequiredError.Error())
+	assert.ErrorContains(t, new(ListActiveNumbersRequest).Validate(), TypeRequiredError.Error())
+	assert.NoError(t, new(ListActiveNumbersRequest).WithRegionCode(countries.US).WithType(TypeLocal).Validate())
+}
+
+func Test_Client_ListActiveNumbers(t *testing.T) {
+	pages := map[string]string{
+		"":     `{"activeNumbers": [{"phoneNumber": "+12025550134"}, {"phoneNumber": "+12025550135"}], "nextPageToken": "next", "totalSize": 3}`,
+		"next": `{"activeNumbers": [{"phoneNumber": "+12025550136"}], "totalSize": 3}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/test/activeNumbers", r.URL.Path)
+		assert.Equal(t, "US", r.URL.Query().Get("regionCode"))
+		_, _ = w.Write([]byte(pages[r.URL.Query().Get("pageToken")]))
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithProjectID("test").
+		WithKeyID("test").
+		WithKeySecret("test").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+
+	numbers, err := client.ListActiveNumbers(new(ListActiveNumbersRequest).WithRegionCode(countries.US).WithType(TypeLocal)).All(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, numbers, 3) {
+		assert.Equal(t, "+12025550136", numbers[2].PhoneNumber)
+	}
+}
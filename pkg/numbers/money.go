@@ -0,0 +1,76 @@
+package numbers
+
+import "github.com/thezmc/go-sinch/pkg/sinch"
+
+// The money types moved to the sinch package, so that every API decodes amounts the same way. They are kept here under
+// their original names.
+type (
+	Decimal  = sinch.Decimal
+	Currency = sinch.Currency
+	Money    = sinch.Money
+	Price    = sinch.Money // Price is the price of a number.
+)
+
+var (
+	NewDecimal   = sinch.NewDecimal
+	ParseDecimal = sinch.ParseDecimal
+	NewMoney     = sinch.NewMoney
+)
+
+// NumberCost is the projected cost of renting a number.
+type NumberCost struct {
+	PhoneNumber string
+	Setup       Money // The one time setup price.
+	Recurring   Money // The monthly price multiplied by the number of months paid for.
+	Total       Money
+	Months      int // The number of months paid for, a multiple of the number's PaymentIntervalMonths.
+}
+
+// CostProjection is the projected cost of renting a set of numbers.
+type CostProjection struct {
+	Numbers []NumberCost
+	Total   Money
+}
+
+// CostOfOwnership projects the cost of renting the number for the given number of months. The monthly price is paid
+// every PaymentIntervalMonths, so months is rounded up to a whole number of payment intervals and is at least one
+// interval.
+func (an *AvailableNumber) CostOfOwnership(months int) (NumberCost, error) {
+	interval := an.PaymentIntervalMonths
+	if interval <= 0 {
+		interval = 1
+	}
+	paid := interval
+	if months > interval {
+		paid = (months + interval - 1) / interval * interval
+	}
+	recurring := an.MonthlyPrice.MulInt(int64(paid))
+	total, err := an.SetupPrice.Add(recurring)
+	if err != nil {
+		return NumberCost{}, err
+	}
+	return NumberCost{
+		PhoneNumber: an.PhoneNumber,
+		Setup:       an.SetupPrice,
+		Recurring:   recurring,
+		Total:       total,
+		Months:      paid,
+	}, nil
+}
+
+// ProjectCosts projects the cost of renting every candidate number for the given number of months. All candidates must
+// be priced in the same currency.
+func ProjectCosts(months int, candidates ...AvailableNumber) (*CostProjection, error) {
+	projection := &CostProjection{Numbers: make([]NumberCost, 0, len(candidates))}
+	for i := range candidates {
+		cost, err := candidates[i].CostOfOwnership(months)
+		if err != nil {
+			return nil, err
+		}
+		if projection.Total, err = projection.Total.Add(cost.Total); err != nil {
+			return nil, err
+		}
+		projection.Numbers = append(projection.Numbers, cost)
+	}
+	return projection, nil
+}
@@ -0,0 +1,34 @@
+package numbers
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Money_UnknownCurrency(t *testing.T) {
+	var an ActiveNumber
+	assert.NoError(t, an.FromJSON([]byte(`{"phoneNumber": "+12025550134", "money": {"amount": "2", "currencyCode": "ZZZ"}}`)))
+	assert.Equal(t, Currency("ZZZ"), an.Money.CurrencyCode)
+}
+
+func Test_ProjectCosts(t *testing.T) {
+	var candidates []AvailableNumber
+	assert.NoError(t, json.Unmarshal([]byte(`[
+		{"phoneNumber": "+12025550134", "setupPrice": {"amount": "1.00", "currencyCode": "USD"}, "monthlyPrice": {"amount": "0.50", "currencyCode": "USD"}, "paymentIntervalMonths": 1},
+		{"phoneNumber": "+12025550135", "setupPrice": {"amount": "0", "currencyCode": "USD"}, "monthlyPrice": {"amount": "0.10", "currencyCode": "USD"}, "paymentIntervalMonths": 12}
+	]`), &candidates))
+
+	projection, err := ProjectCosts(13, candidates...)
+	assert.NoError(t, err)
+	assert.Equal(t, 13, projection.Numbers[0].Months)
+	assert.Equal(t, "7.50 USD", projection.Numbers[0].Total.String())
+	assert.Equal(t, 24, projection.Numbers[1].Months)
+	assert.Equal(t, "2.40 USD", projection.Numbers[1].Total.String())
+	assert.Equal(t, "9.90 USD", projection.Total.String())
+
+	candidates[1].SetupPrice.CurrencyCode = "EUR"
+	_, err = ProjectCosts(1, candidates...)
+	assert.ErrorIs(t, err, CurrencyMismatchError)
+}
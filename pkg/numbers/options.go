@@ -0,0 +1,62 @@
+package numbers
+
+import (
+	"time"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Option configures a Client created with New.
+type Option = api.ServiceOption[*Client]
+
+// New returns a client that sends requests to BaseURLv1 with api.DefaultHTTPClient unless configured otherwise. It
+// returns an error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	return api.NewService(WithBaseURL(BaseURLv1), opts...)
+}
+
+var (
+	// WithSinchAPI sends requests with sinchAPI, see api.WithServiceSinchAPI.
+	WithSinchAPI = api.WithServiceSinchAPI[*Client]
+	// WithBaseURL sets the base URL.
+	WithBaseURL = api.WithServiceBaseURL[*Client]
+	// WithHTTPClient sets the HTTP client used to send requests.
+	WithHTTPClient = api.WithServiceHTTPClient[*Client]
+)
+
+// WithProjectID sets the project ID.
+func WithProjectID(projectID string) Option {
+	return func(c *Client) {
+		c.WithProjectID(projectID)
+	}
+}
+
+// WithKey sets the ID and secret of the access key used for basic authentication.
+func WithKey(keyID, keySecret string) Option {
+	return func(c *Client) {
+		c.WithKeyID(keyID).WithKeySecret(keySecret)
+	}
+}
+
+// WithAuthenticator authenticates requests with a instead of the access key.
+func WithAuthenticator(a sinch.Authenticator) Option {
+	return func(c *Client) {
+		c.WithAuthenticator(a)
+	}
+}
+
+// WithTokenSource authenticates requests with OAuth2 access tokens from ts instead of the access key.
+func WithTokenSource(ts auth.TokenSource) Option {
+	return func(c *Client) {
+		c.WithTokenSource(ts)
+	}
+}
+
+// WithProvisioningBackoff sets the initial and maximum intervals between polls in WaitForProvisioning.
+func WithProvisioningBackoff(initial, max time.Duration) Option {
+	return func(c *Client) {
+		c.WithProvisioningBackoff(initial, max)
+	}
+}
@@ -0,0 +1,63 @@
+package numbers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+func Test_New(t *testing.T) {
+	custom := &http.Client{}
+
+	tests := map[string]struct {
+		opts        []Option
+		wantBaseURL string
+		wantHTTP    *http.Client
+		wantErr     error
+	}{
+		"defaults": {
+			opts:        []Option{WithProjectID("project"), WithKey("key", "secret")},
+			wantBaseURL: BaseURLv1,
+			wantHTTP:    api.DefaultHTTPClient,
+		},
+		"custom": {
+			opts: []Option{
+				WithProjectID("project"),
+				WithKey("key", "secret"),
+				WithBaseURL("http://localhost"),
+				WithHTTPClient(custom),
+				WithProvisioningBackoff(time.Second, time.Minute),
+			},
+			wantBaseURL: "http://localhost",
+			wantHTTP:    custom,
+		},
+		"no project id": {
+			opts:    []Option{WithKey("key", "secret")},
+			wantErr: ProjectIDRequiredError,
+		},
+		"no key": {
+			opts:    []Option{WithProjectID("project")},
+			wantErr: KeyIDRequiredError,
+		},
+		"nil http client": {
+			opts:    []Option{WithProjectID("project"), WithKey("key", "secret"), WithHTTPClient(nil)},
+			wantErr: api.NilHTTPClientError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantBaseURL, got.API().BaseURL)
+			assert.Same(t, tt.wantHTTP, got.API().HTTPClient)
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package numbers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ProvisioningError is returned by WaitForProvisioning when the SMS or voice provisioning of a number failed.
+type ProvisioningError struct {
+	PhoneNumber   string
+	SMSStatus     ProvisioningStatus
+	SMSErrorCodes []ProvisioningErrorCode
+	VoiceStatus   ProvisioningStatus
+}
+
+func (pe *ProvisioningError) Error() string {
+	var failures []string
+	if pe.SMSStatus == ProvisioningStatusFailed {
+		codes := make([]string, len(pe.SMSErrorCodes))
+		for i, code := range pe.SMSErrorCodes {
+			codes[i] = string(code)
+		}
+		failures = append(failures, fmt.Sprintf("sms provisioning failed: [%s]", strings.Join(codes, ", ")))
+	}
+	if pe.VoiceStatus == ProvisioningStatusFailed {
+		failures = append(failures, "voice provisioning failed")
+	}
+	return fmt.Sprintf("%s: %s: %s", ProvisioningFailedError, pe.PhoneNumber, strings.Join(failures, "; "))
+}
+
+// Is makes errors.Is(err, ProvisioningFailedError) true for every ProvisioningError.
+func (pe *ProvisioningError) Is(target error) bool {
+	return target == ProvisioningFailedError
+}
+
+// ProvisioningStatus returns the SMS and voice provisioning statuses of the number. A configuration without a scheduled
+// provisioning reports ProvisioningStatusUnspecified.
+func (an *ActiveNumber) ProvisioningStatus() (sms ProvisioningStatus, voice ProvisioningStatus) {
+	sms, voice = ProvisioningStatusUnspecified, ProvisioningStatusUnspecified
+	if an.SMSConfiguration != nil && an.SMSConfiguration.ScheduledProvisioning != nil {
+		sms = an.SMSConfiguration.ScheduledProvisioning.Status
+	}
+	if an.VoiceConfiguration != nil && an.VoiceConfiguration.ScheduledVoiceProvisioning != nil {
+		voice = an.VoiceConfiguration.ScheduledVoiceProvisioning.Status
+	}
+	return sms, voice
+}
+
+// ProvisioningErr returns a ProvisioningError if the SMS or voice provisioning of the number failed.
+func (an *ActiveNumber) ProvisioningErr() error {
+	sms, voice := an.ProvisioningStatus()
+	if sms != ProvisioningStatusFailed && voice != ProvisioningStatusFailed {
+		return nil
+	}
+	pe := &ProvisioningError{PhoneNumber: an.PhoneNumber, SMSStatus: sms, VoiceStatus: voice}
+	if sms == ProvisioningStatusFailed {
+		pe.SMSErrorCodes = an.SMSConfiguration.ScheduledProvisioning.ErrorCodes
+	}
+	return pe
+}
+
+// WaitForProvisioning polls the active number until both its SMS and voice provisioning reach a terminal state and
+// returns it. The interval between polls starts at the client's PollInterval and doubles up to MaxPollInterval. If a
+// provisioning failed, the number is returned along with a ProvisioningError.
+func (c *Client) WaitForProvisioning(ctx context.Context, phoneNumber string) (*ActiveNumber, error) {
+	interval, maxInterval := c.PollInterval, c.MaxPollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	if maxInterval <= 0 {
+		maxInterval = DefaultMaxPollInterval
+	}
+
+	req := new(ActiveNumberRequest).WithPhoneNumber(phoneNumber)
+	for {
+		number := new(ActiveNumber)
+		if err := c.DoContext(ctx, req, number); err != nil {
+			return nil, err
+		}
+		if err := number.ProvisioningErr(); err != nil {
+			return number, err
+		}
+		if sms, voice := number.ProvisioningStatus(); sms.IsTerminal() && voice.IsTerminal() {
+			return number, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return number, ctx.Err()
+		case <-timer.C:
+		}
+		if interval *= 2; interval > maxInterval {
+			interval = maxInterval
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package numbers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+func Test_WaitForProvisioning(t *testing.T) {
+	const (
+		inProgress = `{"phoneNumber": "+12025550134",
+			"smsConfiguration": {"servicePlanId": "plan", "scheduledProvisioning": {"status": "IN_PROGRESS"}},
+			"voiceConfiguration": {"appId": "app", "scheduledVoiceProvisioning": {"status": "WAITING"}}}`
+		done = `{"phoneNumber": "+12025550134",
+			"smsConfiguration": {"servicePlanId": "plan"},
+			"voiceConfiguration": {"appId": "app"}}`
+		failed = `{"phoneNumber": "+12025550134",
+			"smsConfiguration": {"servicePlanId": "plan", "scheduledProvisioning": {"status": "FAILED", "errorCodes": ["CAMPAIGN_NOT_AVAILABLE"]}},
+			"voiceConfiguration": {"appId": "app"}}`
+	)
+
+	tests := map[string]struct {
+		responses     []string
+		timeout       time.Duration
+		expectedPolls int
+		expectedErr   error
+	}{
+		"already provisioned": {
+			responses:     []string{done},
+			expectedPolls: 1,
+		},
+		"provisioned after polling": {
+			responses:     []string{inProgress, inProgress, done},
+			expectedPolls: 3,
+		},
+		"provisioning failed": {
+			responses:     []string{inProgress, failed},
+			expectedPolls: 2,
+			expectedErr:   ProvisioningFailedError,
+		},
+		"context done": {
+			responses:   []string{inProgress},
+			timeout:     10 * time.Millisecond,
+			expectedErr: context.DeadlineExceeded,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var polls int
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp := test.responses[len(test.responses)-1]
+				if polls < len(test.responses) {
+					resp = test.responses[polls]
+				}
+				polls++
+				_, _ = w.Write([]byte(resp))
+			}))
+			defer srv.Close()
+
+			client := new(Client).
+				WithProjectID("test").
+				WithKeyID("test").
+				WithKeySecret("test").
+				WithProvisioningBackoff(time.Millisecond, 2*time.Millisecond).
+				WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+
+			ctx := context.Background()
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+			number, err := client.WaitForProvisioning(ctx, "+12025550134")
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			if test.expectedPolls > 0 {
+				assert.Equal(t, test.expectedPolls, polls)
+				assert.Equal(t, "+12025550134", number.PhoneNumber)
+			}
+		})
+	}
+}
+
+func Test_ProvisioningError(t *testing.T) {
+	number := new(ActiveNumber)
+	assert.NoError(t, number.FromJSON([]byte(`{"phoneNumber": "+12025550134",
+		"smsConfiguration": {"scheduledProvisioning": {"status": "FAILED", "errorCodes": ["INSUFFICIENT_BALANCE", "INTERNAL_ERROR"]}},
+		"voiceConfiguration": {"scheduledVoiceProvisioning": {"status": "FAILED"}}}`)))
+
+	err := number.ProvisioningErr()
+	var pe *ProvisioningError
+	if assert.True(t, errors.As(err, &pe)) {
+		assert.Equal(t, []ProvisioningErrorCode{ProvisioningErrorInsufficientBalance, ProvisioningErrorInternalError}, pe.SMSErrorCodes)
+		assert.Equal(t, ProvisioningStatusFailed, pe.VoiceStatus)
+	}
+	assert.EqualError(t, err, "provisioning failed: +12025550134: sms provisioning failed: [INSUFFICIENT_BALANCE, INTERNAL_ERROR]; voice provisioning failed")
+}
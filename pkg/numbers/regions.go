@@ -0,0 +1,203 @@
+package numbers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"golang.org/x/exp/slices"
+)
+
+const DefaultRegionCacheTTL = 24 * time.Hour
+
+type AvailableRegionsAction struct {
+	request  *AvailableRegionsRequest
+	response *AvailableRegionsResponse
+}
+
+func (ara *AvailableRegionsAction) IsNumbersAction() {}
+
+func (ara *AvailableRegionsAction) Request() *AvailableRegionsRequest {
+	return ara.request
+}
+
+func (ara *AvailableRegionsAction) Response() *AvailableRegionsResponse {
+	return ara.response
+}
+
+type AvailableRegionsRequest struct {
+	Types []Type `url:"types,omitempty"` // Only returns regions that support at least one of these number types. Options include MOBILE, LOCAL or TOLL_FREE.
+}
+
+type AvailableRegionsResponse struct {
+	AvailableRegions []AvailableRegion `json:"availableRegions"`
+}
+
+type AvailableRegion struct {
+	RegionCode string `json:"regionCode"` // ISO 3166-1 alpha-2 country code of the region. Example: US, GB or SE.
+	RegionName string `json:"regionName"` // Display name of the region. Example: United States, United Kingdom or Sweden.
+	Types      []Type `json:"types"`      // The number types supported in the region. Options include MOBILE, LOCAL or TOLL_FREE.
+}
+
+func (arr *AvailableRegionsRequest) WithType(t ...Type) *AvailableRegionsRequest {
+	arr.Types = append(arr.Types, t...)
+	return arr
+}
+
+func (arr *AvailableRegionsRequest) Validate() error {
+	return nil
+}
+
+func (arr *AvailableRegionsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (arr *AvailableRegionsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (arr *AvailableRegionsRequest) Path() string {
+	return "/availableRegions"
+}
+
+func (arr *AvailableRegionsRequest) QueryString() (string, error) {
+	v, err := query.Values(arr)
+	if err != nil {
+		return "", err
+	}
+	if len(v) == 0 {
+		return "", nil
+	}
+	return "?" + v.Encode(), nil
+}
+
+func (arr *AvailableRegionsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (arr *AvailableRegionsResponse) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, arr)
+}
+
+// RegionCache keeps the regions supported by the Numbers API in memory and refreshes them from the API once they are
+// older than its TTL. It is safe for concurrent use; concurrent lookups share a single refresh.
+type RegionCache struct {
+	client    *Client
+	ttl       time.Duration
+	mu        sync.Mutex
+	regions   map[string]AvailableRegion
+	fetchedAt time.Time
+	fetching  *regionFetch
+}
+
+// regionFetch is a refresh in progress. done is closed once regions or err are set.
+type regionFetch struct {
+	done    chan struct{}
+	regions map[string]AvailableRegion
+	err     error
+}
+
+func (rc *RegionCache) WithClient(client *Client) *RegionCache {
+	rc.client = client
+	return rc
+}
+
+// WithTTL sets how long the regions are cached before being fetched again. Defaults to DefaultRegionCacheTTL.
+func (rc *RegionCache) WithTTL(ttl time.Duration) *RegionCache {
+	rc.ttl = ttl
+	return rc
+}
+
+// Region returns the cached region for the given region code, fetching the regions from the API if needed.
+func (rc *RegionCache) Region(ctx context.Context, regionCode string) (AvailableRegion, bool, error) {
+	regions, err := rc.load(ctx)
+	if err != nil {
+		return AvailableRegion{}, false, err
+	}
+	region, ok := regions[regionCode]
+	return region, ok, nil
+}
+
+// Supports returns an error if the region is not supported by the Numbers API or if numberType is not available in it.
+func (rc *RegionCache) Supports(ctx context.Context, regionCode string, numberType Type) error {
+	region, ok, err := rc.Region(ctx, regionCode)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return UnsupportedRegionError
+	}
+	if numberType != TypeUnspecified && !slices.Contains(region.Types, numberType) {
+		return UnsupportedRegionTypeError
+	}
+	return nil
+}
+
+// load returns the cached regions, or fetches them without holding the lock. Callers that arrive while a fetch is in
+// progress wait for it, and fetch again themselves if it failed only because the context of its caller ended.
+func (rc *RegionCache) load(ctx context.Context) (map[string]AvailableRegion, error) {
+	ttl := rc.ttl
+	if ttl == 0 {
+		ttl = DefaultRegionCacheTTL
+	}
+	for {
+		rc.mu.Lock()
+		if rc.regions != nil && time.Since(rc.fetchedAt) < ttl {
+			regions := rc.regions
+			rc.mu.Unlock()
+			return regions, nil
+		}
+		if rc.client == nil {
+			rc.mu.Unlock()
+			return nil, NilClientError
+		}
+		f := rc.fetching
+		if f == nil {
+			f = &regionFetch{done: make(chan struct{})}
+			rc.fetching = f
+			rc.mu.Unlock()
+			rc.fetch(ctx, f)
+			return f.regions, f.err
+		}
+		rc.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-f.done:
+		}
+		if f.err == nil {
+			return f.regions, nil
+		}
+		if !isContextError(f.err) || ctx.Err() != nil {
+			return nil, f.err
+		}
+	}
+}
+
+func (rc *RegionCache) fetch(ctx context.Context, f *regionFetch) {
+	defer close(f.done)
+	resp := new(AvailableRegionsResponse)
+	f.err = rc.client.DoContext(ctx, new(AvailableRegionsRequest), resp)
+	if f.err == nil {
+		f.regions = make(map[string]AvailableRegion, len(resp.AvailableRegions))
+		for _, region := range resp.AvailableRegions {
+			f.regions[region.RegionCode] = region
+		}
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	if f.err == nil {
+		rc.regions, rc.fetchedAt = f.regions, time.Now()
+	}
+	rc.fetching = nil
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
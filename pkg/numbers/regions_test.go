@@ -0,0 +1,145 @@
+package numbers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/biter777/countries"
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_AvailableRegions_Implementations(t *testing.T) {
+	var _ sinch.Action[*AvailableRegionsRequest, *AvailableRegionsResponse] = new(AvailableRegionsAction)
+	var _ sinch.APIRequest = new(AvailableRegionsRequest)
+	var _ sinch.APIResponse = new(AvailableRegionsResponse)
+}
+
+func Test_AvailableRegionsRequest_QueryString(t *testing.T) {
+	qs, err := new(AvailableRegionsRequest).QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "", qs)
+
+	qs, err = new(AvailableRegionsRequest).WithType(TypeLocal, TypeTollFree).QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "?types=LOCAL&types=TOLL_FREE", qs)
+}
+
+func Test_RegionCache(t *testing.T) {
+	var fetches int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/test/availableNumbers" {
+			_, _ = w.Write([]byte(`{"availableNumbers": []}`))
+			return
+		}
+		atomic.AddInt32(&fetches, 1)
+		_, _ = w.Write([]byte(`{"availableRegions": [
+			{"regionCode": "US", "regionName": "United States", "types": ["LOCAL", "TOLL_FREE"]},
+			{"regionCode": "SE", "regionName": "Sweden", "types": ["MOBILE"]}
+		]}`))
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithProjectID("test").
+		WithKeyID("test").
+		WithKeySecret("test").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+	rc := new(RegionCache).WithClient(client)
+
+	tests := map[string]struct {
+		request     *AvailabilityRequest
+		expectedErr error
+	}{
+		"supported": {
+			request: new(AvailabilityRequest).WithRegionCode(countries.US).WithType(TypeTollFree),
+		},
+		"unsupported region": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.GB).WithType(TypeLocal),
+			expectedErr: UnsupportedRegionError,
+		},
+		"unsupported type": {
+			request:     new(AvailabilityRequest).WithRegionCode(countries.SE).WithType(TypeLocal),
+			expectedErr: UnsupportedRegionTypeError,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			request := test.request.WithRegionCache(rc)
+			assert.NoError(t, request.Validate())
+			err := client.Do(request, new(AvailabilityResponse))
+			if test.expectedErr != nil {
+				assert.ErrorIs(t, err, test.expectedErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches))
+
+	err := client.Do(new(AvailabilityRequest).WithRegionCache(rc), new(AvailabilityResponse))
+	assert.ErrorContains(t, err, RegionCodeRequiredError.Error())
+	assert.Equal(t, int32(1), atomic.LoadInt32(&fetches), "invalid requests are not checked against the cache")
+
+	rc.WithTTL(time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	assert.NoError(t, rc.Supports(context.Background(), "US", TypeLocal))
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+
+	assert.ErrorIs(t, new(RegionCache).Supports(context.Background(), "US", TypeLocal), NilClientError)
+}
+
+func Test_RegionCache_SharedRefresh(t *testing.T) {
+	var fetches int32
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&fetches, 1)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+			return
+		}
+		_, _ = w.Write([]byte(`{"availableRegions": [{"regionCode": "US", "types": ["LOCAL"]}]}`))
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithProjectID("test").
+		WithKeyID("test").
+		WithKeySecret("test").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+	rc := new(RegionCache).WithClient(client)
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() { leaderErr <- rc.Supports(leaderCtx, "US", TypeLocal) }()
+	for atomic.LoadInt32(&fetches) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = rc.Supports(context.Background(), "US", TypeLocal)
+		}(i)
+	}
+
+	cancelLeader()
+	assert.ErrorIs(t, <-leaderErr, context.Canceled)
+	close(release)
+	wg.Wait()
+	for _, err := range errs {
+		assert.NoError(t, err, "waiters retry when only the leader's context was cancelled")
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(&fetches))
+}
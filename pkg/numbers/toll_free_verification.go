@@ -0,0 +1,392 @@
+package numbers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// TollFreeVerificationStatus is the status of a toll-free verification request. US toll-free numbers must be verified
+// before they can send high volumes of messages.
+type TollFreeVerificationStatus string
+
+const (
+	TollFreeVerificationStatusUnspecified TollFreeVerificationStatus = "TFV_STATUS_UNSPECIFIED"
+	TollFreeVerificationStatusSubmitted   TollFreeVerificationStatus = "SUBMITTED"
+	TollFreeVerificationStatusInReview    TollFreeVerificationStatus = "IN_REVIEW"
+	TollFreeVerificationStatusVerified    TollFreeVerificationStatus = "VERIFIED"
+	TollFreeVerificationStatusRejected    TollFreeVerificationStatus = "REJECTED"
+)
+
+// IsTerminal returns true if the verification request was either verified or rejected.
+func (s TollFreeVerificationStatus) IsTerminal() bool {
+	return s == TollFreeVerificationStatusVerified || s == TollFreeVerificationStatusRejected
+}
+
+// TollFreeUseCase is the kind of traffic a toll-free number is going to send.
+type TollFreeUseCase string
+
+const (
+	TollFreeUseCase2FA                   TollFreeUseCase = "TWO_FACTOR_AUTHENTICATION"
+	TollFreeUseCaseAccountNotifications  TollFreeUseCase = "ACCOUNT_NOTIFICATIONS"
+	TollFreeUseCaseAppointments          TollFreeUseCase = "APPOINTMENTS"
+	TollFreeUseCaseCustomerCare          TollFreeUseCase = "CUSTOMER_CARE"
+	TollFreeUseCaseDeliveryNotifications TollFreeUseCase = "DELIVERY_NOTIFICATIONS"
+	TollFreeUseCaseFraudAlerts           TollFreeUseCase = "FRAUD_ALERTS"
+	TollFreeUseCaseMarketing             TollFreeUseCase = "MARKETING"
+	TollFreeUseCasePublicServiceAlerts   TollFreeUseCase = "PUBLIC_SERVICE_ALERTS"
+	TollFreeUseCaseMixed                 TollFreeUseCase = "MIXED"
+)
+
+// IsValid returns true if u is one of the declared use cases.
+func (u TollFreeUseCase) IsValid() bool {
+	switch u {
+	case TollFreeUseCase2FA, TollFreeUseCaseAccountNotifications, TollFreeUseCaseAppointments, TollFreeUseCaseCustomerCare,
+		TollFreeUseCaseDeliveryNotifications, TollFreeUseCaseFraudAlerts, TollFreeUseCaseMarketing,
+		TollFreeUseCasePublicServiceAlerts, TollFreeUseCaseMixed:
+		return true
+	}
+	return false
+}
+
+// OptInType is how the recipients of a toll-free number agree to receive its messages.
+type OptInType string
+
+const (
+	OptInVerbal    OptInType = "VERBAL"
+	OptInWebForm   OptInType = "WEB_FORM"
+	OptInPaperForm OptInType = "PAPER_FORM"
+	OptInViaText   OptInType = "VIA_TEXT"
+	OptInQRCode    OptInType = "MOBILE_QR_CODE"
+)
+
+// IsValid returns true if t is one of the declared opt-in types.
+func (t OptInType) IsValid() bool {
+	switch t {
+	case OptInVerbal, OptInWebForm, OptInPaperForm, OptInViaText, OptInQRCode:
+		return true
+	}
+	return false
+}
+
+// BusinessInfo identifies the business that sends the messages of a toll-free number.
+type BusinessInfo struct {
+	Name             string `json:"name"`
+	Website          string `json:"website"`
+	Street           string `json:"street,omitempty"`
+	City             string `json:"city,omitempty"`
+	State            string `json:"state,omitempty"`
+	PostalCode       string `json:"postalCode,omitempty"`
+	Country          string `json:"country,omitempty"` // ISO 3166-1 alpha-2 country code. Example: US.
+	ContactFirstName string `json:"contactFirstName,omitempty"`
+	ContactLastName  string `json:"contactLastName,omitempty"`
+	ContactEmail     string `json:"contactEmail"`
+	ContactPhone     string `json:"contactPhone,omitempty"` // In E.164 format with leading +.
+}
+
+// OptIn describes how recipients opt in to the messages of a toll-free number.
+type OptIn struct {
+	Type        OptInType `json:"type"`
+	Description string    `json:"description"`         // The steps a recipient takes to opt in.
+	ImageURLs   []string  `json:"imageUrls,omitempty"` // Screenshots or photos of the opt-in form or flow.
+}
+
+// TollFreeVerification is a request to verify the business and the traffic of one or more toll-free numbers.
+type TollFreeVerification struct {
+	ID                    string                     `json:"id,omitempty"`
+	ProjectID             string                     `json:"projectId,omitempty"`
+	PhoneNumbers          []string                   `json:"phoneNumbers"` // The toll-free numbers in E.164 format with leading +.
+	Business              BusinessInfo               `json:"businessInfo"`
+	UseCase               TollFreeUseCase            `json:"useCase"`
+	UseCaseSummary        string                     `json:"useCaseSummary,omitempty"`
+	MessageVolume         string                     `json:"messageVolume,omitempty"` // The expected monthly volume. Example: 10,000.
+	SampleMessages        []string                   `json:"sampleMessages"`
+	OptIn                 OptIn                      `json:"optIn"`
+	AdditionalInformation string                     `json:"additionalInformation,omitempty"`
+	Status                TollFreeVerificationStatus `json:"status,omitempty"`
+	RejectionReason       string                     `json:"rejectionReason,omitempty"` // Set when Status is REJECTED.
+	CreateTime            *sinch.Time                `json:"createTime,omitempty"`
+	UpdateTime            *sinch.Time                `json:"updateTime,omitempty"`
+}
+
+func (tfv *TollFreeVerification) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, tfv)
+}
+
+// Verified returns true if the numbers of the request were verified.
+func (tfv *TollFreeVerification) Verified() bool {
+	return tfv.Status == TollFreeVerificationStatusVerified
+}
+
+type TollFreeVerificationSubmitAction struct {
+	request  *TollFreeVerificationSubmitRequest
+	response *TollFreeVerification
+}
+
+func (tfvsa *TollFreeVerificationSubmitAction) IsNumbersAction() {}
+
+func (tfvsa *TollFreeVerificationSubmitAction) Request() *TollFreeVerificationSubmitRequest {
+	return tfvsa.request
+}
+
+func (tfvsa *TollFreeVerificationSubmitAction) Response() *TollFreeVerification {
+	return tfvsa.response
+}
+
+// TollFreeVerificationSubmitRequest submits toll-free numbers rented by the project for verification.
+type TollFreeVerificationSubmitRequest struct {
+	TollFreeVerification
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) WithPhoneNumbers(phoneNumbers ...string) *TollFreeVerificationSubmitRequest {
+	tfvsr.PhoneNumbers = append(tfvsr.PhoneNumbers, phoneNumbers...)
+	return tfvsr
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) WithBusiness(business BusinessInfo) *TollFreeVerificationSubmitRequest {
+	tfvsr.Business = business
+	return tfvsr
+}
+
+// WithUseCase sets the use case of the numbers and a summary of the messages they send.
+func (tfvsr *TollFreeVerificationSubmitRequest) WithUseCase(useCase TollFreeUseCase, summary string) *TollFreeVerificationSubmitRequest {
+	tfvsr.UseCase = useCase
+	tfvsr.UseCaseSummary = summary
+	return tfvsr
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) WithMessageVolume(volume string) *TollFreeVerificationSubmitRequest {
+	tfvsr.MessageVolume = volume
+	return tfvsr
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) WithSampleMessages(messages ...string) *TollFreeVerificationSubmitRequest {
+	tfvsr.SampleMessages = append(tfvsr.SampleMessages, messages...)
+	return tfvsr
+}
+
+// WithOptIn sets how recipients opt in, with optional URLs of images showing the opt-in flow.
+func (tfvsr *TollFreeVerificationSubmitRequest) WithOptIn(optInType OptInType, description string, imageURLs ...string) *TollFreeVerificationSubmitRequest {
+	tfvsr.OptIn = OptIn{Type: optInType, Description: description, ImageURLs: imageURLs}
+	return tfvsr
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) WithAdditionalInformation(info string) *TollFreeVerificationSubmitRequest {
+	tfvsr.AdditionalInformation = info
+	return tfvsr
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) Validate() error {
+	var errs sinch.Errors
+	if len(tfvsr.PhoneNumbers) == 0 {
+		errs = append(errs, PhoneNumberRequiredError)
+	}
+	for _, number := range tfvsr.PhoneNumbers {
+		if !sinch.IsE164(number) {
+			errs = append(errs, InvalidTollFreeNumberError)
+			break
+		}
+	}
+	if tfvsr.Business.Name == "" {
+		errs = append(errs, BusinessNameRequiredError)
+	}
+	if tfvsr.Business.Website == "" {
+		errs = append(errs, BusinessWebsiteRequiredError)
+	}
+	if tfvsr.Business.ContactEmail == "" {
+		errs = append(errs, ContactEmailRequiredError)
+	}
+	if tfvsr.Business.ContactPhone != "" && !sinch.IsE164(tfvsr.Business.ContactPhone) {
+		errs = append(errs, InvalidContactPhoneError)
+	}
+	if tfvsr.UseCase == "" {
+		errs = append(errs, UseCaseRequiredError)
+	} else if !tfvsr.UseCase.IsValid() {
+		errs = append(errs, InvalidUseCaseError)
+	}
+	if len(tfvsr.SampleMessages) == 0 {
+		errs = append(errs, SampleMessageRequiredError)
+	}
+	if tfvsr.OptIn.Type == "" || tfvsr.OptIn.Description == "" {
+		errs = append(errs, OptInRequiredError)
+	} else if !tfvsr.OptIn.Type.IsValid() {
+		errs = append(errs, InvalidOptInTypeError)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) Method() string {
+	return http.MethodPost
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) Path() string {
+	return "/tollFreeVerifications"
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (tfvsr *TollFreeVerificationSubmitRequest) Body() ([]byte, error) {
+	return json.Marshal(tfvsr)
+}
+
+type TollFreeVerificationAction struct {
+	request  *TollFreeVerificationRequest
+	response *TollFreeVerification
+}
+
+func (tfva *TollFreeVerificationAction) IsNumbersAction() {}
+
+func (tfva *TollFreeVerificationAction) Request() *TollFreeVerificationRequest {
+	return tfva.request
+}
+
+func (tfva *TollFreeVerificationAction) Response() *TollFreeVerification {
+	return tfva.response
+}
+
+// TollFreeVerificationRequest fetches a toll-free verification request to track its status.
+type TollFreeVerificationRequest struct {
+	ID string `url:"-" json:"-"`
+}
+
+func (tfvr *TollFreeVerificationRequest) WithID(id string) *TollFreeVerificationRequest {
+	tfvr.ID = id
+	return tfvr
+}
+
+func (tfvr *TollFreeVerificationRequest) Validate() error {
+	if tfvr.ID == "" {
+		return TollFreeVerificationIDRequiredError
+	}
+	return nil
+}
+
+func (tfvr *TollFreeVerificationRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (tfvr *TollFreeVerificationRequest) Method() string {
+	return http.MethodGet
+}
+
+func (tfvr *TollFreeVerificationRequest) Path() string {
+	return "/tollFreeVerifications/" + url.PathEscape(tfvr.ID)
+}
+
+func (tfvr *TollFreeVerificationRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (tfvr *TollFreeVerificationRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListTollFreeVerificationsAction struct {
+	request  *ListTollFreeVerificationsRequest
+	response *ListTollFreeVerificationsResponse
+}
+
+func (ltfva *ListTollFreeVerificationsAction) IsNumbersAction() {}
+
+func (ltfva *ListTollFreeVerificationsAction) Request() *ListTollFreeVerificationsRequest {
+	return ltfva.request
+}
+
+func (ltfva *ListTollFreeVerificationsAction) Response() *ListTollFreeVerificationsResponse {
+	return ltfva.response
+}
+
+type ListTollFreeVerificationsRequest struct {
+	PhoneNumber string                     `url:"phoneNumber,omitempty"` // Only the requests that include the number.
+	Status      TollFreeVerificationStatus `url:"status,omitempty"`
+	PageSize    int                        `url:"pageSize,omitempty"`
+	PageToken   string                     `url:"pageToken,omitempty"`
+}
+
+type ListTollFreeVerificationsResponse struct {
+	TollFreeVerifications []TollFreeVerification `json:"tollFreeVerifications"`
+	NextPage              string                 `json:"nextPageToken"`
+	TotalSize             int                    `json:"totalSize"`
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) WithPhoneNumber(phoneNumber string) *ListTollFreeVerificationsRequest {
+	ltfvr.PhoneNumber = phoneNumber
+	return ltfvr
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) WithStatus(status TollFreeVerificationStatus) *ListTollFreeVerificationsRequest {
+	ltfvr.Status = status
+	return ltfvr
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) WithPageSize(pageSize int) *ListTollFreeVerificationsRequest {
+	ltfvr.PageSize = pageSize
+	return ltfvr
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) SetPageToken(token string) {
+	ltfvr.PageToken = token
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) Validate() error {
+	return nil
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) Path() string {
+	return "/tollFreeVerifications"
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) QueryString() (string, error) {
+	v, err := query.Values(ltfvr)
+	if err != nil {
+		return "", err
+	}
+	if len(v) == 0 {
+		return "", nil
+	}
+	return "?" + v.Encode(), nil
+}
+
+func (ltfvr *ListTollFreeVerificationsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ltfvr *ListTollFreeVerificationsResponse) FromJSON(bytes []byte) error {
+	return json.Unmarshal(bytes, ltfvr)
+}
+
+func (ltfvr *ListTollFreeVerificationsResponse) Items() []TollFreeVerification {
+	return ltfvr.TollFreeVerifications
+}
+
+func (ltfvr *ListTollFreeVerificationsResponse) NextPageToken() string {
+	return ltfvr.NextPage
+}
+
+// ListTollFreeVerifications returns a pager over the toll-free verification requests of the project.
+func (c *Client) ListTollFreeVerifications(req *ListTollFreeVerificationsRequest) *api.Pager[TollFreeVerification] {
+	return api.NewPager[TollFreeVerification](c, req, func() sinch.ListResponse[TollFreeVerification] {
+		return new(ListTollFreeVerificationsResponse)
+	})
+}
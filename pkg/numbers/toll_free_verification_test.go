@@ -0,0 +1,169 @@
+package numbers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_TollFreeVerification_Implementations(t *testing.T) {
+	var _ sinch.Action[*TollFreeVerificationSubmitRequest, *TollFreeVerification] = new(TollFreeVerificationSubmitAction)
+	var _ sinch.Action[*TollFreeVerificationRequest, *TollFreeVerification] = new(TollFreeVerificationAction)
+	var _ sinch.Action[*ListTollFreeVerificationsRequest, *ListTollFreeVerificationsResponse] = new(ListTollFreeVerificationsAction)
+	var _ sinch.APIRequest = new(TollFreeVerificationSubmitRequest)
+	var _ sinch.APIRequest = new(TollFreeVerificationRequest)
+	var _ sinch.ListRequest = new(ListTollFreeVerificationsRequest)
+	var _ sinch.APIResponse = new(TollFreeVerification)
+	var _ sinch.ListResponse[TollFreeVerification] = new(ListTollFreeVerificationsResponse)
+}
+
+func validTollFreeVerificationSubmitRequest() *TollFreeVerificationSubmitRequest {
+	return new(TollFreeVerificationSubmitRequest).
+		WithPhoneNumbers("+18885550134").
+		WithBusiness(BusinessInfo{Name: "Acme", Website: "https://acme.example", ContactEmail: "ops@acme.example"}).
+		WithUseCase(TollFreeUseCaseDeliveryNotifications, "Shipping updates for online orders").
+		WithSampleMessages("Your order has shipped. Reply STOP to opt out.").
+		WithOptIn(OptInWebForm, "Customers tick a box at checkout", "https://acme.example/optin.png")
+}
+
+func Test_TollFreeVerificationSubmitRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		request *TollFreeVerificationSubmitRequest
+		wantErr error
+	}{
+		"valid":              {request: validTollFreeVerificationSubmitRequest()},
+		"no phone numbers":   {request: new(TollFreeVerificationSubmitRequest), wantErr: PhoneNumberRequiredError},
+		"no business name":   {request: new(TollFreeVerificationSubmitRequest), wantErr: BusinessNameRequiredError},
+		"no website":         {request: new(TollFreeVerificationSubmitRequest), wantErr: BusinessWebsiteRequiredError},
+		"no contact email":   {request: new(TollFreeVerificationSubmitRequest), wantErr: ContactEmailRequiredError},
+		"no use case":        {request: new(TollFreeVerificationSubmitRequest), wantErr: UseCaseRequiredError},
+		"no sample messages": {request: new(TollFreeVerificationSubmitRequest), wantErr: SampleMessageRequiredError},
+		"no opt-in description": {
+			request: validTollFreeVerificationSubmitRequest().WithOptIn(OptInVerbal, ""),
+			wantErr: OptInRequiredError,
+		},
+		"number without plus": {
+			request: validTollFreeVerificationSubmitRequest().WithPhoneNumbers("18885550135"),
+			wantErr: InvalidTollFreeNumberError,
+		},
+		"invalid contact phone": {
+			request: validTollFreeVerificationSubmitRequest().WithBusiness(BusinessInfo{
+				Name: "Acme", Website: "https://acme.example", ContactEmail: "ops@acme.example", ContactPhone: "555-0100",
+			}),
+			wantErr: InvalidContactPhoneError,
+		},
+		"unknown use case": {
+			request: validTollFreeVerificationSubmitRequest().WithUseCase("SPAM", "Unsolicited offers"),
+			wantErr: InvalidUseCaseError,
+		},
+		"unknown opt-in type": {
+			request: validTollFreeVerificationSubmitRequest().WithOptIn("IMPLIED", "Customers bought something once"),
+			wantErr: InvalidOptInTypeError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr.Error())
+		})
+	}
+}
+
+func Test_TollFreeVerificationSubmitRequest(t *testing.T) {
+	tfvsr := validTollFreeVerificationSubmitRequest().WithMessageVolume("10,000")
+	assert.Equal(t, http.MethodPost, tfvsr.Method())
+	assert.Equal(t, "/tollFreeVerifications", tfvsr.Path())
+
+	body, err := tfvsr.Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"phoneNumbers": ["+18885550134"],
+		"businessInfo": {"name": "Acme", "website": "https://acme.example", "contactEmail": "ops@acme.example"},
+		"useCase": "DELIVERY_NOTIFICATIONS",
+		"useCaseSummary": "Shipping updates for online orders",
+		"messageVolume": "10,000",
+		"sampleMessages": ["Your order has shipped. Reply STOP to opt out."],
+		"optIn": {"type": "WEB_FORM", "description": "Customers tick a box at checkout", "imageUrls": ["https://acme.example/optin.png"]}
+	}`, string(body))
+}
+
+func Test_TollFreeVerificationRequest(t *testing.T) {
+	tfvr := new(TollFreeVerificationRequest)
+	assert.ErrorIs(t, tfvr.Validate(), TollFreeVerificationIDRequiredError)
+
+	tfvr.WithID("tfv-1")
+	assert.NoError(t, tfvr.Validate())
+	assert.Equal(t, http.MethodGet, tfvr.Method())
+	assert.Equal(t, "/tollFreeVerifications/tfv-1", tfvr.Path())
+	assert.Equal(t, "/tollFreeVerifications/tfv%2F1%3Fx", tfvr.WithID("tfv/1?x").Path())
+	tfvr.WithID("tfv-1")
+
+	tfv := new(TollFreeVerification)
+	assert.NoError(t, tfv.FromJSON([]byte(`{"id": "tfv-1", "status": "REJECTED", "rejectionReason": "Opt-in not shown",
+		"updateTime": "2022-07-25T19:07:14Z"}`)))
+	assert.False(t, tfv.Verified())
+	assert.True(t, tfv.Status.IsTerminal())
+	assert.Equal(t, "Opt-in not shown", tfv.RejectionReason)
+	assert.Equal(t, 2022, tfv.UpdateTime.Year())
+}
+
+func Test_TollFreeVerificationStatus_IsTerminal(t *testing.T) {
+	tests := map[TollFreeVerificationStatus]bool{
+		TollFreeVerificationStatusUnspecified: false,
+		TollFreeVerificationStatusSubmitted:   false,
+		TollFreeVerificationStatusInReview:    false,
+		TollFreeVerificationStatusVerified:    true,
+		TollFreeVerificationStatusRejected:    true,
+	}
+
+	for status, want := range tests {
+		t.Run(string(status), func(t *testing.T) {
+			assert.Equal(t, want, status.IsTerminal())
+		})
+	}
+}
+
+func Test_ListTollFreeVerificationsRequest_QueryString(t *testing.T) {
+	qs, err := new(ListTollFreeVerificationsRequest).QueryString()
+	assert.NoError(t, err)
+	assert.Empty(t, qs)
+
+	qs, err = new(ListTollFreeVerificationsRequest).WithPhoneNumber("+18885550134").WithStatus(TollFreeVerificationStatusInReview).QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "?phoneNumber=%2B18885550134&status=IN_REVIEW", qs)
+}
+
+func Test_Client_ListTollFreeVerifications(t *testing.T) {
+	pages := map[string]string{
+		"":     `{"tollFreeVerifications": [{"id": "tfv-1", "status": "VERIFIED"}], "nextPageToken": "next", "totalSize": 2}`,
+		"next": `{"tollFreeVerifications": [{"id": "tfv-2", "status": "IN_REVIEW"}], "totalSize": 2}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/test/tollFreeVerifications", r.URL.Path)
+		_, _ = w.Write([]byte(pages[r.URL.Query().Get("pageToken")]))
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithProjectID("test").
+		WithKeyID("test").
+		WithKeySecret("test").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+
+	verifications, err := client.ListTollFreeVerifications(new(ListTollFreeVerificationsRequest)).All(context.Background())
+	assert.NoError(t, err)
+	if assert.Len(t, verifications, 2) {
+		assert.True(t, verifications[0].Verified())
+		assert.Equal(t, TollFreeVerificationStatusInReview, verifications[1].Status)
+	}
+}
@@ -1,12 +1,65 @@
 package numbers
 
+import (
+	"encoding/json"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
 type RequestSMSConfiguration struct {
 	ServicePlanID string `json:"servicePlanId"` // required
 	CampaignID    string `json:"campaignId"`
 }
 
+// VoiceConfigurationType is what the calls to a number are routed to.
+type VoiceConfigurationType string
+
+const (
+	VoiceConfigurationRTC VoiceConfigurationType = "RTC" // A Voice API application, see AppID.
+	VoiceConfigurationEST VoiceConfigurationType = "EST" // An Elastic SIP Trunk, see TrunkID.
+	VoiceConfigurationFAX VoiceConfigurationType = "FAX" // A Fax API service, see ServiceID.
+)
+
+// RequestVoiceConfiguration routes the calls to a number. Without a type, calls are routed to the Voice API application
+// AppID.
 type RequestVoiceConfiguration struct {
-	AppID string `json:"appId"`
+	Type      VoiceConfigurationType `json:"type,omitempty"`
+	AppID     string                 `json:"appId,omitempty"`
+	TrunkID   string                 `json:"trunkId,omitempty"`
+	ServiceID string                 `json:"serviceId,omitempty"`
+}
+
+// MarshalJSON always sends the app ID of configurations that route calls to an application, as an empty app ID removes
+// the number from its application. Trunk and fax service configurations leave it out.
+func (rvc RequestVoiceConfiguration) MarshalJSON() ([]byte, error) {
+	type plain RequestVoiceConfiguration
+	if rvc.Type != "" && rvc.Type != VoiceConfigurationRTC {
+		return json.Marshal(plain(rvc))
+	}
+	return json.Marshal(struct {
+		plain
+		AppID string `json:"appId"`
+	}{plain(rvc), rvc.AppID})
+}
+
+func (rvc *RequestVoiceConfiguration) validate() error {
+	switch rvc.Type {
+	case "", VoiceConfigurationRTC:
+		if rvc.AppID == "" {
+			return AppIDRequiredError
+		}
+	case VoiceConfigurationEST:
+		if rvc.TrunkID == "" {
+			return TrunkIDRequiredError
+		}
+	case VoiceConfigurationFAX:
+		if rvc.ServiceID == "" {
+			return FaxServiceIDRequiredError
+		}
+	default:
+		return InvalidVoiceTypeError
+	}
+	return nil
 }
 
 type ResponseSMSConfiguration struct {
@@ -16,21 +69,64 @@ type ResponseSMSConfiguration struct {
 }
 
 type ResponseScheduledProvisioning struct {
-	ServicePlanID   string   `json:"servicePlanId"`
-	Status          string   `json:"status"`
-	LastUpdatedTime string   `json:"lastUpdatedTime"`
-	CampaignID      string   `json:"campaignId"`
-	ErrorCodes      []string `json:"errorCodes"`
+	ServicePlanID   string                  `json:"servicePlanId"`
+	Status          ProvisioningStatus      `json:"status"`
+	LastUpdatedTime sinch.Time              `json:"lastUpdatedTime"`
+	CampaignID      string                  `json:"campaignId"`
+	ErrorCodes      []ProvisioningErrorCode `json:"errorCodes"`
 }
 
 type ResponseVoiceConfiguration struct {
+	Type                       VoiceConfigurationType              `json:"type,omitempty"`
+	TrunkID                    string                              `json:"trunkId,omitempty"`
+	ServiceID                  string                              `json:"serviceId,omitempty"`
 	AppID                      string                              `json:"appId"`
 	ScheduledVoiceProvisioning *ResponseScheduledVoiceProvisioning `json:"scheduledVoiceProvisioning"`
-	LastUpdatedTime            string                              `json:"lastUpdatedTime"`
+	LastUpdatedTime            sinch.Time                          `json:"lastUpdatedTime"`
 }
 
 type ResponseScheduledVoiceProvisioning struct {
-	AppID           string `json:"appId"`
-	Status          string `json:"status"`
-	LastUpdatedTime string `json:"lastUpdatedTime"`
+	AppID           string             `json:"appId"`
+	Status          ProvisioningStatus `json:"status"`
+	LastUpdatedTime sinch.Time         `json:"lastUpdatedTime"`
 }
+
+// ProvisioningStatus is the status of a scheduled SMS or voice provisioning.
+type ProvisioningStatus string
+
+const (
+	ProvisioningStatusUnspecified ProvisioningStatus = "PROVISIONING_STATUS_UNSPECIFIED"
+	ProvisioningStatusWaiting     ProvisioningStatus = "WAITING"
+	ProvisioningStatusInProgress  ProvisioningStatus = "IN_PROGRESS"
+	ProvisioningStatusFailed      ProvisioningStatus = "FAILED"
+)
+
+// IsTerminal returns true if the provisioning is no longer waiting or in progress.
+func (ps ProvisioningStatus) IsTerminal() bool {
+	return ps != ProvisioningStatusWaiting && ps != ProvisioningStatusInProgress
+}
+
+// ProvisioningErrorCode explains why a scheduled SMS provisioning failed.
+type ProvisioningErrorCode string
+
+const (
+	ProvisioningErrorInternalError                ProvisioningErrorCode = "INTERNAL_ERROR"
+	ProvisioningErrorSMSProvisioningFailed        ProvisioningErrorCode = "SMS_PROVISIONING_FAILED"
+	ProvisioningErrorCampaignProvisioningFailed   ProvisioningErrorCode = "CAMPAIGN_PROVISIONING_FAILED"
+	ProvisioningErrorCampaignNotAvailable         ProvisioningErrorCode = "CAMPAIGN_NOT_AVAILABLE"
+	ProvisioningErrorExceeded10DLCLimit           ProvisioningErrorCode = "EXCEEDED_10DLC_LIMIT"
+	ProvisioningErrorNumberProvisioningFailed     ProvisioningErrorCode = "NUMBER_PROVISIONING_FAILED"
+	ProvisioningErrorPartnerServiceUnavailable    ProvisioningErrorCode = "PARTNER_SERVICE_UNAVAILABLE"
+	ProvisioningErrorCampaignPendingAcceptance    ProvisioningErrorCode = "CAMPAIGN_PENDING_ACCEPTANCE"
+	ProvisioningErrorMNOSharingError              ProvisioningErrorCode = "MNO_SHARING_ERROR"
+	ProvisioningErrorCampaignExpired              ProvisioningErrorCode = "CAMPAIGN_EXPIRED"
+	ProvisioningErrorCampaignMNORejected          ProvisioningErrorCode = "CAMPAIGN_MNO_REJECTED"
+	ProvisioningErrorCampaignMNOSuspended         ProvisioningErrorCode = "CAMPAIGN_MNO_SUSPENDED"
+	ProvisioningErrorCampaignMNOReview            ProvisioningErrorCode = "CAMPAIGN_MNO_REVIEW"
+	ProvisioningErrorInsufficientBalance          ProvisioningErrorCode = "INSUFFICIENT_BALANCE"
+	ProvisioningErrorMockCampaignNotAllowed       ProvisioningErrorCode = "MOCK_CAMPAIGN_NOT_ALLOWED"
+	ProvisioningErrorTFNNotAllowed                ProvisioningErrorCode = "TFN_NOT_ALLOWED"
+	ProvisioningErrorInvalidNNID                  ProvisioningErrorCode = "INVALID_NNID"
+	ProvisioningErrorCampaignProvisioningStatus   ProvisioningErrorCode = "CAMPAIGN_PROVISIONING_STATUS_UNSPECIFIED"
+	ProvisioningErrorCampaignProvisioningRejected ProvisioningErrorCode = "CAMPAIGN_PROVISIONING_REJECTED"
+)
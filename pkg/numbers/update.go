@@ -24,12 +24,12 @@ type UpdateResponse struct {
 	ProjectId             string                      `json:"projectId"`
 	DisplayName           string                      `json:"displayName"`
 	RegionCode            string                      `json:"regionCode"`
-	Type                  string                      `json:"type"`
-	Capability            []string                    `json:"capability"`
+	Type                  Type                        `json:"type"`
+	Capability            []Capability                `json:"capability"`
 	Money                 Price                       `json:"money"`
 	PaymentIntervalMonths int                         `json:"paymentIntervalMonths"`
-	NextChargeDate        string                      `json:"nextChargeDate"`
-	ExpireAt              string                      `json:"expireAt"`
+	NextChargeDate        sinch.Time                  `json:"nextChargeDate"`
+	ExpireAt              sinch.Time                  `json:"expireAt"`
 	SMSConfiguration      *ResponseSMSConfiguration   `json:"smsConfiguration"`
 	VoiceConfiguration    *ResponseVoiceConfiguration `json:"voiceConfiguration,omitempty"`
 }
@@ -98,6 +98,18 @@ func (ur *UpdateRequest) WithVoiceConfigurationAppID(appID string) *UpdateReques
 	return ur
 }
 
+// WithSIPTrunk routes the calls to the number to an Elastic SIP Trunk instead of a Voice API application.
+func (ur *UpdateRequest) WithSIPTrunk(trunkID string) *UpdateRequest {
+	ur.VoiceConfiguration = &RequestVoiceConfiguration{Type: VoiceConfigurationEST, TrunkID: trunkID}
+	return ur
+}
+
+// WithFaxService routes the calls to the number to a Fax API service, so the number receives faxes.
+func (ur *UpdateRequest) WithFaxService(serviceID string) *UpdateRequest {
+	ur.VoiceConfiguration = &RequestVoiceConfiguration{Type: VoiceConfigurationFAX, ServiceID: serviceID}
+	return ur
+}
+
 func (ur *UpdateRequest) Validate() error {
 	var errors sinch.Errors
 	if ur.PhoneNumber == "" {
@@ -109,6 +121,12 @@ func (ur *UpdateRequest) Validate() error {
 			errors = append(errors, ServicePlanIDRequiredError)
 		}
 	}
+	// An untyped voice configuration without an app ID is allowed, it removes the number from its application.
+	if ur.VoiceConfiguration != nil && ur.VoiceConfiguration.Type != "" {
+		if err := ur.VoiceConfiguration.validate(); err != nil {
+			errors = append(errors, err)
+		}
+	}
 	if len(errors) > 0 {
 		return errors
 	}
@@ -133,3 +133,25 @@ func Test_UpdateRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_UpdateRequest_VoiceConfigurationBody(t *testing.T) {
+	tests := map[string]struct {
+		request *UpdateRequest
+		want    string
+	}{
+		"app":         {request: new(UpdateRequest).WithVoiceConfigurationAppID("app"), want: `{"appId":"app"}`},
+		"unlink app":  {request: new(UpdateRequest).WithVoiceConfigurationAppID(""), want: `{"appId":""}`},
+		"rtc":         {request: new(UpdateRequest).WithVoiceConfiguration(&RequestVoiceConfiguration{Type: VoiceConfigurationRTC, AppID: "app"}), want: `{"type":"RTC","appId":"app"}`},
+		"sip trunk":   {request: new(UpdateRequest).WithSIPTrunk("trunk"), want: `{"type":"EST","trunkId":"trunk"}`},
+		"fax service": {request: new(UpdateRequest).WithFaxService("service"), want: `{"type":"FAX","serviceId":"service"}`},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			body, err := tt.request.WithPhoneNumber("+12025550134").Body()
+			assert.NoError(t, err)
+			assert.NoError(t, tt.request.Validate())
+			assert.Contains(t, string(body), `"voiceConfiguration":`+tt.want)
+		})
+	}
+}
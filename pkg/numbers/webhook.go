@@ -0,0 +1,138 @@
+package numbers
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	SignatureHeader    = "X-Sinch-Signature"
+	MaxWebhookBodySize = 1 << 20
+)
+
+type EventType string
+
+const (
+	EventProvisioningToSMSPlatform       EventType = "PROVISIONING_TO_SMS_PLATFORM"
+	EventDeprovisioningFromSMSPlatform   EventType = "DEPROVISIONING_FROM_SMS_PLATFORM"
+	EventProvisioningToCampaign          EventType = "PROVISIONING_TO_CAMPAIGN"
+	EventDeprovisioningFromCampaign      EventType = "DEPROVISIONING_FROM_CAMPAIGN"
+	EventProvisioningToVoicePlatform     EventType = "PROVISIONING_TO_VOICE_PLATFORM"
+	EventDeprovisioningFromVoicePlatform EventType = "DEPROVISIONING_FROM_VOICE_PLATFORM"
+)
+
+type EventStatus string
+
+const (
+	EventStatusSucceeded EventStatus = "SUCCEEDED"
+	EventStatusFailed    EventStatus = "FAILED"
+)
+
+// Event is the payload the Numbers API sends to the project's callback URL.
+type Event struct {
+	EventID      string      `json:"eventId"`
+	Timestamp    sinch.Time  `json:"timestamp"`
+	ProjectID    string      `json:"projectId"`
+	ResourceID   string      `json:"resourceId"`   // The phone number the event is about, in E.164 format.
+	ResourceType string      `json:"resourceType"` // The type of the resource. Always ACTIVE_NUMBER.
+	EventType    EventType   `json:"eventType"`
+	Status       EventStatus `json:"status"`
+	FailureCode  string      `json:"failureCode"` // Set when Status is FAILED. Example: CAMPAIGN_NOT_AVAILABLE.
+}
+
+// Succeeded returns true if the operation the event is about succeeded.
+func (e *Event) Succeeded() bool {
+	return e.Status == EventStatusSucceeded
+}
+
+// EventHandlerFunc handles a Numbers API event. Returning an error makes the webhook respond with a 500 so Sinch
+// retries the callback.
+type EventHandlerFunc func(ctx context.Context, event *Event) error
+
+// WebhookHandler is an http.Handler that receives Numbers API events, verifies their signature with the HMAC secret
+// of the project's callback configuration and dispatches them to the registered handlers.
+type WebhookHandler struct {
+	Secret   string
+	handlers map[EventType]EventHandlerFunc
+	fallback EventHandlerFunc
+}
+
+// WithSecret sets the HMAC secret used to verify the signature of the events. See CallbackConfigurationUpdateRequest.
+func (wh *WebhookHandler) WithSecret(secret string) *WebhookHandler {
+	wh.Secret = secret
+	return wh
+}
+
+// Handle registers fn as the handler for events of the given type.
+func (wh *WebhookHandler) Handle(eventType EventType, fn EventHandlerFunc) *WebhookHandler {
+	if wh.handlers == nil {
+		wh.handlers = make(map[EventType]EventHandlerFunc)
+	}
+	wh.handlers[eventType] = fn
+	return wh
+}
+
+// HandleAll registers fn as the handler for events without a handler of their own.
+func (wh *WebhookHandler) HandleAll(fn EventHandlerFunc) *WebhookHandler {
+	wh.fallback = fn
+	return wh
+}
+
+func (wh *WebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxWebhookBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !VerifySignature(wh.Secret, body, r.Header.Get(SignatureHeader)) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	event := new(Event)
+	if err := json.Unmarshal(body, event); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	fn, ok := wh.handlers[event.EventType]
+	if !ok {
+		fn = wh.fallback
+	}
+	if fn != nil {
+		if err := fn(r.Context(), event); err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// Sign returns the hex encoded HMAC-SHA1 signature of body, as sent by Sinch in the X-Sinch-Signature header.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature returns true if signature is the signature of body with the given secret.
+func VerifySignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(strings.ToLower(signature)))
+}
@@ -0,0 +1,96 @@
+package numbers
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WebhookHandler(t *testing.T) {
+	const secret = "secret"
+	const body = `{"eventId": "1", "resourceId": "+12025550134", "eventType": "PROVISIONING_TO_SMS_PLATFORM", "status": "FAILED", "failureCode": "CAMPAIGN_NOT_AVAILABLE"}`
+
+	var received *Event
+	var fallbackCalled bool
+	handler := new(WebhookHandler).
+		WithSecret(secret).
+		Handle(EventProvisioningToSMSPlatform, func(ctx context.Context, event *Event) error {
+			received = event
+			return nil
+		}).
+		Handle(EventProvisioningToCampaign, func(ctx context.Context, event *Event) error {
+			return assert.AnError
+		}).
+		HandleAll(func(ctx context.Context, event *Event) error {
+			fallbackCalled = true
+			return nil
+		})
+
+	tests := map[string]struct {
+		method         string
+		body           string
+		signature      string
+		expectedStatus int
+	}{
+		"wrong method": {
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"missing signature": {
+			method:         http.MethodPost,
+			body:           body,
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"bad signature": {
+			method:         http.MethodPost,
+			body:           body,
+			signature:      Sign("wrong", []byte(body)),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"bad body": {
+			method:         http.MethodPost,
+			body:           "{",
+			signature:      Sign(secret, []byte("{")),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"handler error": {
+			method:         http.MethodPost,
+			body:           `{"eventType": "PROVISIONING_TO_CAMPAIGN"}`,
+			signature:      Sign(secret, []byte(`{"eventType": "PROVISIONING_TO_CAMPAIGN"}`)),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"fallback": {
+			method:         http.MethodPost,
+			body:           `{"eventType": "PROVISIONING_TO_VOICE_PLATFORM"}`,
+			signature:      Sign(secret, []byte(`{"eventType": "PROVISIONING_TO_VOICE_PLATFORM"}`)),
+			expectedStatus: http.StatusOK,
+		},
+		"success": {
+			method:         http.MethodPost,
+			body:           body,
+			signature:      strings.ToUpper(Sign(secret, []byte(body))),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req := httptest.NewRequest(test.method, "/callbacks/numbers", strings.NewReader(test.body))
+			req.Header.Set(SignatureHeader, test.signature)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			assert.Equal(t, test.expectedStatus, rec.Code)
+		})
+	}
+
+	assert.True(t, fallbackCalled)
+	if assert.NotNil(t, received) {
+		assert.Equal(t, "+12025550134", received.ResourceID)
+		assert.False(t, received.Succeeded())
+		assert.Equal(t, "CAMPAIGN_NOT_AVAILABLE", received.FailureCode)
+	}
+}
@@ -1,6 +1,7 @@
 package sinch
 
 import (
+	"errors"
 	"fmt"
 
 	"go.uber.org/multierr"
@@ -21,6 +22,26 @@ func (e Errors) Error() string {
 	return multierr.Combine(e...).Error()
 }
 
+// Is reports whether any of the errors matches target.
+func (e Errors) Is(target error) bool {
+	for _, err := range e {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first error that matches target and sets target to that error value.
+func (e Errors) As(target interface{}) bool {
+	for _, err := range e {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
 const (
 	NoAuthTokenError          = Error("an auth token is required")
 	NoBaseURLError            = Error("a base URL is required")
@@ -31,6 +52,12 @@ const (
 	InvalidRequestTypeError   = Error("invalid request type")
 )
 
+const (
+	InvalidDecimalError   = Error("invalid decimal")
+	InvalidCurrencyError  = Error("currency must be an ISO 4217 currency code")
+	CurrencyMismatchError = Error("amounts must be in the same currency")
+)
+
 func UnexpectedStatusCodeErr(exp, actual int) error {
 	return multierr.Combine(UnexpectedStatusCodeError, fmt.Errorf("expected %d, got %d", exp, actual))
 }
@@ -1,6 +1,9 @@
 package sinch
 
-import "net/http"
+import (
+	"context"
+	"net/http"
+)
 
 type Validatable interface {
 	Validate() error
@@ -20,10 +23,21 @@ type APIRequest interface {
 	Path() string
 }
 
+// ContentTyper is implemented by requests whose body is not JSON, like multipart file uploads. The body is sent with
+// the returned content type instead of application/json.
+type ContentTyper interface {
+	ContentType() string
+}
+
 type APIResponse interface {
 	FromJSON([]byte) error
 }
 
+// Authenticator adds credentials to requests before they are sent.
+type Authenticator interface {
+	Authenticate(*http.Request) (*http.Request, error)
+}
+
 type APIClient interface {
 	Validatable
 	Authenticate(*http.Request) (*http.Request, error)
@@ -31,8 +45,34 @@ type APIClient interface {
 	Do(APIRequest, APIResponse) error
 }
 
+// ContextAPIClient is an APIClient that can abort requests when a context is done.
+type ContextAPIClient interface {
+	APIClient
+	DoContext(context.Context, APIRequest, APIResponse) error
+}
+
 type API interface {
 	Do(client APIClient, req APIRequest, recv APIResponse) error
 }
 
+// ContextAPI is an API that can abort requests when a context is done.
+type ContextAPI interface {
+	API
+	DoContext(ctx context.Context, client APIClient, req APIRequest, recv APIResponse) error
+}
+
 type Action[RQ APIRequest, RS APIResponse] APIAction[RQ, RS]
+
+// ListRequest is a request for a page of a paginated list. The page token is whatever the API uses to select a page,
+// like a page number or an opaque token returned with the previous page. An empty token selects the first page.
+type ListRequest interface {
+	APIRequest
+	SetPageToken(token string)
+}
+
+// ListResponse is a page of a paginated list of T.
+type ListResponse[T any] interface {
+	APIResponse
+	Items() []T
+	NextPageToken() string // The token of the next page, or an empty string if this is the last page.
+}
@@ -1,6 +1,7 @@
 package sinch
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/stretchr/testify/mock"
@@ -58,6 +59,11 @@ func (m *MockAPI) Do(client APIClient, req APIRequest, recv APIResponse) error {
 	return args.Error(0)
 }
 
+func (m *MockAPI) DoContext(ctx context.Context, client APIClient, req APIRequest, recv APIResponse) error {
+	args := m.Called(ctx, client, req, recv)
+	return args.Error(0)
+}
+
 type MockAPIClient struct {
 	mock.Mock
 }
@@ -82,6 +88,11 @@ func (m *MockAPIClient) Do(req APIRequest, resp APIResponse) error {
 	return args.Error(0)
 }
 
+func (m *MockAPIClient) DoContext(ctx context.Context, req APIRequest, resp APIResponse) error {
+	args := m.Called(ctx, req, resp)
+	return args.Error(0)
+}
+
 type MockValidatable struct {
 	mock.Mock
 }
@@ -6,4 +6,8 @@ func Test_Mock_Implementations(t *testing.T) {
 	var _ APIAction[*MockAPIRequest, *MockAPIResponse] = new(MockAPIAction)
 	var _ APIRequest = new(MockAPIRequest)
 	var _ APIResponse = new(MockAPIResponse)
+	var _ APIClient = new(MockAPIClient)
+	var _ ContextAPIClient = new(MockAPIClient)
+	var _ API = new(MockAPI)
+	var _ ContextAPI = new(MockAPI)
 }
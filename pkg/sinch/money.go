@@ -0,0 +1,293 @@
+package sinch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/biter777/countries"
+)
+
+var decimalPattern = regexp.MustCompile(`^([+-]?)(\d*)(?:\.(\d*))?$`)
+
+// Decimal is an exact base 10 number. Arithmetic on decimals never loses precision, unlike float64. The zero value is 0.
+type Decimal struct {
+	unscaled *big.Int // The value of the decimal is unscaled * 10^-scale.
+	scale    int32
+}
+
+// NewDecimal returns the decimal unscaled * 10^-scale. For example, NewDecimal(1250, 2) is 12.50.
+func NewDecimal(unscaled int64, scale int32) Decimal {
+	if scale < 0 {
+		return Decimal{unscaled: new(big.Int).Mul(big.NewInt(unscaled), pow10(-scale))}
+	}
+	return Decimal{unscaled: big.NewInt(unscaled), scale: scale}
+}
+
+// ParseDecimal parses a decimal in plain notation, like "12.50" or "-0.0075". The number of digits after the decimal
+// point is kept, so String returns s unchanged.
+func ParseDecimal(s string) (Decimal, error) {
+	m := decimalPattern.FindStringSubmatch(s)
+	if m == nil || m[2] == "" && m[3] == "" {
+		return Decimal{}, fmt.Errorf("%w: %q", InvalidDecimalError, s)
+	}
+	unscaled, _ := new(big.Int).SetString(m[2]+m[3], 10)
+	if m[1] == "-" {
+		unscaled.Neg(unscaled)
+	}
+	return Decimal{unscaled: unscaled, scale: int32(len(m[3]))}, nil
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+func (d Decimal) int() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// rescale returns the unscaled value of d at the given scale, which must not be lower than the scale of d.
+func (d Decimal) rescale(scale int32) *big.Int {
+	return new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+}
+
+func maxScale(d, o Decimal) int32 {
+	if d.scale > o.scale {
+		return d.scale
+	}
+	return o.scale
+}
+
+// Scale returns the number of digits after the decimal point.
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+func (d Decimal) Add(o Decimal) Decimal {
+	scale := maxScale(d, o)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), o.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(o Decimal) Decimal {
+	return d.Add(o.Neg())
+}
+
+func (d Decimal) Mul(o Decimal) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), o.int()), scale: d.scale + o.scale}
+}
+
+// MulInt returns d * n.
+func (d Decimal) MulInt(n int64) Decimal {
+	return Decimal{unscaled: new(big.Int).Mul(d.int(), big.NewInt(n)), scale: d.scale}
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.int()), scale: d.scale}
+}
+
+// Cmp returns -1, 0 or 1 if d is lower than, equal to or greater than o. Trailing zeros are ignored, so 1.50 equals 1.5.
+func (d Decimal) Cmp(o Decimal) int {
+	scale := maxScale(d, o)
+	return d.rescale(scale).Cmp(o.rescale(scale))
+}
+
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Round returns d rounded half away from zero to the given number of digits after the decimal point. If d has fewer
+// digits than places, zeros are appended.
+func (d Decimal) Round(places int32) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if places >= d.scale {
+		return Decimal{unscaled: d.rescale(places), scale: places}
+	}
+	divisor := pow10(d.scale - places)
+	q, r := new(big.Int).QuoRem(new(big.Int).Abs(d.int()), divisor, new(big.Int))
+	if r.Lsh(r, 1).Cmp(divisor) >= 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if d.Sign() < 0 {
+		q.Neg(q)
+	}
+	return Decimal{unscaled: q, scale: places}
+}
+
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if pad := int(d.scale) + 1 - len(digits); pad > 0 {
+		digits = strings.Repeat("0", pad) + digits
+	}
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+// UnmarshalJSON accepts both JSON strings and numbers.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+	s := string(data)
+	if strings.HasPrefix(s, `"`) {
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*d = Decimal{}
+			return nil
+		}
+	}
+	parsed, err := ParseDecimal(s)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// Currency is an ISO 4217 alphabetic currency code, like USD or EUR.
+type Currency string
+
+// IsValid returns true if c is an ISO 4217 currency code.
+func (c Currency) IsValid() bool {
+	code := countries.CurrencyCodeByName(string(c))
+	return code.IsValid() && code.Alpha() == string(c)
+}
+
+// Digits returns the number of digits after the decimal point of the currency's minor unit, for example 2 for USD and
+// 0 for JPY. It returns 0 for invalid currencies.
+func (c Currency) Digits() int32 {
+	if !c.IsValid() {
+		return 0
+	}
+	return int32(countries.CurrencyCodeByName(string(c)).Digits())
+}
+
+// UnmarshalText accepts any currency code, so a code unknown to this package does not fail decoding the whole response.
+// Money in such a currency is rejected by the arithmetic and comparison methods instead.
+func (c *Currency) UnmarshalText(text []byte) error {
+	*c = Currency(strings.ToUpper(string(text)))
+	return nil
+}
+
+// Money is an exact amount in a currency.
+type Money struct {
+	Amount       Decimal  `json:"amount"`
+	CurrencyCode Currency `json:"currencyCode"`
+}
+
+// NewMoney parses amount and returns it as money in the given currency.
+func NewMoney(amount string, currency Currency) (Money, error) {
+	d, err := ParseDecimal(amount)
+	if err != nil {
+		return Money{}, err
+	}
+	m := Money{Amount: d, CurrencyCode: currency}
+	return m, m.Validate()
+}
+
+func (m Money) Validate() error {
+	if !m.CurrencyCode.IsValid() {
+		return InvalidCurrencyError
+	}
+	return nil
+}
+
+// Add returns the sum of m and o. Both must be in the same currency. Zero money without a currency can be added to
+// money in any currency, so a zero Money can be used to start a sum.
+func (m Money) Add(o Money) (Money, error) {
+	currency, err := commonCurrency(m, o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Add(o.Amount), CurrencyCode: currency}, nil
+}
+
+// Sub returns m minus o. Both must be in the same currency.
+func (m Money) Sub(o Money) (Money, error) {
+	currency, err := commonCurrency(m, o)
+	if err != nil {
+		return Money{}, err
+	}
+	return Money{Amount: m.Amount.Sub(o.Amount), CurrencyCode: currency}, nil
+}
+
+// MulInt returns m * n.
+func (m Money) MulInt(n int64) Money {
+	return Money{Amount: m.Amount.MulInt(n), CurrencyCode: m.CurrencyCode}
+}
+
+// Cmp compares m and o like Decimal.Cmp. Both must be in the same currency.
+func (m Money) Cmp(o Money) (int, error) {
+	if _, err := commonCurrency(m, o); err != nil {
+		return 0, err
+	}
+	return m.Amount.Cmp(o.Amount), nil
+}
+
+// commonCurrency returns the currency of m and o, or an error if they differ or if it is not an ISO 4217 currency.
+func commonCurrency(m, o Money) (Currency, error) {
+	var currency Currency
+	switch {
+	case m.CurrencyCode == o.CurrencyCode:
+		currency = m.CurrencyCode
+	case m.CurrencyCode == "" && m.Amount.IsZero():
+		currency = o.CurrencyCode
+	case o.CurrencyCode == "" && o.Amount.IsZero():
+		currency = m.CurrencyCode
+	default:
+		return "", fmt.Errorf("%w: %s and %s", CurrencyMismatchError, m.CurrencyCode, o.CurrencyCode)
+	}
+	if currency != "" && !currency.IsValid() {
+		return "", fmt.Errorf("%w: %q", InvalidCurrencyError, currency)
+	}
+	return currency, nil
+}
+
+// String formats the amount with at least as many digits as the currency's minor unit, followed by the currency code.
+// For example "12.50 USD" or "0.0075 EUR". The amount is never rounded.
+func (m Money) String() string {
+	amount := m.Amount
+	if digits := m.CurrencyCode.Digits(); amount.Scale() < digits {
+		amount = amount.Round(digits)
+	}
+	if m.CurrencyCode == "" {
+		return amount.String()
+	}
+	return amount.String() + " " + string(m.CurrencyCode)
+}
+
+// Price is an exact amount in a currency as returned by the Verification and Voice APIs, decoded without the rounding
+// errors of float64.
+type Price struct {
+	CurrencyID Currency `json:"currencyId"`
+	Amount     Decimal  `json:"amount"`
+}
+
+// Money returns the price as money, for arithmetic with other amounts.
+func (p Price) Money() Money {
+	return Money{Amount: p.Amount, CurrencyCode: p.CurrencyID}
+}
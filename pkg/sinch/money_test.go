@@ -0,0 +1,107 @@
+package sinch
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	d, err := ParseDecimal(s)
+	assert.NoError(t, err)
+	return d
+}
+
+func Test_ParseDecimal(t *testing.T) {
+	tests := map[string]struct {
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		"integer":         {input: "12", expected: "12"},
+		"trailing zeros":  {input: "12.50", expected: "12.50"},
+		"small":           {input: "0.0075", expected: "0.0075"},
+		"negative":        {input: "-1.5", expected: "-1.5"},
+		"leading point":   {input: ".5", expected: "0.5"},
+		"positive sign":   {input: "+3", expected: "3"},
+		"empty":           {input: "", wantErr: true},
+		"exponent":        {input: "1e3", wantErr: true},
+		"only point":      {input: ".", wantErr: true},
+		"multiple points": {input: "1.2.3", wantErr: true},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := ParseDecimal(test.input)
+			if test.wantErr {
+				assert.ErrorIs(t, err, InvalidDecimalError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, d.String())
+		})
+	}
+}
+
+func Test_Decimal_Arithmetic(t *testing.T) {
+	a, b := mustDecimal(t, "0.1"), mustDecimal(t, "0.2")
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	assert.Equal(t, "0.02", a.Mul(b).String())
+	assert.Equal(t, "1.2", a.MulInt(12).String())
+	assert.Equal(t, 0, mustDecimal(t, "1.50").Cmp(mustDecimal(t, "1.5")))
+	assert.Equal(t, -1, a.Cmp(b))
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, "12.50", NewDecimal(1250, 2).String())
+	assert.Equal(t, "1200", NewDecimal(12, -2).String())
+	assert.Equal(t, "0.01", mustDecimal(t, "0.005").Round(2).String())
+	assert.Equal(t, "-0.01", mustDecimal(t, "-0.005").Round(2).String())
+	assert.Equal(t, "0.00", mustDecimal(t, "0.004").Round(2).String())
+	assert.Equal(t, "2.500", mustDecimal(t, "2.5").Round(3).String())
+}
+
+func Test_Money(t *testing.T) {
+	var m Money
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": "2.5", "currencyCode": "usd"}`), &m))
+	assert.Equal(t, Currency("USD"), m.CurrencyCode)
+	assert.Equal(t, "2.50 USD", m.String())
+
+	data, err := json.Marshal(m)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"amount": "2.5", "currencyCode": "USD"}`, string(data))
+
+	var unknown Money
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": "1", "currencyCode": "xyz"}`), &unknown))
+	assert.Equal(t, Currency("XYZ"), unknown.CurrencyCode)
+	assert.Equal(t, "1 XYZ", unknown.String())
+	_, err = unknown.Add(unknown)
+	assert.ErrorIs(t, err, InvalidCurrencyError)
+	_, err = unknown.Cmp(unknown)
+	assert.ErrorIs(t, err, InvalidCurrencyError)
+
+	assert.NoError(t, json.Unmarshal([]byte(`{"amount": 1.25, "currencyCode": "JPY"}`), &m))
+	assert.Equal(t, "1.25 JPY", m.String())
+
+	_, err = NewMoney("1", "Dollar")
+	assert.ErrorIs(t, err, InvalidCurrencyError)
+
+	usd, _ := NewMoney("1.10", "USD")
+	eur, _ := NewMoney("1.10", "EUR")
+	_, err = usd.Add(eur)
+	assert.ErrorIs(t, err, CurrencyMismatchError)
+	sum, err := Money{}.Add(usd)
+	assert.NoError(t, err)
+	assert.Equal(t, "1.10 USD", sum.String())
+	diff, err := usd.Sub(usd.MulInt(3))
+	assert.NoError(t, err)
+	assert.Equal(t, "-2.20 USD", diff.String())
+}
+
+func Test_Price(t *testing.T) {
+	var p Price
+	assert.NoError(t, json.Unmarshal([]byte(`{"currencyId": "USD", "amount": 0.0453}`), &p))
+	assert.Equal(t, "0.0453", p.Amount.String())
+	total, err := p.Money().MulInt(3).Add(Money{})
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1359 USD", total.String())
+}
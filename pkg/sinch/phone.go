@@ -0,0 +1,10 @@
+package sinch
+
+import "regexp"
+
+var e164 = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+
+// IsE164 reports whether number is a phone number in E.164 format with a leading +, e.g. +12025550134.
+func IsE164(number string) bool {
+	return e164.MatchString(number)
+}
@@ -0,0 +1,26 @@
+package sinch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_IsE164(t *testing.T) {
+	tests := map[string]bool{
+		"+12025550134":      true,
+		"+461":              true,
+		"+123456789012345":  true,
+		"12025550134":       false,
+		"+02025550134":      false,
+		"+1":                false,
+		"+1234567890123456": false,
+		"+1 202 555 0134":   false,
+		"":                  false,
+	}
+	for number, want := range tests {
+		t.Run(number, func(t *testing.T) {
+			assert.Equal(t, want, IsE164(number))
+		})
+	}
+}
@@ -0,0 +1,9 @@
+package sinch
+
+// NoContent is the response of requests whose response has no body, like 204 No Content responses.
+type NoContent struct{}
+
+// FromJSON ignores the response body.
+func (NoContent) FromJSON([]byte) error {
+	return nil
+}
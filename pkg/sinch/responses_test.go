@@ -0,0 +1,16 @@
+package sinch
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NoContent(t *testing.T) {
+	var _ APIResponse = NoContent{}
+	var _ APIResponse = new(NoContent)
+
+	for _, data := range [][]byte{nil, {}, []byte("not json")} {
+		assert.NoError(t, new(NoContent).FromJSON(data))
+	}
+}
@@ -0,0 +1,120 @@
+package sinch
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Time is a timestamp in one of the ISO-8601 flavors used by the Sinch APIs, like the millisecond precision
+// 2006-01-02T15:04:05.000Z of the SMS API or the RFC 3339 timestamps of the Numbers API. It remembers the layout it was
+// parsed from, so marshaling it back produces the original string.
+type Time struct {
+	time.Time
+	layout string
+}
+
+const (
+	// MillisecondLayout is the layout of SMS API timestamps. UTC times are formatted with a trailing Z.
+	MillisecondLayout = "2006-01-02T15:04:05.000Z07:00"
+)
+
+// NewTime returns t formatted as RFC 3339 with nanosecond precision when marshaled.
+func NewTime(t time.Time) Time {
+	return Time{Time: t}
+}
+
+// WithLayout returns a copy of t that is marshaled with the given layout.
+func (t Time) WithLayout(layout string) Time {
+	t.layout = layout
+	return t
+}
+
+// Layout returns the layout used to marshal t.
+func (t Time) Layout() string {
+	if t.layout == "" {
+		return time.RFC3339Nano
+	}
+	return t.layout
+}
+
+// ParseTime parses an ISO-8601 timestamp with or without fractional seconds or a time zone. Timestamps without a time
+// zone are in UTC.
+func ParseTime(s string) (Time, error) {
+	layout := layoutOf(s)
+	parsed, err := time.Parse(layout, s)
+	if err != nil {
+		return Time{}, err
+	}
+	return Time{Time: parsed, layout: layout}, nil
+}
+
+// layoutOf builds the layout matching s, keeping the number of fractional digits and whether s has a time zone.
+func layoutOf(s string) string {
+	layout := "2006-01-02T15:04:05"
+	rest := s
+	if len(rest) >= len(layout) {
+		rest = rest[len(layout):]
+	}
+	if strings.HasPrefix(rest, ".") {
+		digits := 1
+		for digits < len(rest) && rest[digits] >= '0' && rest[digits] <= '9' {
+			digits++
+		}
+		layout += "." + strings.Repeat("0", digits-1)
+		rest = rest[digits:]
+	}
+	if rest != "" {
+		layout += "Z07:00"
+	}
+	return layout
+}
+
+func (t Time) String() string {
+	return t.Format(t.Layout())
+}
+
+func (t Time) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+func (t *Time) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*t = Time{}
+		return nil
+	}
+	parsed, err := ParseTime(string(text))
+	if err != nil {
+		return err
+	}
+	*t = parsed
+	return nil
+}
+
+// MarshalJSON marshals the zero time as null.
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return json.Marshal(t.String())
+}
+
+func (t *Time) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return t.UnmarshalText([]byte(s))
+}
+
+// EncodeValues encodes t as a query string parameter.
+func (t Time) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, t.String())
+	return nil
+}
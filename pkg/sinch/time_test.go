@@ -0,0 +1,53 @@
+package sinch
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Time_RoundTrip(t *testing.T) {
+	tests := map[string]string{
+		"sms milliseconds":    `"2022-07-25T19:07:14.123Z"`,
+		"rfc 3339":            `"2022-07-25T19:07:14Z"`,
+		"rfc 3339 nanosecond": `"2022-07-25T19:07:14.123456789Z"`,
+		"offset":              `"2022-07-25T21:07:14.500+02:00"`,
+		"no time zone":        `"2022-07-25T19:07:14"`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			var parsed Time
+			assert.NoError(t, json.Unmarshal([]byte(input), &parsed))
+			assert.Equal(t, 2022, parsed.Year())
+			data, err := json.Marshal(parsed)
+			assert.NoError(t, err)
+			assert.Equal(t, input, string(data))
+		})
+	}
+}
+
+func Test_Time_JSON(t *testing.T) {
+	var parsed Time
+	assert.NoError(t, json.Unmarshal([]byte(`null`), &parsed))
+	assert.True(t, parsed.IsZero())
+	assert.NoError(t, json.Unmarshal([]byte(`""`), &parsed))
+	assert.True(t, parsed.IsZero())
+	assert.Error(t, json.Unmarshal([]byte(`"yesterday"`), &parsed))
+	assert.Error(t, json.Unmarshal([]byte(`12`), &parsed))
+
+	data, err := json.Marshal(Time{})
+	assert.NoError(t, err)
+	assert.Equal(t, "null", string(data))
+
+	ms := time.Date(2022, 7, 25, 19, 7, 14, 0, time.UTC)
+	data, err = json.Marshal(NewTime(ms).WithLayout(MillisecondLayout))
+	assert.NoError(t, err)
+	assert.Equal(t, `"2022-07-25T19:07:14.000Z"`, string(data))
+	assert.Equal(t, "2022-07-25T19:07:14Z", NewTime(ms).String())
+
+	a, _ := ParseTime("2022-07-25T19:07:14.000Z")
+	b, _ := ParseTime("2022-07-25T21:07:14+02:00")
+	assert.True(t, a.Equal(b.Time))
+}
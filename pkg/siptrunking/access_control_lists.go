@@ -0,0 +1,612 @@
+package siptrunking
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// AccessControlList is a list of IP ranges that are allowed to send calls to the SIP trunks it is assigned to.
+type AccessControlList struct {
+	ID         string      `json:"id,omitempty"`
+	Name       string      `json:"name"`
+	Enabled    bool        `json:"enabled"`
+	IPRanges   []IPRange   `json:"ipRanges,omitempty"`
+	ProjectID  string      `json:"projectId,omitempty"`
+	CreateTime *sinch.Time `json:"createTime,omitempty"`
+	UpdateTime *sinch.Time `json:"updateTime,omitempty"`
+}
+
+// IPRange is an IPv4 address with the number of leading bits of its network, like 203.0.113.0/24.
+type IPRange struct {
+	ID          string `json:"id,omitempty"`
+	Description string `json:"description,omitempty"`
+	IPAddress   string `json:"ipAddress"`
+	Range       int    `json:"range"`
+}
+
+// NewAccessControlList returns an enabled access control list.
+func NewAccessControlList(name string) *AccessControlList {
+	return &AccessControlList{Name: name, Enabled: true}
+}
+
+func (acl *AccessControlList) WithID(id string) *AccessControlList {
+	acl.ID = id
+	return acl
+}
+
+// WithIPRange adds the IP range in CIDR notation, e.g. 203.0.113.0/24. A single address is added as a /32 range.
+func (acl *AccessControlList) WithIPRange(cidr, description string) *AccessControlList {
+	acl.IPRanges = append(acl.IPRanges, ParseIPRange(cidr, description))
+	return acl
+}
+
+func (acl *AccessControlList) validate() error {
+	if acl.Name == "" {
+		return NameRequiredError
+	}
+	for _, ipRange := range acl.IPRanges {
+		if err := ipRange.validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (acl *AccessControlList) FromJSON(data []byte) error {
+	return json.Unmarshal(data, acl)
+}
+
+// ParseIPRange returns the IP range of cidr, e.g. 203.0.113.0/24. An address without a range is a /32 range. Invalid
+// ranges are returned as is and rejected by the requests they are sent with.
+func ParseIPRange(cidr, description string) IPRange {
+	ipRange := IPRange{Description: description, IPAddress: cidr, Range: 32}
+	if ip, network, err := net.ParseCIDR(cidr); err == nil {
+		ipRange.IPAddress = ip.String()
+		ipRange.Range, _ = network.Mask.Size()
+	}
+	return ipRange
+}
+
+func (r IPRange) validate() error {
+	if ip := net.ParseIP(r.IPAddress); ip == nil || ip.To4() == nil || r.Range < 0 || r.Range > 32 {
+		return InvalidIPRangeError
+	}
+	return nil
+}
+
+func (r *IPRange) FromJSON(data []byte) error {
+	return json.Unmarshal(data, r)
+}
+
+func accessControlListPath(aclID string) string {
+	return "/accessControlLists/" + url.PathEscape(aclID)
+}
+
+type CreateAccessControlListAction struct {
+	request  *CreateAccessControlListRequest
+	response *AccessControlList
+}
+
+func (cacla *CreateAccessControlListAction) Request() *CreateAccessControlListRequest {
+	return cacla.request
+}
+
+func (cacla *CreateAccessControlListAction) Response() *AccessControlList {
+	return cacla.response
+}
+
+// CreateAccessControlListRequest creates an access control list with its IP ranges. The response is the created list.
+type CreateAccessControlListRequest struct {
+	AccessControlList *AccessControlList
+}
+
+func (caclr *CreateAccessControlListRequest) WithAccessControlList(acl *AccessControlList) *CreateAccessControlListRequest {
+	caclr.AccessControlList = acl
+	return caclr
+}
+
+func (caclr *CreateAccessControlListRequest) Validate() error {
+	if caclr.AccessControlList == nil {
+		return AccessControlListRequiredError
+	}
+	return caclr.AccessControlList.validate()
+}
+
+func (caclr *CreateAccessControlListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (caclr *CreateAccessControlListRequest) Method() string {
+	return http.MethodPost
+}
+
+func (caclr *CreateAccessControlListRequest) Path() string {
+	return "/accessControlLists"
+}
+
+func (caclr *CreateAccessControlListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (caclr *CreateAccessControlListRequest) Body() ([]byte, error) {
+	return json.Marshal(caclr.AccessControlList)
+}
+
+type GetAccessControlListAction struct {
+	request  *GetAccessControlListRequest
+	response *AccessControlList
+}
+
+func (gacla *GetAccessControlListAction) Request() *GetAccessControlListRequest {
+	return gacla.request
+}
+
+func (gacla *GetAccessControlListAction) Response() *AccessControlList {
+	return gacla.response
+}
+
+// GetAccessControlListRequest fetches an access control list with its IP ranges.
+type GetAccessControlListRequest struct {
+	AccessControlListID string
+}
+
+func (gaclr *GetAccessControlListRequest) WithAccessControlListID(aclID string) *GetAccessControlListRequest {
+	gaclr.AccessControlListID = aclID
+	return gaclr
+}
+
+func (gaclr *GetAccessControlListRequest) Validate() error {
+	if gaclr.AccessControlListID == "" {
+		return AccessControlListIDRequiredError
+	}
+	return nil
+}
+
+func (gaclr *GetAccessControlListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gaclr *GetAccessControlListRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gaclr *GetAccessControlListRequest) Path() string {
+	return accessControlListPath(gaclr.AccessControlListID)
+}
+
+func (gaclr *GetAccessControlListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gaclr *GetAccessControlListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListAccessControlListsAction struct {
+	request  *ListAccessControlListsRequest
+	response *ListAccessControlListsResponse
+}
+
+func (lacla *ListAccessControlListsAction) Request() *ListAccessControlListsRequest {
+	return lacla.request
+}
+
+func (lacla *ListAccessControlListsAction) Response() *ListAccessControlListsResponse {
+	return lacla.response
+}
+
+// ListAccessControlListsRequest lists the access control lists of the project.
+type ListAccessControlListsRequest struct {
+	PageSize int `url:"pageSize,omitempty"`
+	Page     int `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListAccessControlListsResponse struct {
+	AccessControlLists []AccessControlList `json:"accessControlLists"`
+	Page               int                 `json:"page"`
+	PageSize           int                 `json:"pageSize"`
+	TotalItems         int                 `json:"totalItems"`
+}
+
+func (laclr *ListAccessControlListsRequest) WithPageSize(pageSize int) *ListAccessControlListsRequest {
+	laclr.PageSize = pageSize
+	return laclr
+}
+
+// SetPageToken sets the page number from a token returned by ListAccessControlListsResponse.NextPageToken.
+func (laclr *ListAccessControlListsRequest) SetPageToken(token string) {
+	laclr.Page, _ = strconv.Atoi(token)
+}
+
+func (laclr *ListAccessControlListsRequest) Validate() error {
+	return validPageSize(laclr.PageSize)
+}
+
+func (laclr *ListAccessControlListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (laclr *ListAccessControlListsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (laclr *ListAccessControlListsRequest) Path() string {
+	return "/accessControlLists"
+}
+
+func (laclr *ListAccessControlListsRequest) QueryString() (string, error) {
+	return queryString(laclr)
+}
+
+func (laclr *ListAccessControlListsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (laclr *ListAccessControlListsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, laclr)
+}
+
+func (laclr *ListAccessControlListsResponse) Items() []AccessControlList {
+	return laclr.AccessControlLists
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (laclr *ListAccessControlListsResponse) NextPageToken() string {
+	return nextPage(laclr.Page, laclr.PageSize, laclr.TotalItems)
+}
+
+type UpdateAccessControlListAction struct {
+	request  *UpdateAccessControlListRequest
+	response *AccessControlList
+}
+
+func (uacla *UpdateAccessControlListAction) Request() *UpdateAccessControlListRequest {
+	return uacla.request
+}
+
+func (uacla *UpdateAccessControlListAction) Response() *AccessControlList {
+	return uacla.response
+}
+
+// UpdateAccessControlListRequest updates the name and the enabled state of the access control list with the ID of
+// AccessControlList. IP ranges are managed with AddIPRangeRequest and DeleteIPRangeRequest. The response is the updated
+// list.
+type UpdateAccessControlListRequest struct {
+	AccessControlList *AccessControlList
+}
+
+func (uaclr *UpdateAccessControlListRequest) WithAccessControlList(acl *AccessControlList) *UpdateAccessControlListRequest {
+	uaclr.AccessControlList = acl
+	return uaclr
+}
+
+func (uaclr *UpdateAccessControlListRequest) Validate() error {
+	if uaclr.AccessControlList == nil {
+		return AccessControlListRequiredError
+	}
+	if uaclr.AccessControlList.ID == "" {
+		return AccessControlListIDRequiredError
+	}
+	if uaclr.AccessControlList.Name == "" {
+		return NameRequiredError
+	}
+	return nil
+}
+
+func (uaclr *UpdateAccessControlListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (uaclr *UpdateAccessControlListRequest) Method() string {
+	return http.MethodPut
+}
+
+func (uaclr *UpdateAccessControlListRequest) Path() string {
+	return accessControlListPath(uaclr.AccessControlList.ID)
+}
+
+func (uaclr *UpdateAccessControlListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (uaclr *UpdateAccessControlListRequest) Body() ([]byte, error) {
+	return json.Marshal(struct {
+		Name    string `json:"name"`
+		Enabled bool   `json:"enabled"`
+	}{uaclr.AccessControlList.Name, uaclr.AccessControlList.Enabled})
+}
+
+// DeleteAccessControlListRequest deletes an access control list. It must not be assigned to any trunk. The response
+// has no content, use sinch.NoContent.
+type DeleteAccessControlListRequest struct {
+	AccessControlListID string
+}
+
+func (daclr *DeleteAccessControlListRequest) WithAccessControlListID(aclID string) *DeleteAccessControlListRequest {
+	daclr.AccessControlListID = aclID
+	return daclr
+}
+
+func (daclr *DeleteAccessControlListRequest) Validate() error {
+	if daclr.AccessControlListID == "" {
+		return AccessControlListIDRequiredError
+	}
+	return nil
+}
+
+func (daclr *DeleteAccessControlListRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (daclr *DeleteAccessControlListRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (daclr *DeleteAccessControlListRequest) Path() string {
+	return accessControlListPath(daclr.AccessControlListID)
+}
+
+func (daclr *DeleteAccessControlListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (daclr *DeleteAccessControlListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type AddIPRangeAction struct {
+	request  *AddIPRangeRequest
+	response *IPRange
+}
+
+func (aira *AddIPRangeAction) Request() *AddIPRangeRequest {
+	return aira.request
+}
+
+func (aira *AddIPRangeAction) Response() *IPRange {
+	return aira.response
+}
+
+// AddIPRangeRequest adds an IP range to an access control list. The response is the created IP range.
+type AddIPRangeRequest struct {
+	AccessControlListID string
+	IPRange             *IPRange
+}
+
+func (airr *AddIPRangeRequest) WithAccessControlListID(aclID string) *AddIPRangeRequest {
+	airr.AccessControlListID = aclID
+	return airr
+}
+
+// WithIPRange sets the IP range in CIDR notation, see ParseIPRange.
+func (airr *AddIPRangeRequest) WithIPRange(cidr, description string) *AddIPRangeRequest {
+	ipRange := ParseIPRange(cidr, description)
+	airr.IPRange = &ipRange
+	return airr
+}
+
+func (airr *AddIPRangeRequest) Validate() error {
+	if airr.AccessControlListID == "" {
+		return AccessControlListIDRequiredError
+	}
+	if airr.IPRange == nil {
+		return IPRangeRequiredError
+	}
+	return airr.IPRange.validate()
+}
+
+func (airr *AddIPRangeRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (airr *AddIPRangeRequest) Method() string {
+	return http.MethodPost
+}
+
+func (airr *AddIPRangeRequest) Path() string {
+	return accessControlListPath(airr.AccessControlListID) + "/ipRanges"
+}
+
+func (airr *AddIPRangeRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (airr *AddIPRangeRequest) Body() ([]byte, error) {
+	return json.Marshal(airr.IPRange)
+}
+
+// DeleteIPRangeRequest removes an IP range from an access control list. The response has no content, use
+// sinch.NoContent.
+type DeleteIPRangeRequest struct {
+	AccessControlListID string
+	IPRangeID           string
+}
+
+func (dirr *DeleteIPRangeRequest) WithIPRange(aclID, ipRangeID string) *DeleteIPRangeRequest {
+	dirr.AccessControlListID = aclID
+	dirr.IPRangeID = ipRangeID
+	return dirr
+}
+
+func (dirr *DeleteIPRangeRequest) Validate() error {
+	if dirr.AccessControlListID == "" {
+		return AccessControlListIDRequiredError
+	}
+	if dirr.IPRangeID == "" {
+		return IPRangeIDRequiredError
+	}
+	return nil
+}
+
+func (dirr *DeleteIPRangeRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dirr *DeleteIPRangeRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dirr *DeleteIPRangeRequest) Path() string {
+	return accessControlListPath(dirr.AccessControlListID) + "/ipRanges/" + url.PathEscape(dirr.IPRangeID)
+}
+
+func (dirr *DeleteIPRangeRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dirr *DeleteIPRangeRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+// AssignAccessControlListsRequest allows calls from the IP ranges of access control lists to a SIP trunk. The response
+// has no content, use sinch.NoContent.
+type AssignAccessControlListsRequest struct {
+	TrunkID              string   `json:"-"`
+	AccessControlListIDs []string `json:"accessControlListIds"`
+}
+
+func (aaclr *AssignAccessControlListsRequest) WithTrunkID(trunkID string) *AssignAccessControlListsRequest {
+	aaclr.TrunkID = trunkID
+	return aaclr
+}
+
+func (aaclr *AssignAccessControlListsRequest) WithAccessControlListIDs(aclIDs ...string) *AssignAccessControlListsRequest {
+	aaclr.AccessControlListIDs = append(aaclr.AccessControlListIDs, aclIDs...)
+	return aaclr
+}
+
+func (aaclr *AssignAccessControlListsRequest) Validate() error {
+	if aaclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if len(aaclr.AccessControlListIDs) == 0 {
+		return AccessControlListIDRequiredError
+	}
+	return nil
+}
+
+func (aaclr *AssignAccessControlListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (aaclr *AssignAccessControlListsRequest) Method() string {
+	return http.MethodPost
+}
+
+func (aaclr *AssignAccessControlListsRequest) Path() string {
+	return "/trunks/" + url.PathEscape(aaclr.TrunkID) + "/accessControlLists"
+}
+
+func (aaclr *AssignAccessControlListsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (aaclr *AssignAccessControlListsRequest) Body() ([]byte, error) {
+	return json.Marshal(aaclr)
+}
+
+type ListTrunkAccessControlListsAction struct {
+	request  *ListTrunkAccessControlListsRequest
+	response *ListTrunkAccessControlListsResponse
+}
+
+func (ltacla *ListTrunkAccessControlListsAction) Request() *ListTrunkAccessControlListsRequest {
+	return ltacla.request
+}
+
+func (ltacla *ListTrunkAccessControlListsAction) Response() *ListTrunkAccessControlListsResponse {
+	return ltacla.response
+}
+
+// ListTrunkAccessControlListsRequest lists the IDs of the access control lists assigned to a SIP trunk.
+type ListTrunkAccessControlListsRequest struct {
+	TrunkID string
+}
+
+type ListTrunkAccessControlListsResponse struct {
+	AccessControlListIDs []string `json:"accessControlListIds"`
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) WithTrunkID(trunkID string) *ListTrunkAccessControlListsRequest {
+	ltaclr.TrunkID = trunkID
+	return ltaclr
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) Validate() error {
+	if ltaclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	return nil
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) Path() string {
+	return "/trunks/" + url.PathEscape(ltaclr.TrunkID) + "/accessControlLists"
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ltaclr *ListTrunkAccessControlListsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ltaclr *ListTrunkAccessControlListsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, ltaclr)
+}
+
+// UnassignAccessControlListRequest removes an access control list from a SIP trunk. The response has no content, use
+// sinch.NoContent.
+type UnassignAccessControlListRequest struct {
+	TrunkID             string
+	AccessControlListID string
+}
+
+func (uaclr *UnassignAccessControlListRequest) WithAccessControlList(trunkID, aclID string) *UnassignAccessControlListRequest {
+	uaclr.TrunkID = trunkID
+	uaclr.AccessControlListID = aclID
+	return uaclr
+}
+
+func (uaclr *UnassignAccessControlListRequest) Validate() error {
+	if uaclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if uaclr.AccessControlListID == "" {
+		return AccessControlListIDRequiredError
+	}
+	return nil
+}
+
+func (uaclr *UnassignAccessControlListRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (uaclr *UnassignAccessControlListRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (uaclr *UnassignAccessControlListRequest) Path() string {
+	return "/trunks/" + url.PathEscape(uaclr.TrunkID) + "/accessControlLists/" + url.PathEscape(uaclr.AccessControlListID)
+}
+
+func (uaclr *UnassignAccessControlListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (uaclr *UnassignAccessControlListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
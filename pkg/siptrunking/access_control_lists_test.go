@@ -0,0 +1,136 @@
+package siptrunking
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_AccessControlLists_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateAccessControlListRequest, *AccessControlList] = new(CreateAccessControlListAction)
+	var _ sinch.Action[*GetAccessControlListRequest, *AccessControlList] = new(GetAccessControlListAction)
+	var _ sinch.Action[*ListAccessControlListsRequest, *ListAccessControlListsResponse] = new(ListAccessControlListsAction)
+	var _ sinch.ListRequest = new(ListAccessControlListsRequest)
+	var _ sinch.ListResponse[AccessControlList] = new(ListAccessControlListsResponse)
+	var _ sinch.Action[*UpdateAccessControlListRequest, *AccessControlList] = new(UpdateAccessControlListAction)
+	var _ sinch.APIRequest = new(DeleteAccessControlListRequest)
+	var _ sinch.Action[*AddIPRangeRequest, *IPRange] = new(AddIPRangeAction)
+	var _ sinch.APIRequest = new(DeleteIPRangeRequest)
+	var _ sinch.APIRequest = new(AssignAccessControlListsRequest)
+	var _ sinch.Action[*ListTrunkAccessControlListsRequest, *ListTrunkAccessControlListsResponse] = new(ListTrunkAccessControlListsAction)
+	var _ sinch.APIRequest = new(UnassignAccessControlListRequest)
+}
+
+func Test_ParseIPRange(t *testing.T) {
+	tests := map[string]struct {
+		cidr string
+		want IPRange
+	}{
+		"network": {cidr: "203.0.113.0/24", want: IPRange{IPAddress: "203.0.113.0", Range: 24}},
+		"address": {cidr: "203.0.113.10", want: IPRange{IPAddress: "203.0.113.10", Range: 32}},
+		"invalid": {cidr: "pbx.example.com", want: IPRange{IPAddress: "pbx.example.com", Range: 32}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ParseIPRange(tt.cidr, ""))
+		})
+	}
+}
+
+func Test_AccessControlListRequests(t *testing.T) {
+	runRequestTests(t, map[string]requestTest{
+		"create without list": {
+			req:     new(CreateAccessControlListRequest),
+			wantErr: AccessControlListRequiredError,
+		},
+		"create without name": {
+			req:     new(CreateAccessControlListRequest).WithAccessControlList(NewAccessControlList("")),
+			wantErr: NameRequiredError,
+		},
+		"create with host name": {
+			req:     new(CreateAccessControlListRequest).WithAccessControlList(NewAccessControlList("office").WithIPRange("pbx.example.com", "")),
+			wantErr: InvalidIPRangeError,
+		},
+		"create with IPv6 range": {
+			req:     new(CreateAccessControlListRequest).WithAccessControlList(NewAccessControlList("office").WithIPRange("2001:db8::/32", "")),
+			wantErr: InvalidIPRangeError,
+		},
+		"create": {
+			req:        new(CreateAccessControlListRequest).WithAccessControlList(NewAccessControlList("office").WithIPRange("203.0.113.0/24", "office network")),
+			wantMethod: http.MethodPost,
+			wantPath:   "/accessControlLists",
+			wantBody:   `{"name":"office","enabled":true,"ipRanges":[{"description":"office network","ipAddress":"203.0.113.0","range":24}]}`,
+		},
+		"get": {
+			req:        new(GetAccessControlListRequest).WithAccessControlListID("acl"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/accessControlLists/acl",
+		},
+		"list": {
+			req:        new(ListAccessControlListsRequest).WithPageSize(20),
+			wantMethod: http.MethodGet,
+			wantPath:   "/accessControlLists",
+			wantQuery:  "?pageSize=20",
+		},
+		"update": {
+			req:        new(UpdateAccessControlListRequest).WithAccessControlList(NewAccessControlList("office").WithID("acl").WithIPRange("203.0.113.0/24", "")),
+			wantMethod: http.MethodPut,
+			wantPath:   "/accessControlLists/acl",
+			wantBody:   `{"name":"office","enabled":true}`,
+		},
+		"delete without id": {
+			req:     new(DeleteAccessControlListRequest),
+			wantErr: AccessControlListIDRequiredError,
+		},
+		"delete": {
+			req:        new(DeleteAccessControlListRequest).WithAccessControlListID("acl"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/accessControlLists/acl",
+			wantStatus: http.StatusNoContent,
+		},
+		"add ip range without range": {
+			req:     new(AddIPRangeRequest).WithAccessControlListID("acl"),
+			wantErr: IPRangeRequiredError,
+		},
+		"add ip range": {
+			req:        new(AddIPRangeRequest).WithAccessControlListID("acl").WithIPRange("198.51.100.7", "backup PBX"),
+			wantMethod: http.MethodPost,
+			wantPath:   "/accessControlLists/acl/ipRanges",
+			wantBody:   `{"description":"backup PBX","ipAddress":"198.51.100.7","range":32}`,
+		},
+		"delete ip range without id": {
+			req:     new(DeleteIPRangeRequest).WithIPRange("acl", ""),
+			wantErr: IPRangeIDRequiredError,
+		},
+		"delete ip range": {
+			req:        new(DeleteIPRangeRequest).WithIPRange("acl", "range"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/accessControlLists/acl/ipRanges/range",
+			wantStatus: http.StatusNoContent,
+		},
+		"assign without lists": {
+			req:     new(AssignAccessControlListsRequest).WithTrunkID("trunk"),
+			wantErr: AccessControlListIDRequiredError,
+		},
+		"assign": {
+			req:        new(AssignAccessControlListsRequest).WithTrunkID("trunk").WithAccessControlListIDs("acl", "acl2"),
+			wantMethod: http.MethodPost,
+			wantPath:   "/trunks/trunk/accessControlLists",
+			wantBody:   `{"accessControlListIds":["acl","acl2"]}`,
+		},
+		"list assigned": {
+			req:        new(ListTrunkAccessControlListsRequest).WithTrunkID("trunk"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks/trunk/accessControlLists",
+		},
+		"unassign": {
+			req:        new(UnassignAccessControlListRequest).WithAccessControlList("trunk", "acl"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/trunks/trunk/accessControlLists/acl",
+			wantStatus: http.StatusNoContent,
+		},
+	})
+}
@@ -0,0 +1,43 @@
+// Package siptrunking is a client for the Sinch Elastic SIP Trunking API, which manages SIP trunks, the endpoints calls
+// are routed to and the access control and credential lists that authorize calls from the customer's PBX. Numbers are
+// routed to a trunk with numbers.ActivationRequest.WithSIPTrunk.
+package siptrunking
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Client struct {
+	api.ProjectClient
+}
+
+const BaseURLv1 = "https://elastic-trunking.api.sinch.com/v1/projects"
+
+// ListTrunks returns a pager over the SIP trunks of the project.
+func (c *Client) ListTrunks(req *ListTrunksRequest) *api.Pager[Trunk] {
+	return api.NewPager[Trunk](c, req, func() sinch.ListResponse[Trunk] {
+		return new(ListTrunksResponse)
+	})
+}
+
+// ListEndpoints returns a pager over the endpoints of a SIP trunk.
+func (c *Client) ListEndpoints(req *ListEndpointsRequest) *api.Pager[Endpoint] {
+	return api.NewPager[Endpoint](c, req, func() sinch.ListResponse[Endpoint] {
+		return new(ListEndpointsResponse)
+	})
+}
+
+// ListAccessControlLists returns a pager over the access control lists of the project.
+func (c *Client) ListAccessControlLists(req *ListAccessControlListsRequest) *api.Pager[AccessControlList] {
+	return api.NewPager[AccessControlList](c, req, func() sinch.ListResponse[AccessControlList] {
+		return new(ListAccessControlListsResponse)
+	})
+}
+
+// ListCredentialLists returns a pager over the credential lists of the project.
+func (c *Client) ListCredentialLists(req *ListCredentialListsRequest) *api.Pager[CredentialList] {
+	return api.NewPager[CredentialList](c, req, func() sinch.ListResponse[CredentialList] {
+		return new(ListCredentialListsResponse)
+	})
+}
@@ -0,0 +1,77 @@
+package siptrunking
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing project": {opts: []Option{WithKey("key", "secret")}, wantErr: ProjectIDRequiredError},
+		"missing key id":  {opts: []Option{WithProjectID("project"), WithKey("", "secret")}, wantErr: KeyIDRequiredError},
+		"missing secret":  {opts: []Option{WithProjectID("project"), WithKey("key", "")}, wantErr: KeySecretRequiredError},
+		"basic auth":      {opts: []Option{WithProjectID("project"), WithKey("key", "secret")}},
+		"authenticator":   {opts: []Option{WithProjectID("project"), WithAuthenticator(auth.NewBearerToken("token"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"))
+	assert.NoError(t, err)
+	assert.Equal(t, BaseURLv1+"/project", c.URL())
+	assert.Same(t, api.DefaultHTTPClient, c.API().HTTPClient)
+
+	shared := &api.Client{BaseURL: BaseURLv1, HTTPClient: http.DefaultClient}
+	c, err = New(WithProjectID("project"), WithKey("key", "secret"), WithSinchAPI(shared), WithBaseURL("http://localhost"))
+	assert.NoError(t, err)
+	assert.Equal(t, "http://localhost/project", c.URL())
+	assert.Equal(t, BaseURLv1, shared.BaseURL)
+
+	_, err = New(WithKey("key", "secret"))
+	assert.ErrorIs(t, err, ProjectIDRequiredError)
+}
+
+func Test_Client_ListTrunks(t *testing.T) {
+	pages := map[string]string{
+		"":  `{"trunks": [{"id": "1"}, {"id": "2"}], "page": 1, "pageSize": 2, "totalItems": 3}`,
+		"2": `{"trunks": [{"id": "3"}], "page": 2, "pageSize": 2, "totalItems": 3}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/project/trunks", r.URL.Path)
+		io.WriteString(w, pages[r.URL.Query().Get("page")])
+	}))
+	defer srv.Close()
+
+	c, err := New(WithProjectID("project"), WithKey("key", "secret"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+
+	trunks, err := c.ListTrunks(new(ListTrunksRequest).WithPageSize(2)).All(context.Background())
+	assert.NoError(t, err)
+	var ids []string
+	for _, trunk := range trunks {
+		ids = append(ids, trunk.ID)
+	}
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+}
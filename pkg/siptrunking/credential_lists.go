@@ -0,0 +1,475 @@
+package siptrunking
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// CredentialList is a list of SIP digest credentials that the customer's PBX may authenticate calls to the SIP trunks it
+// is assigned to with.
+type CredentialList struct {
+	ID          string       `json:"id,omitempty"`
+	Name        string       `json:"name"`
+	Credentials []Credential `json:"credentials,omitempty"`
+	ProjectID   string       `json:"projectId,omitempty"`
+	CreateTime  *sinch.Time  `json:"createTime,omitempty"`
+	UpdateTime  *sinch.Time  `json:"updateTime,omitempty"`
+}
+
+// Credential is a SIP username and password. The API never returns passwords.
+type Credential struct {
+	Username string `json:"username"`
+	Password string `json:"password,omitempty"`
+}
+
+func (cl *CredentialList) WithID(id string) *CredentialList {
+	cl.ID = id
+	return cl
+}
+
+func (cl *CredentialList) WithName(name string) *CredentialList {
+	cl.Name = name
+	return cl
+}
+
+func (cl *CredentialList) WithCredential(username, password string) *CredentialList {
+	cl.Credentials = append(cl.Credentials, Credential{Username: username, Password: password})
+	return cl
+}
+
+func (cl *CredentialList) validate() error {
+	if cl.Name == "" {
+		return NameRequiredError
+	}
+	for _, credential := range cl.Credentials {
+		if credential.Username == "" || credential.Password == "" {
+			return InvalidCredentialError
+		}
+	}
+	return nil
+}
+
+func (cl *CredentialList) FromJSON(data []byte) error {
+	return json.Unmarshal(data, cl)
+}
+
+func credentialListPath(credentialListID string) string {
+	return "/credentialLists/" + url.PathEscape(credentialListID)
+}
+
+type CreateCredentialListAction struct {
+	request  *CreateCredentialListRequest
+	response *CredentialList
+}
+
+func (ccla *CreateCredentialListAction) Request() *CreateCredentialListRequest {
+	return ccla.request
+}
+
+func (ccla *CreateCredentialListAction) Response() *CredentialList {
+	return ccla.response
+}
+
+// CreateCredentialListRequest creates a credential list. The response is the created list.
+type CreateCredentialListRequest struct {
+	CredentialList *CredentialList
+}
+
+func (cclr *CreateCredentialListRequest) WithCredentialList(credentialList *CredentialList) *CreateCredentialListRequest {
+	cclr.CredentialList = credentialList
+	return cclr
+}
+
+func (cclr *CreateCredentialListRequest) Validate() error {
+	if cclr.CredentialList == nil {
+		return CredentialListRequiredError
+	}
+	return cclr.CredentialList.validate()
+}
+
+func (cclr *CreateCredentialListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (cclr *CreateCredentialListRequest) Method() string {
+	return http.MethodPost
+}
+
+func (cclr *CreateCredentialListRequest) Path() string {
+	return "/credentialLists"
+}
+
+func (cclr *CreateCredentialListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (cclr *CreateCredentialListRequest) Body() ([]byte, error) {
+	return json.Marshal(cclr.CredentialList)
+}
+
+type GetCredentialListAction struct {
+	request  *GetCredentialListRequest
+	response *CredentialList
+}
+
+func (gcla *GetCredentialListAction) Request() *GetCredentialListRequest {
+	return gcla.request
+}
+
+func (gcla *GetCredentialListAction) Response() *CredentialList {
+	return gcla.response
+}
+
+// GetCredentialListRequest fetches a credential list with the usernames of its credentials.
+type GetCredentialListRequest struct {
+	CredentialListID string
+}
+
+func (gclr *GetCredentialListRequest) WithCredentialListID(credentialListID string) *GetCredentialListRequest {
+	gclr.CredentialListID = credentialListID
+	return gclr
+}
+
+func (gclr *GetCredentialListRequest) Validate() error {
+	if gclr.CredentialListID == "" {
+		return CredentialListIDRequiredError
+	}
+	return nil
+}
+
+func (gclr *GetCredentialListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gclr *GetCredentialListRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gclr *GetCredentialListRequest) Path() string {
+	return credentialListPath(gclr.CredentialListID)
+}
+
+func (gclr *GetCredentialListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gclr *GetCredentialListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListCredentialListsAction struct {
+	request  *ListCredentialListsRequest
+	response *ListCredentialListsResponse
+}
+
+func (lcla *ListCredentialListsAction) Request() *ListCredentialListsRequest {
+	return lcla.request
+}
+
+func (lcla *ListCredentialListsAction) Response() *ListCredentialListsResponse {
+	return lcla.response
+}
+
+// ListCredentialListsRequest lists the credential lists of the project.
+type ListCredentialListsRequest struct {
+	PageSize int `url:"pageSize,omitempty"`
+	Page     int `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListCredentialListsResponse struct {
+	CredentialLists []CredentialList `json:"credentialLists"`
+	Page            int              `json:"page"`
+	PageSize        int              `json:"pageSize"`
+	TotalItems      int              `json:"totalItems"`
+}
+
+func (lclr *ListCredentialListsRequest) WithPageSize(pageSize int) *ListCredentialListsRequest {
+	lclr.PageSize = pageSize
+	return lclr
+}
+
+// SetPageToken sets the page number from a token returned by ListCredentialListsResponse.NextPageToken.
+func (lclr *ListCredentialListsRequest) SetPageToken(token string) {
+	lclr.Page, _ = strconv.Atoi(token)
+}
+
+func (lclr *ListCredentialListsRequest) Validate() error {
+	return validPageSize(lclr.PageSize)
+}
+
+func (lclr *ListCredentialListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lclr *ListCredentialListsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lclr *ListCredentialListsRequest) Path() string {
+	return "/credentialLists"
+}
+
+func (lclr *ListCredentialListsRequest) QueryString() (string, error) {
+	return queryString(lclr)
+}
+
+func (lclr *ListCredentialListsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lclr *ListCredentialListsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lclr)
+}
+
+func (lclr *ListCredentialListsResponse) Items() []CredentialList {
+	return lclr.CredentialLists
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (lclr *ListCredentialListsResponse) NextPageToken() string {
+	return nextPage(lclr.Page, lclr.PageSize, lclr.TotalItems)
+}
+
+type UpdateCredentialListAction struct {
+	request  *UpdateCredentialListRequest
+	response *CredentialList
+}
+
+func (ucla *UpdateCredentialListAction) Request() *UpdateCredentialListRequest {
+	return ucla.request
+}
+
+func (ucla *UpdateCredentialListAction) Response() *CredentialList {
+	return ucla.response
+}
+
+// UpdateCredentialListRequest replaces the name and the credentials of the credential list with the ID of
+// CredentialList. The response is the updated list.
+type UpdateCredentialListRequest struct {
+	CredentialList *CredentialList
+}
+
+func (uclr *UpdateCredentialListRequest) WithCredentialList(credentialList *CredentialList) *UpdateCredentialListRequest {
+	uclr.CredentialList = credentialList
+	return uclr
+}
+
+func (uclr *UpdateCredentialListRequest) Validate() error {
+	if uclr.CredentialList == nil {
+		return CredentialListRequiredError
+	}
+	if uclr.CredentialList.ID == "" {
+		return CredentialListIDRequiredError
+	}
+	return uclr.CredentialList.validate()
+}
+
+func (uclr *UpdateCredentialListRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (uclr *UpdateCredentialListRequest) Method() string {
+	return http.MethodPut
+}
+
+func (uclr *UpdateCredentialListRequest) Path() string {
+	return credentialListPath(uclr.CredentialList.ID)
+}
+
+func (uclr *UpdateCredentialListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (uclr *UpdateCredentialListRequest) Body() ([]byte, error) {
+	return json.Marshal(uclr.CredentialList)
+}
+
+// DeleteCredentialListRequest deletes a credential list. It must not be assigned to any trunk. The response has no
+// content, use sinch.NoContent.
+type DeleteCredentialListRequest struct {
+	CredentialListID string
+}
+
+func (dclr *DeleteCredentialListRequest) WithCredentialListID(credentialListID string) *DeleteCredentialListRequest {
+	dclr.CredentialListID = credentialListID
+	return dclr
+}
+
+func (dclr *DeleteCredentialListRequest) Validate() error {
+	if dclr.CredentialListID == "" {
+		return CredentialListIDRequiredError
+	}
+	return nil
+}
+
+func (dclr *DeleteCredentialListRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dclr *DeleteCredentialListRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dclr *DeleteCredentialListRequest) Path() string {
+	return credentialListPath(dclr.CredentialListID)
+}
+
+func (dclr *DeleteCredentialListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dclr *DeleteCredentialListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+// AssignCredentialListsRequest allows calls authenticated with the credentials of credential lists to a SIP trunk. The
+// response has no content, use sinch.NoContent.
+type AssignCredentialListsRequest struct {
+	TrunkID           string   `json:"-"`
+	CredentialListIDs []string `json:"credentialListIds"`
+}
+
+func (aclr *AssignCredentialListsRequest) WithTrunkID(trunkID string) *AssignCredentialListsRequest {
+	aclr.TrunkID = trunkID
+	return aclr
+}
+
+func (aclr *AssignCredentialListsRequest) WithCredentialListIDs(credentialListIDs ...string) *AssignCredentialListsRequest {
+	aclr.CredentialListIDs = append(aclr.CredentialListIDs, credentialListIDs...)
+	return aclr
+}
+
+func (aclr *AssignCredentialListsRequest) Validate() error {
+	if aclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if len(aclr.CredentialListIDs) == 0 {
+		return CredentialListIDRequiredError
+	}
+	return nil
+}
+
+func (aclr *AssignCredentialListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (aclr *AssignCredentialListsRequest) Method() string {
+	return http.MethodPost
+}
+
+func (aclr *AssignCredentialListsRequest) Path() string {
+	return "/trunks/" + url.PathEscape(aclr.TrunkID) + "/credentialLists"
+}
+
+func (aclr *AssignCredentialListsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (aclr *AssignCredentialListsRequest) Body() ([]byte, error) {
+	return json.Marshal(aclr)
+}
+
+type ListTrunkCredentialListsAction struct {
+	request  *ListTrunkCredentialListsRequest
+	response *ListTrunkCredentialListsResponse
+}
+
+func (ltcla *ListTrunkCredentialListsAction) Request() *ListTrunkCredentialListsRequest {
+	return ltcla.request
+}
+
+func (ltcla *ListTrunkCredentialListsAction) Response() *ListTrunkCredentialListsResponse {
+	return ltcla.response
+}
+
+// ListTrunkCredentialListsRequest lists the IDs of the credential lists assigned to a SIP trunk.
+type ListTrunkCredentialListsRequest struct {
+	TrunkID string
+}
+
+type ListTrunkCredentialListsResponse struct {
+	CredentialListIDs []string `json:"credentialListIds"`
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) WithTrunkID(trunkID string) *ListTrunkCredentialListsRequest {
+	ltclr.TrunkID = trunkID
+	return ltclr
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) Validate() error {
+	if ltclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	return nil
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) Path() string {
+	return "/trunks/" + url.PathEscape(ltclr.TrunkID) + "/credentialLists"
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ltclr *ListTrunkCredentialListsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ltclr *ListTrunkCredentialListsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, ltclr)
+}
+
+// UnassignCredentialListRequest removes a credential list from a SIP trunk. The response has no content, use
+// sinch.NoContent.
+type UnassignCredentialListRequest struct {
+	TrunkID          string
+	CredentialListID string
+}
+
+func (uclr *UnassignCredentialListRequest) WithCredentialList(trunkID, credentialListID string) *UnassignCredentialListRequest {
+	uclr.TrunkID = trunkID
+	uclr.CredentialListID = credentialListID
+	return uclr
+}
+
+func (uclr *UnassignCredentialListRequest) Validate() error {
+	if uclr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if uclr.CredentialListID == "" {
+		return CredentialListIDRequiredError
+	}
+	return nil
+}
+
+func (uclr *UnassignCredentialListRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (uclr *UnassignCredentialListRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (uclr *UnassignCredentialListRequest) Path() string {
+	return "/trunks/" + url.PathEscape(uclr.TrunkID) + "/credentialLists/" + url.PathEscape(uclr.CredentialListID)
+}
+
+func (uclr *UnassignCredentialListRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (uclr *UnassignCredentialListRequest) Body() ([]byte, error) {
+	return nil, nil
+}
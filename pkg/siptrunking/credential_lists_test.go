@@ -0,0 +1,96 @@
+package siptrunking
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_CredentialLists_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateCredentialListRequest, *CredentialList] = new(CreateCredentialListAction)
+	var _ sinch.Action[*GetCredentialListRequest, *CredentialList] = new(GetCredentialListAction)
+	var _ sinch.Action[*ListCredentialListsRequest, *ListCredentialListsResponse] = new(ListCredentialListsAction)
+	var _ sinch.ListRequest = new(ListCredentialListsRequest)
+	var _ sinch.ListResponse[CredentialList] = new(ListCredentialListsResponse)
+	var _ sinch.Action[*UpdateCredentialListRequest, *CredentialList] = new(UpdateCredentialListAction)
+	var _ sinch.APIRequest = new(DeleteCredentialListRequest)
+	var _ sinch.APIRequest = new(AssignCredentialListsRequest)
+	var _ sinch.Action[*ListTrunkCredentialListsRequest, *ListTrunkCredentialListsResponse] = new(ListTrunkCredentialListsAction)
+	var _ sinch.APIRequest = new(UnassignCredentialListRequest)
+}
+
+func Test_CredentialListRequests(t *testing.T) {
+	runRequestTests(t, map[string]requestTest{
+		"create without list": {
+			req:     new(CreateCredentialListRequest),
+			wantErr: CredentialListRequiredError,
+		},
+		"create without password": {
+			req:     new(CreateCredentialListRequest).WithCredentialList(new(CredentialList).WithName("pbx").WithCredential("pbx", "")),
+			wantErr: InvalidCredentialError,
+		},
+		"create": {
+			req:        new(CreateCredentialListRequest).WithCredentialList(new(CredentialList).WithName("pbx").WithCredential("pbx", "s3cret")),
+			wantMethod: http.MethodPost,
+			wantPath:   "/credentialLists",
+			wantBody:   `{"name":"pbx","credentials":[{"username":"pbx","password":"s3cret"}]}`,
+		},
+		"get without id": {
+			req:     new(GetCredentialListRequest),
+			wantErr: CredentialListIDRequiredError,
+		},
+		"get": {
+			req:        new(GetCredentialListRequest).WithCredentialListID("list"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/credentialLists/list",
+		},
+		"list": {
+			req:        new(ListCredentialListsRequest).WithPageSize(20),
+			wantMethod: http.MethodGet,
+			wantPath:   "/credentialLists",
+			wantQuery:  "?pageSize=20",
+		},
+		"update without name": {
+			req:     new(UpdateCredentialListRequest).WithCredentialList(new(CredentialList).WithID("list")),
+			wantErr: NameRequiredError,
+		},
+		"update": {
+			req:        new(UpdateCredentialListRequest).WithCredentialList(new(CredentialList).WithID("list").WithName("pbx").WithCredential("pbx", "n3w")),
+			wantMethod: http.MethodPut,
+			wantPath:   "/credentialLists/list",
+			wantBody:   `{"id":"list","name":"pbx","credentials":[{"username":"pbx","password":"n3w"}]}`,
+		},
+		"delete": {
+			req:        new(DeleteCredentialListRequest).WithCredentialListID("list"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/credentialLists/list",
+			wantStatus: http.StatusNoContent,
+		},
+		"assign without trunk": {
+			req:     new(AssignCredentialListsRequest).WithCredentialListIDs("list"),
+			wantErr: TrunkIDRequiredError,
+		},
+		"assign": {
+			req:        new(AssignCredentialListsRequest).WithTrunkID("trunk").WithCredentialListIDs("list"),
+			wantMethod: http.MethodPost,
+			wantPath:   "/trunks/trunk/credentialLists",
+			wantBody:   `{"credentialListIds":["list"]}`,
+		},
+		"list assigned": {
+			req:        new(ListTrunkCredentialListsRequest).WithTrunkID("trunk"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks/trunk/credentialLists",
+		},
+		"unassign without list": {
+			req:     new(UnassignCredentialListRequest).WithCredentialList("trunk", ""),
+			wantErr: CredentialListIDRequiredError,
+		},
+		"unassign": {
+			req:        new(UnassignCredentialListRequest).WithCredentialList("trunk", "list"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/trunks/trunk/credentialLists/list",
+			wantStatus: http.StatusNoContent,
+		},
+	})
+}
@@ -0,0 +1,364 @@
+package siptrunking
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Endpoint is a SIP server of the customer that calls to a trunk's numbers are routed to. Endpoints with a lower
+// priority are tried first.
+type Endpoint struct {
+	ID         string      `json:"id,omitempty"`
+	TrunkID    string      `json:"sipTrunkId,omitempty"` // Set by the API.
+	Name       string      `json:"name"`
+	Address    string      `json:"address"` // An IPv4 address or a domain name.
+	Port       int         `json:"port,omitempty"`
+	Priority   int         `json:"priority"`
+	Enabled    bool        `json:"enabled"`
+	CreateTime *sinch.Time `json:"createTime,omitempty"`
+	UpdateTime *sinch.Time `json:"updateTime,omitempty"`
+}
+
+// NewEndpoint returns an enabled endpoint on the default SIP port.
+func NewEndpoint(name, address string, priority int) *Endpoint {
+	return &Endpoint{Name: name, Address: address, Port: 5060, Priority: priority, Enabled: true}
+}
+
+func (e *Endpoint) WithID(id string) *Endpoint {
+	e.ID = id
+	return e
+}
+
+func (e *Endpoint) WithPort(port int) *Endpoint {
+	e.Port = port
+	return e
+}
+
+// Disable keeps the endpoint but stops routing calls to it.
+func (e *Endpoint) Disable() *Endpoint {
+	e.Enabled = false
+	return e
+}
+
+func (e *Endpoint) validate() error {
+	if e.Name == "" || e.Address == "" {
+		return EndpointAddressRequiredError
+	}
+	if e.Port < 0 || e.Port > 65535 {
+		return InvalidPortError
+	}
+	return nil
+}
+
+func (e *Endpoint) FromJSON(data []byte) error {
+	return json.Unmarshal(data, e)
+}
+
+func endpointsPath(trunkID string) string {
+	return "/trunks/" + url.PathEscape(trunkID) + "/endpoints"
+}
+
+type CreateEndpointAction struct {
+	request  *CreateEndpointRequest
+	response *Endpoint
+}
+
+func (cea *CreateEndpointAction) Request() *CreateEndpointRequest {
+	return cea.request
+}
+
+func (cea *CreateEndpointAction) Response() *Endpoint {
+	return cea.response
+}
+
+// CreateEndpointRequest adds an endpoint to a SIP trunk. The response is the created endpoint.
+type CreateEndpointRequest struct {
+	TrunkID  string
+	Endpoint *Endpoint
+}
+
+func (cer *CreateEndpointRequest) WithTrunkID(trunkID string) *CreateEndpointRequest {
+	cer.TrunkID = trunkID
+	return cer
+}
+
+func (cer *CreateEndpointRequest) WithEndpoint(endpoint *Endpoint) *CreateEndpointRequest {
+	cer.Endpoint = endpoint
+	return cer
+}
+
+func (cer *CreateEndpointRequest) Validate() error {
+	if cer.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if cer.Endpoint == nil {
+		return EndpointRequiredError
+	}
+	return cer.Endpoint.validate()
+}
+
+func (cer *CreateEndpointRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (cer *CreateEndpointRequest) Method() string {
+	return http.MethodPost
+}
+
+func (cer *CreateEndpointRequest) Path() string {
+	return endpointsPath(cer.TrunkID)
+}
+
+func (cer *CreateEndpointRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (cer *CreateEndpointRequest) Body() ([]byte, error) {
+	return json.Marshal(cer.Endpoint)
+}
+
+type GetEndpointAction struct {
+	request  *GetEndpointRequest
+	response *Endpoint
+}
+
+func (gea *GetEndpointAction) Request() *GetEndpointRequest {
+	return gea.request
+}
+
+func (gea *GetEndpointAction) Response() *Endpoint {
+	return gea.response
+}
+
+// GetEndpointRequest fetches an endpoint of a SIP trunk.
+type GetEndpointRequest struct {
+	TrunkID    string
+	EndpointID string
+}
+
+func (ger *GetEndpointRequest) WithEndpoint(trunkID, endpointID string) *GetEndpointRequest {
+	ger.TrunkID = trunkID
+	ger.EndpointID = endpointID
+	return ger
+}
+
+func (ger *GetEndpointRequest) Validate() error {
+	if ger.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if ger.EndpointID == "" {
+		return EndpointIDRequiredError
+	}
+	return nil
+}
+
+func (ger *GetEndpointRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ger *GetEndpointRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ger *GetEndpointRequest) Path() string {
+	return endpointsPath(ger.TrunkID) + "/" + url.PathEscape(ger.EndpointID)
+}
+
+func (ger *GetEndpointRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ger *GetEndpointRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListEndpointsAction struct {
+	request  *ListEndpointsRequest
+	response *ListEndpointsResponse
+}
+
+func (lea *ListEndpointsAction) Request() *ListEndpointsRequest {
+	return lea.request
+}
+
+func (lea *ListEndpointsAction) Response() *ListEndpointsResponse {
+	return lea.response
+}
+
+// ListEndpointsRequest lists the endpoints of a SIP trunk.
+type ListEndpointsRequest struct {
+	TrunkID  string `url:"-"`
+	PageSize int    `url:"pageSize,omitempty"`
+	Page     int    `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListEndpointsResponse struct {
+	Endpoints  []Endpoint `json:"endpoints"`
+	Page       int        `json:"page"`
+	PageSize   int        `json:"pageSize"`
+	TotalItems int        `json:"totalItems"`
+}
+
+func (ler *ListEndpointsRequest) WithTrunkID(trunkID string) *ListEndpointsRequest {
+	ler.TrunkID = trunkID
+	return ler
+}
+
+func (ler *ListEndpointsRequest) WithPageSize(pageSize int) *ListEndpointsRequest {
+	ler.PageSize = pageSize
+	return ler
+}
+
+// SetPageToken sets the page number from a token returned by ListEndpointsResponse.NextPageToken.
+func (ler *ListEndpointsRequest) SetPageToken(token string) {
+	ler.Page, _ = strconv.Atoi(token)
+}
+
+func (ler *ListEndpointsRequest) Validate() error {
+	if ler.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	return validPageSize(ler.PageSize)
+}
+
+func (ler *ListEndpointsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ler *ListEndpointsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ler *ListEndpointsRequest) Path() string {
+	return endpointsPath(ler.TrunkID)
+}
+
+func (ler *ListEndpointsRequest) QueryString() (string, error) {
+	return queryString(ler)
+}
+
+func (ler *ListEndpointsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ler *ListEndpointsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, ler)
+}
+
+func (ler *ListEndpointsResponse) Items() []Endpoint {
+	return ler.Endpoints
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (ler *ListEndpointsResponse) NextPageToken() string {
+	return nextPage(ler.Page, ler.PageSize, ler.TotalItems)
+}
+
+type UpdateEndpointAction struct {
+	request  *UpdateEndpointRequest
+	response *Endpoint
+}
+
+func (uea *UpdateEndpointAction) Request() *UpdateEndpointRequest {
+	return uea.request
+}
+
+func (uea *UpdateEndpointAction) Response() *Endpoint {
+	return uea.response
+}
+
+// UpdateEndpointRequest replaces the settings of the endpoint with the ID of Endpoint. The response is the updated
+// endpoint.
+type UpdateEndpointRequest struct {
+	TrunkID  string
+	Endpoint *Endpoint
+}
+
+func (uer *UpdateEndpointRequest) WithTrunkID(trunkID string) *UpdateEndpointRequest {
+	uer.TrunkID = trunkID
+	return uer
+}
+
+func (uer *UpdateEndpointRequest) WithEndpoint(endpoint *Endpoint) *UpdateEndpointRequest {
+	uer.Endpoint = endpoint
+	return uer
+}
+
+func (uer *UpdateEndpointRequest) Validate() error {
+	if uer.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if uer.Endpoint == nil {
+		return EndpointRequiredError
+	}
+	if uer.Endpoint.ID == "" {
+		return EndpointIDRequiredError
+	}
+	return uer.Endpoint.validate()
+}
+
+func (uer *UpdateEndpointRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (uer *UpdateEndpointRequest) Method() string {
+	return http.MethodPut
+}
+
+func (uer *UpdateEndpointRequest) Path() string {
+	return endpointsPath(uer.TrunkID) + "/" + url.PathEscape(uer.Endpoint.ID)
+}
+
+func (uer *UpdateEndpointRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (uer *UpdateEndpointRequest) Body() ([]byte, error) {
+	return json.Marshal(uer.Endpoint)
+}
+
+// DeleteEndpointRequest deletes an endpoint of a SIP trunk. The response has no content, use sinch.NoContent.
+type DeleteEndpointRequest struct {
+	TrunkID    string
+	EndpointID string
+}
+
+func (der *DeleteEndpointRequest) WithEndpoint(trunkID, endpointID string) *DeleteEndpointRequest {
+	der.TrunkID = trunkID
+	der.EndpointID = endpointID
+	return der
+}
+
+func (der *DeleteEndpointRequest) Validate() error {
+	if der.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	if der.EndpointID == "" {
+		return EndpointIDRequiredError
+	}
+	return nil
+}
+
+func (der *DeleteEndpointRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (der *DeleteEndpointRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (der *DeleteEndpointRequest) Path() string {
+	return endpointsPath(der.TrunkID) + "/" + url.PathEscape(der.EndpointID)
+}
+
+func (der *DeleteEndpointRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (der *DeleteEndpointRequest) Body() ([]byte, error) {
+	return nil, nil
+}
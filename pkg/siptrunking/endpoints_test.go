@@ -0,0 +1,80 @@
+package siptrunking
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Endpoints_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateEndpointRequest, *Endpoint] = new(CreateEndpointAction)
+	var _ sinch.Action[*GetEndpointRequest, *Endpoint] = new(GetEndpointAction)
+	var _ sinch.Action[*ListEndpointsRequest, *ListEndpointsResponse] = new(ListEndpointsAction)
+	var _ sinch.ListRequest = new(ListEndpointsRequest)
+	var _ sinch.ListResponse[Endpoint] = new(ListEndpointsResponse)
+	var _ sinch.Action[*UpdateEndpointRequest, *Endpoint] = new(UpdateEndpointAction)
+	var _ sinch.APIRequest = new(DeleteEndpointRequest)
+}
+
+func Test_EndpointRequests(t *testing.T) {
+	runRequestTests(t, map[string]requestTest{
+		"create without trunk": {
+			req:     new(CreateEndpointRequest).WithEndpoint(NewEndpoint("primary", "203.0.113.10", 1)),
+			wantErr: TrunkIDRequiredError,
+		},
+		"create without endpoint": {
+			req:     new(CreateEndpointRequest).WithTrunkID("trunk"),
+			wantErr: EndpointRequiredError,
+		},
+		"create without address": {
+			req:     new(CreateEndpointRequest).WithTrunkID("trunk").WithEndpoint(NewEndpoint("primary", "", 1)),
+			wantErr: EndpointAddressRequiredError,
+		},
+		"create with invalid port": {
+			req:     new(CreateEndpointRequest).WithTrunkID("trunk").WithEndpoint(NewEndpoint("primary", "pbx.example.com", 1).WithPort(70000)),
+			wantErr: InvalidPortError,
+		},
+		"create": {
+			req:        new(CreateEndpointRequest).WithTrunkID("trunk").WithEndpoint(NewEndpoint("primary", "203.0.113.10", 1)),
+			wantMethod: http.MethodPost,
+			wantPath:   "/trunks/trunk/endpoints",
+			wantBody:   `{"name":"primary","address":"203.0.113.10","port":5060,"priority":1,"enabled":true}`,
+		},
+		"get without endpoint": {
+			req:     new(GetEndpointRequest).WithEndpoint("trunk", ""),
+			wantErr: EndpointIDRequiredError,
+		},
+		"get": {
+			req:        new(GetEndpointRequest).WithEndpoint("trunk", "endpoint"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks/trunk/endpoints/endpoint",
+		},
+		"list without trunk": {
+			req:     new(ListEndpointsRequest),
+			wantErr: TrunkIDRequiredError,
+		},
+		"list": {
+			req:        new(ListEndpointsRequest).WithTrunkID("trunk").WithPageSize(10),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks/trunk/endpoints",
+			wantQuery:  "?pageSize=10",
+		},
+		"update without id": {
+			req:     new(UpdateEndpointRequest).WithTrunkID("trunk").WithEndpoint(NewEndpoint("primary", "203.0.113.10", 1)),
+			wantErr: EndpointIDRequiredError,
+		},
+		"update": {
+			req:        new(UpdateEndpointRequest).WithTrunkID("trunk").WithEndpoint(NewEndpoint("primary", "203.0.113.10", 2).WithID("endpoint").Disable()),
+			wantMethod: http.MethodPut,
+			wantPath:   "/trunks/trunk/endpoints/endpoint",
+			wantBody:   `{"id":"endpoint","name":"primary","address":"203.0.113.10","port":5060,"priority":2,"enabled":false}`,
+		},
+		"delete": {
+			req:        new(DeleteEndpointRequest).WithEndpoint("trunk", "endpoint"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/trunks/trunk/endpoints/endpoint",
+			wantStatus: http.StatusNoContent,
+		},
+	})
+}
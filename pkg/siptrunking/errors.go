@@ -0,0 +1,30 @@
+package siptrunking
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ProjectIDRequiredError           = api.ProjectIDRequiredError
+	KeyIDRequiredError               = api.KeyIDRequiredError
+	KeySecretRequiredError           = api.KeySecretRequiredError
+	TrunkRequiredError               = sinch.Error("a trunk is required")
+	TrunkIDRequiredError             = sinch.Error("trunk ID is required")
+	TrunkNameRequiredError           = sinch.Error("trunk name is required")
+	HostNameRequiredError            = sinch.Error("host name is required")
+	EndpointRequiredError            = sinch.Error("an endpoint is required")
+	EndpointIDRequiredError          = sinch.Error("endpoint ID is required")
+	EndpointAddressRequiredError     = sinch.Error("endpoint name and address are required")
+	InvalidPortError                 = sinch.Error("port must be between 1 and 65535")
+	AccessControlListRequiredError   = sinch.Error("an access control list is required")
+	AccessControlListIDRequiredError = sinch.Error("access control list ID is required")
+	IPRangeRequiredError             = sinch.Error("an IP range is required")
+	IPRangeIDRequiredError           = sinch.Error("IP range ID is required")
+	InvalidIPRangeError              = sinch.Error("IP range must be an IPv4 address with a range between 0 and 32")
+	CredentialListRequiredError      = sinch.Error("a credential list is required")
+	CredentialListIDRequiredError    = sinch.Error("credential list ID is required")
+	InvalidCredentialError           = sinch.Error("credentials need a username and a password")
+	NameRequiredError                = sinch.Error("name is required")
+	InvalidPageSizeError             = sinch.Error("page size must be between 0 and 1000")
+)
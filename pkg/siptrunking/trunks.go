@@ -0,0 +1,355 @@
+package siptrunking
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Trunk is a SIP trunk. Calls to the trunk's numbers are routed to its endpoints, and calls from the customer's PBX are
+// sent to its domain, HostName.TopLevelDomain.
+type Trunk struct {
+	ID               string      `json:"id,omitempty"`
+	Name             string      `json:"name"`
+	HostName         string      `json:"hostName"`                 // The unique first label of the trunk's domain.
+	TopLevelDomain   string      `json:"topLevelDomain,omitempty"` // Set by the API, e.g. pstn.sinch.com.
+	Domain           string      `json:"domain,omitempty"`         // Set by the API.
+	CallsPerSecond   int         `json:"callsPerSecond,omitempty"`
+	EnableCallerName bool        `json:"enableCallerName,omitempty"` // Looks up the CNAM of inbound US calls.
+	ProjectID        string      `json:"projectId,omitempty"`
+	CreateTime       *sinch.Time `json:"createTime,omitempty"`
+	UpdateTime       *sinch.Time `json:"updateTime,omitempty"`
+}
+
+func (t *Trunk) WithID(id string) *Trunk {
+	t.ID = id
+	return t
+}
+
+func (t *Trunk) WithName(name string) *Trunk {
+	t.Name = name
+	return t
+}
+
+func (t *Trunk) WithHostName(hostName string) *Trunk {
+	t.HostName = hostName
+	return t
+}
+
+func (t *Trunk) WithCallsPerSecond(callsPerSecond int) *Trunk {
+	t.CallsPerSecond = callsPerSecond
+	return t
+}
+
+func (t *Trunk) WithCallerName() *Trunk {
+	t.EnableCallerName = true
+	return t
+}
+
+func (t *Trunk) validate() error {
+	if t.Name == "" {
+		return TrunkNameRequiredError
+	}
+	if t.HostName == "" {
+		return HostNameRequiredError
+	}
+	return nil
+}
+
+func (t *Trunk) FromJSON(data []byte) error {
+	return json.Unmarshal(data, t)
+}
+
+func queryString(v interface{}) (string, error) {
+	values, err := query.Values(v)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
+
+// nextPage returns the token of the page after page, or an empty string if it is the last one. Pages are numbered from
+// 1.
+func nextPage(page, pageSize, totalItems int) string {
+	if page < 1 || pageSize < 1 || page*pageSize >= totalItems {
+		return ""
+	}
+	return strconv.Itoa(page + 1)
+}
+
+func validPageSize(pageSize int) error {
+	if pageSize < 0 || pageSize > 1000 {
+		return InvalidPageSizeError
+	}
+	return nil
+}
+
+type CreateTrunkAction struct {
+	request  *CreateTrunkRequest
+	response *Trunk
+}
+
+func (cta *CreateTrunkAction) Request() *CreateTrunkRequest {
+	return cta.request
+}
+
+func (cta *CreateTrunkAction) Response() *Trunk {
+	return cta.response
+}
+
+// CreateTrunkRequest creates a SIP trunk. The response is the created trunk.
+type CreateTrunkRequest struct {
+	Trunk *Trunk
+}
+
+func (ctr *CreateTrunkRequest) WithTrunk(trunk *Trunk) *CreateTrunkRequest {
+	ctr.Trunk = trunk
+	return ctr
+}
+
+func (ctr *CreateTrunkRequest) Validate() error {
+	if ctr.Trunk == nil {
+		return TrunkRequiredError
+	}
+	return ctr.Trunk.validate()
+}
+
+func (ctr *CreateTrunkRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ctr *CreateTrunkRequest) Method() string {
+	return http.MethodPost
+}
+
+func (ctr *CreateTrunkRequest) Path() string {
+	return "/trunks"
+}
+
+func (ctr *CreateTrunkRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ctr *CreateTrunkRequest) Body() ([]byte, error) {
+	return json.Marshal(ctr.Trunk)
+}
+
+type GetTrunkAction struct {
+	request  *GetTrunkRequest
+	response *Trunk
+}
+
+func (gta *GetTrunkAction) Request() *GetTrunkRequest {
+	return gta.request
+}
+
+func (gta *GetTrunkAction) Response() *Trunk {
+	return gta.response
+}
+
+// GetTrunkRequest fetches a SIP trunk.
+type GetTrunkRequest struct {
+	TrunkID string
+}
+
+func (gtr *GetTrunkRequest) WithTrunkID(trunkID string) *GetTrunkRequest {
+	gtr.TrunkID = trunkID
+	return gtr
+}
+
+func (gtr *GetTrunkRequest) Validate() error {
+	if gtr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	return nil
+}
+
+func (gtr *GetTrunkRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gtr *GetTrunkRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gtr *GetTrunkRequest) Path() string {
+	return "/trunks/" + url.PathEscape(gtr.TrunkID)
+}
+
+func (gtr *GetTrunkRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gtr *GetTrunkRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListTrunksAction struct {
+	request  *ListTrunksRequest
+	response *ListTrunksResponse
+}
+
+func (lta *ListTrunksAction) Request() *ListTrunksRequest {
+	return lta.request
+}
+
+func (lta *ListTrunksAction) Response() *ListTrunksResponse {
+	return lta.response
+}
+
+// ListTrunksRequest lists the SIP trunks of the project.
+type ListTrunksRequest struct {
+	PageSize int `url:"pageSize,omitempty"`
+	Page     int `url:"page,omitempty"` // The page number starting from 1.
+}
+
+type ListTrunksResponse struct {
+	Trunks     []Trunk `json:"trunks"`
+	Page       int     `json:"page"`
+	PageSize   int     `json:"pageSize"`
+	TotalItems int     `json:"totalItems"`
+}
+
+func (ltr *ListTrunksRequest) WithPageSize(pageSize int) *ListTrunksRequest {
+	ltr.PageSize = pageSize
+	return ltr
+}
+
+// SetPageToken sets the page number from a token returned by ListTrunksResponse.NextPageToken.
+func (ltr *ListTrunksRequest) SetPageToken(token string) {
+	ltr.Page, _ = strconv.Atoi(token)
+}
+
+func (ltr *ListTrunksRequest) Validate() error {
+	return validPageSize(ltr.PageSize)
+}
+
+func (ltr *ListTrunksRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (ltr *ListTrunksRequest) Method() string {
+	return http.MethodGet
+}
+
+func (ltr *ListTrunksRequest) Path() string {
+	return "/trunks"
+}
+
+func (ltr *ListTrunksRequest) QueryString() (string, error) {
+	return queryString(ltr)
+}
+
+func (ltr *ListTrunksRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (ltr *ListTrunksResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, ltr)
+}
+
+func (ltr *ListTrunksResponse) Items() []Trunk {
+	return ltr.Trunks
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one.
+func (ltr *ListTrunksResponse) NextPageToken() string {
+	return nextPage(ltr.Page, ltr.PageSize, ltr.TotalItems)
+}
+
+type UpdateTrunkAction struct {
+	request  *UpdateTrunkRequest
+	response *Trunk
+}
+
+func (uta *UpdateTrunkAction) Request() *UpdateTrunkRequest {
+	return uta.request
+}
+
+func (uta *UpdateTrunkAction) Response() *Trunk {
+	return uta.response
+}
+
+// UpdateTrunkRequest replaces the settings of the SIP trunk with the ID of Trunk. The response is the updated trunk.
+type UpdateTrunkRequest struct {
+	Trunk *Trunk
+}
+
+func (utr *UpdateTrunkRequest) WithTrunk(trunk *Trunk) *UpdateTrunkRequest {
+	utr.Trunk = trunk
+	return utr
+}
+
+func (utr *UpdateTrunkRequest) Validate() error {
+	if utr.Trunk == nil {
+		return TrunkRequiredError
+	}
+	if utr.Trunk.ID == "" {
+		return TrunkIDRequiredError
+	}
+	return utr.Trunk.validate()
+}
+
+func (utr *UpdateTrunkRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (utr *UpdateTrunkRequest) Method() string {
+	return http.MethodPut
+}
+
+func (utr *UpdateTrunkRequest) Path() string {
+	return "/trunks/" + url.PathEscape(utr.Trunk.ID)
+}
+
+func (utr *UpdateTrunkRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (utr *UpdateTrunkRequest) Body() ([]byte, error) {
+	return json.Marshal(utr.Trunk)
+}
+
+// DeleteTrunkRequest deletes a SIP trunk and its endpoints. The response has no content, use sinch.NoContent.
+type DeleteTrunkRequest struct {
+	TrunkID string
+}
+
+func (dtr *DeleteTrunkRequest) WithTrunkID(trunkID string) *DeleteTrunkRequest {
+	dtr.TrunkID = trunkID
+	return dtr
+}
+
+func (dtr *DeleteTrunkRequest) Validate() error {
+	if dtr.TrunkID == "" {
+		return TrunkIDRequiredError
+	}
+	return nil
+}
+
+func (dtr *DeleteTrunkRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (dtr *DeleteTrunkRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (dtr *DeleteTrunkRequest) Path() string {
+	return "/trunks/" + url.PathEscape(dtr.TrunkID)
+}
+
+func (dtr *DeleteTrunkRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (dtr *DeleteTrunkRequest) Body() ([]byte, error) {
+	return nil, nil
+}
@@ -0,0 +1,141 @@
+package siptrunking
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Trunks_Implementations(t *testing.T) {
+	var _ sinch.Action[*CreateTrunkRequest, *Trunk] = new(CreateTrunkAction)
+	var _ sinch.Action[*GetTrunkRequest, *Trunk] = new(GetTrunkAction)
+	var _ sinch.Action[*ListTrunksRequest, *ListTrunksResponse] = new(ListTrunksAction)
+	var _ sinch.ListRequest = new(ListTrunksRequest)
+	var _ sinch.ListResponse[Trunk] = new(ListTrunksResponse)
+	var _ sinch.Action[*UpdateTrunkRequest, *Trunk] = new(UpdateTrunkAction)
+	var _ sinch.APIRequest = new(DeleteTrunkRequest)
+}
+
+// requestTest is a case of the request tables of the package tests.
+type requestTest struct {
+	req        sinch.APIRequest
+	wantErr    error
+	wantMethod string
+	wantPath   string
+	wantQuery  string
+	wantBody   string
+	wantStatus int
+}
+
+func runRequestTests(t *testing.T, tests map[string]requestTest) {
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			if tt.wantStatus != 0 {
+				assert.Equal(t, tt.wantStatus, tt.req.ExpectedStatusCode())
+			}
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			if tt.wantBody == "" {
+				assert.Nil(t, body)
+			} else {
+				assert.JSONEq(t, tt.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func Test_TrunkRequests(t *testing.T) {
+	runRequestTests(t, map[string]requestTest{
+		"create without trunk": {
+			req:     new(CreateTrunkRequest),
+			wantErr: TrunkRequiredError,
+		},
+		"create without name": {
+			req:     new(CreateTrunkRequest).WithTrunk(new(Trunk).WithHostName("acme")),
+			wantErr: TrunkNameRequiredError,
+		},
+		"create without host name": {
+			req:     new(CreateTrunkRequest).WithTrunk(new(Trunk).WithName("Acme PBX")),
+			wantErr: HostNameRequiredError,
+		},
+		"create": {
+			req:        new(CreateTrunkRequest).WithTrunk(new(Trunk).WithName("Acme PBX").WithHostName("acme").WithCallsPerSecond(5).WithCallerName()),
+			wantMethod: http.MethodPost,
+			wantPath:   "/trunks",
+			wantBody:   `{"name":"Acme PBX","hostName":"acme","callsPerSecond":5,"enableCallerName":true}`,
+		},
+		"get without id": {
+			req:     new(GetTrunkRequest),
+			wantErr: TrunkIDRequiredError,
+		},
+		"get": {
+			req:        new(GetTrunkRequest).WithTrunkID("trunk"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks/trunk",
+		},
+		"list": {
+			req:        new(ListTrunksRequest).WithPageSize(50),
+			wantMethod: http.MethodGet,
+			wantPath:   "/trunks",
+			wantQuery:  "?pageSize=50",
+		},
+		"list with invalid page size": {
+			req:     new(ListTrunksRequest).WithPageSize(-1),
+			wantErr: InvalidPageSizeError,
+		},
+		"update without id": {
+			req:     new(UpdateTrunkRequest).WithTrunk(new(Trunk).WithName("Acme PBX").WithHostName("acme")),
+			wantErr: TrunkIDRequiredError,
+		},
+		"update": {
+			req:        new(UpdateTrunkRequest).WithTrunk(new(Trunk).WithID("trunk").WithName("Acme PBX").WithHostName("acme")),
+			wantMethod: http.MethodPut,
+			wantPath:   "/trunks/trunk",
+			wantBody:   `{"id":"trunk","name":"Acme PBX","hostName":"acme"}`,
+		},
+		"delete": {
+			req:        new(DeleteTrunkRequest).WithTrunkID("trunk"),
+			wantMethod: http.MethodDelete,
+			wantPath:   "/trunks/trunk",
+			wantStatus: http.StatusNoContent,
+		},
+	})
+}
+
+func Test_ListTrunksResponse_NextPageToken(t *testing.T) {
+	tests := map[string]struct {
+		resp *ListTrunksResponse
+		want string
+	}{
+		"first of two":  {resp: &ListTrunksResponse{Page: 1, PageSize: 2, TotalItems: 3}, want: "2"},
+		"last":          {resp: &ListTrunksResponse{Page: 2, PageSize: 2, TotalItems: 3}, want: ""},
+		"exactly full":  {resp: &ListTrunksResponse{Page: 1, PageSize: 3, TotalItems: 3}, want: ""},
+		"no pagination": {resp: &ListTrunksResponse{TotalItems: 3}, want: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.resp.NextPageToken())
+		})
+	}
+}
+
+func Test_Trunk_FromJSON(t *testing.T) {
+	trunk := new(Trunk)
+	assert.NoError(t, trunk.FromJSON([]byte(`{"id": "trunk", "name": "Acme PBX", "hostName": "acme", "topLevelDomain": "pstn.sinch.com",
+		"domain": "acme.pstn.sinch.com", "callsPerSecond": 5, "createTime": "2023-04-01T10:00:00Z"}`)))
+	assert.Equal(t, "acme.pstn.sinch.com", trunk.Domain)
+	assert.Equal(t, 2023, trunk.CreateTime.Year())
+	assert.Nil(t, trunk.UpdateTime)
+}
@@ -30,8 +30,8 @@ type BatchSendRequest struct {
 	FromNumber              string                       `json:"from,omitempty"`                        // Sender number. Must be valid phone number, short code or alphanumeric. Required if Automatic Default Originator not configured.
 	Parameters              map[string]map[string]string `json:"parameters,omitempty"`                  // Contains the parameters that will be used for customizing the message for each recipient. Ref: https://developers.sinch.com/docs/sms/resources/message-info/message-parameterization/
 	CampaignID              string                       `json:"campaign_id,omitempty"`                 // The campaign and service IDs this message belongs to. You generate your own campaign and service IDs. US only. Ref: https://dashboard.sinch.com/sms/us-campaigns
-	SendAt                  string                       `json:"send_at,omitempty"`                     // If set in the future, the message will be delayed until send_at occurs. Must be before expire_at. If set in the past, messages will be sent immediately. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
-	ExpireAt                string                       `json:"expire_at,omitempty"`                   // If set, the system will stop trying to deliver the message at this point. Must be after send_at. Default and max is 3 days after send_at. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
+	SendAt                  *sinch.Time                  `json:"send_at,omitempty"`                     // If set in the future, the message will be delayed until send_at occurs. Must be before expire_at. If set in the past, messages will be sent immediately. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
+	ExpireAt                *sinch.Time                  `json:"expire_at,omitempty"`                   // If set, the system will stop trying to deliver the message at this point. Must be after send_at. Default and max is 3 days after send_at. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
 	CallbackURL             string                       `json:"callback_url,omitempty"`                // Override the default callback URL for this batch. Must be valid URL.
 	ClientReference         string                       `json:"client_reference,omitempty"`            // The client identifier of a batch message. If set, the identifier will be added in the delivery report/callback of this batch
 	FeedbackEnabled         bool                         `json:"feedback_enabled,omitempty"`            // If set to true, then feedback is expected after successful delivery. Ref: https://developers.sinch.com/docs/sms/api-reference/sms/tag/Batches/#tag/Batches/operation/deliveryFeedback
@@ -44,10 +44,10 @@ type BatchSendRequest struct {
 
 type BatchSendResponse struct {
 	BatchSendRequest
-	ID         string `json:"id"`          // Unique identifier for batch
-	Canceled   bool   `json:"canceled"`    // Indicates if the batch has been canceled or not
-	CreatedAt  string `json:"created_at"`  // Timestamp for when batch was created. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
-	ModifiedAt string `json:"modified_at"` // Timestamp for when batch was last updated. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
+	ID         string     `json:"id"`          // Unique identifier for batch
+	Canceled   bool       `json:"canceled"`    // Indicates if the batch has been canceled or not
+	CreatedAt  sinch.Time `json:"created_at"`  // Timestamp for when batch was created. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
+	ModifiedAt sinch.Time `json:"modified_at"` // Timestamp for when batch was last updated. Formatted as ISO-8601: YYYY-MM-DDThh:mm:ss.SSSZ
 }
 
 // WithBody sets the message body for the request.
@@ -94,15 +94,33 @@ func (bsr *BatchSendRequest) WithCampaignID(campaignID string) *BatchSendRequest
 	return bsr
 }
 
-// SendingAt sets the date and time to deliver the batch.
+// WithSendAt sets the date and time to deliver the batch.
+func (bsr *BatchSendRequest) WithSendAt(sendAt time.Time) *BatchSendRequest {
+	bsr.SendAt = newTime(sendAt)
+	return bsr
+}
+
+// WithExpireAt sets the date and time to stop attempting to deliver a batch if failures occur.
+func (bsr *BatchSendRequest) WithExpireAt(expireAt time.Time) *BatchSendRequest {
+	bsr.ExpireAt = newTime(expireAt)
+	return bsr
+}
+
+// SendingAt sets the date and time to deliver the batch from an ISO-8601 timestamp. Validate fails with
+// InvalidSendAtError if sendAt cannot be parsed.
+//
+// Deprecated: use WithSendAt.
 func (bsr *BatchSendRequest) SendingAt(sendAt string) *BatchSendRequest {
-	bsr.SendAt = sendAt
+	bsr.SendAt = parseTime(sendAt)
 	return bsr
 }
 
-// ExpiringAt sets the date and time to stop attempting to deliver a batch if failures occur.
+// ExpiringAt sets the date and time to stop attempting to deliver a batch from an ISO-8601 timestamp. Validate fails
+// with InvalidExpireAtError if expireAt cannot be parsed.
+//
+// Deprecated: use WithExpireAt.
 func (bsr *BatchSendRequest) ExpiringAt(expireAt string) *BatchSendRequest {
-	bsr.ExpireAt = expireAt
+	bsr.ExpireAt = parseTime(expireAt)
 	return bsr
 }
 
@@ -181,15 +199,11 @@ func (bsr *BatchSendRequest) Validate() error {
 	if bsr.CallbackURL != "" && !strings.HasPrefix(bsr.CallbackURL, "http") || len(bsr.CallbackURL) > 2048 {
 		errors = append(errors, InvalidCallbackURLError)
 	}
-	if bsr.SendAt != "" {
-		if _, err := time.Parse(TimeFormat, bsr.SendAt); err != nil {
-			errors = append(errors, InvalidSendAtError)
-		}
+	if bsr.SendAt != nil && bsr.SendAt.IsZero() {
+		errors = append(errors, InvalidSendAtError)
 	}
-	if bsr.ExpireAt != "" {
-		if _, err := time.Parse(TimeFormat, bsr.ExpireAt); err != nil {
-			errors = append(errors, InvalidExpireAtError)
-		}
+	if bsr.ExpireAt != nil && (bsr.ExpireAt.IsZero() || bsr.SendAt != nil && !bsr.ExpireAt.After(bsr.SendAt.Time)) {
+		errors = append(errors, InvalidExpireAtError)
 	}
 	if len(errors) > 0 {
 		return errors
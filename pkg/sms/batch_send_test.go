@@ -2,6 +2,7 @@ package sms
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/thezmc/go-sinch/pkg/sinch"
@@ -54,15 +55,21 @@ func Test_BatchSendRequest_Validate(t *testing.T) {
 		},
 		"bad send at time": {
 			configFn: func() {
-				bsr = new(BatchSendRequest)
-				bsr.SendAt = "test"
+				bsr = new(BatchSendRequest).SendingAt("test")
 			},
 			expectedErr: InvalidSendAtError,
 		},
 		"bad expiry time": {
 			configFn: func() {
-				bsr = new(BatchSendRequest)
-				bsr.ExpireAt = "test"
+				bsr = new(BatchSendRequest).ExpiringAt("test")
+			},
+			expectedErr: InvalidExpireAtError,
+		},
+		"expiry before send time": {
+			configFn: func() {
+				bsr = new(BatchSendRequest).
+					WithSendAt(time.Now()).
+					WithExpireAt(time.Now().Add(-time.Hour))
 			},
 			expectedErr: InvalidExpireAtError,
 		},
@@ -87,3 +94,21 @@ func Test_BatchSendRequest_Validate(t *testing.T) {
 		})
 	}
 }
+
+func Test_BatchSend_Times(t *testing.T) {
+	sendAt := time.Date(2022, 7, 25, 21, 7, 14, 0, time.FixedZone("CEST", 2*60*60))
+	body, err := new(BatchSendRequest).WithSendAt(sendAt).WithExpireAt(sendAt.Add(24 * time.Hour)).Body()
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"send_at":"2022-07-25T19:07:14.000Z","expire_at":"2022-07-26T19:07:14.000Z"`)
+
+	resp := new(BatchSendResponse)
+	assert.NoError(t, resp.FromJSON([]byte(`{"id": "1", "created_at": "2022-07-25T19:07:14.123Z", "modified_at": "2022-07-25T19:07:15.000Z"}`)))
+	assert.Equal(t, 123*time.Millisecond, time.Duration(resp.CreatedAt.Nanosecond()))
+	assert.True(t, resp.ModifiedAt.After(resp.CreatedAt.Time))
+}
+
+func Test_BatchSend_StringTimes(t *testing.T) {
+	body, err := new(BatchSendRequest).SendingAt("2022-07-25T21:07:14+02:00").ExpiringAt("2022-07-26T19:07:14.000Z").Body()
+	assert.NoError(t, err)
+	assert.Contains(t, string(body), `"send_at":"2022-07-25T19:07:14.000Z","expire_at":"2022-07-26T19:07:14.000Z"`)
+}
@@ -1,16 +1,27 @@
 package sms // import sinchsms "github.com/thezmc/go-sinch/sms"
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
 type Client struct {
-	SinchAPI  api.Client
-	PlanID    string
-	AuthToken string
+	SinchAPI      api.Client // The API client requests are sent with, unless a shared one is set with WithSinchAPI.
+	PlanID        string
+	AuthToken     string
+	ProjectID     string              // When set, the client uses the project-based endpoints instead of the plan-based ones.
+	Authenticator sinch.Authenticator // When set, authenticates requests instead of the AuthToken.
+
+	Region            Region                     // The region requests are sent to. Empty if a custom base URL is used.
+	RegionCredentials map[Region]PlanCredentials // Service plans provisioned per region, see WithRegionCredentials.
+	FailoverRegions   []Region                   // Regions tried in order when a request fails, see WithFailover.
+	OnFailover        func(FailoverEvent)        // Called before a request is retried in another region.
+	RecipientFilter   RecipientFilter            // Filters the recipients of batches before they are sent, see WithRecipientFilter.
+	sharedAPI         *api.Client
 }
 
 const (
@@ -19,36 +30,36 @@ const (
 	EUBaseURLv1 = "https://eu" + BaseURLv1
 	AUBaseURLv1 = "https://au" + BaseURLv1
 	BRBaseURLv1 = "https://br" + BaseURLv1
-	CABaseURLv1 = "https://cn" + BaseURLv1
+	CABaseURLv1 = "https://ca" + BaseURLv1
+
+	// The base URLs of the project-based endpoints, which authenticate with OAuth2. See Region.ProjectBaseURL.
+	USProjectBaseURLv1 = "https://zt.us" + BaseURLv1
+	EUProjectBaseURLv1 = "https://zt.eu" + BaseURLv1
 )
 
+// US is a shortcut for WithRegion(RegionUS).
 func (c *Client) US() *Client {
-	c.SinchAPI.WithBaseURL(USBaseURLv1)
-	return c
+	return c.WithRegion(RegionUS)
 }
 
-// EU is a shortcut for New().WithBaseURL(EUBaseURLv1)
+// EU is a shortcut for WithRegion(RegionEU).
 func (c *Client) EU() *Client {
-	c.SinchAPI.WithBaseURL(EUBaseURLv1)
-	return c
+	return c.WithRegion(RegionEU)
 }
 
-// AU is a shortcut for New().WithBaseURL(AUBaseURLv1)
+// AU is a shortcut for WithRegion(RegionAU).
 func (c *Client) AU() *Client {
-	c.SinchAPI.WithBaseURL(AUBaseURLv1)
-	return c
+	return c.WithRegion(RegionAU)
 }
 
-// BR is a shortcut for New().WithBaseURL(BRBaseURLv1)
+// BR is a shortcut for WithRegion(RegionBR).
 func (c *Client) BR() *Client {
-	c.SinchAPI.WithBaseURL(BRBaseURLv1)
-	return c
+	return c.WithRegion(RegionBR)
 }
 
-// CA is a shortcut for New().WithBaseURL(CABaseURLv1)
+// CA is a shortcut for WithRegion(RegionCA).
 func (c *Client) CA() *Client {
-	c.SinchAPI.WithBaseURL(CABaseURLv1)
-	return c
+	return c.WithRegion(RegionCA)
 }
 
 // WithPlanID sets the plan ID for the client
@@ -57,13 +68,55 @@ func (c *Client) WithPlanID(planID string) *Client {
 	return c
 }
 
+// WithSinchAPI makes the client send requests with sinchAPI, which other clients may share, in the region of its base
+// URL. Changing regions gives the client its own copy of sinchAPI, see api.OwnAPI.
 func (c *Client) WithSinchAPI(sinchAPI *api.Client) *Client {
-	c.SinchAPI = *sinchAPI
+	c.ShareAPI(sinchAPI)
+	c.Region = regionOf(sinchAPI.BaseURL)
+	return c
+}
+
+// API returns the API client the client sends requests with.
+func (c *Client) API() *api.Client {
+	if c.sharedAPI != nil {
+		return c.sharedAPI
+	}
+	return &c.SinchAPI
+}
+
+func (c *Client) ShareAPI(sinchAPI *api.Client) {
+	c.sharedAPI = sinchAPI
+}
+
+// WithProjectID makes the client use the project-based endpoints of the given project, which are served from the
+// zt.{region}.sms.api.sinch.com hosts. These require OAuth2 authentication, see WithTokenSource.
+func (c *Client) WithProjectID(projectID string) *Client {
+	c.ProjectID = projectID
 	return c
 }
 
+// WithAuthenticator authenticates requests with a instead of the auth token. See the auth package for implementations.
+func (c *Client) WithAuthenticator(a sinch.Authenticator) *Client {
+	c.Authenticator = a
+	return c
+}
+
+// WithTokenSource authenticates requests with OAuth2 access tokens from ts. OAuth2 is only supported by the
+// project-based endpoints, see WithProjectID. See auth.ClientCredentials.
+func (c *Client) WithTokenSource(ts auth.TokenSource) *Client {
+	return c.WithAuthenticator(auth.NewOAuth2(ts))
+}
+
+// URL returns the URL of the service plan, or of the project if a project ID is set. Projects are served from the
+// project-based host of the region, see Region.ProjectBaseURL, unless a custom base URL is used.
 func (c *Client) URL() string {
-	return c.SinchAPI.BaseURL + "/" + c.PlanID
+	if c.ProjectID != "" {
+		if c.Region != "" {
+			return c.Region.ProjectBaseURL() + "/" + c.ProjectID
+		}
+		return c.API().BaseURL + "/" + c.ProjectID
+	}
+	return c.API().BaseURL + "/" + c.PlanID
 }
 
 func (api *Client) WithAuthToken(authToken string) *Client {
@@ -76,21 +129,57 @@ func (c Client) Credentials() (string, string) {
 }
 
 func (c *Client) Validate() error {
-	if c.PlanID == "" {
+	if c.Region != "" && !c.Region.IsValid() {
+		return InvalidRegionError
+	}
+	for _, r := range c.FailoverRegions {
+		if !r.IsValid() {
+			return InvalidRegionError
+		}
+	}
+	if _, ok := c.Authenticator.(auth.TokenSource); ok && c.ProjectID == "" {
+		return NoProjectIDError
+	}
+	if c.PlanID == "" && c.ProjectID == "" {
 		return NoPlanIDError
 	}
-	if c.AuthToken == "" {
+	if c.AuthToken == "" && c.Authenticator == nil {
 		return NoAuthTokenError
 	}
 	return nil
 }
 
 func (c *Client) Authenticate(httpReq *http.Request) (*http.Request, error) {
+	if c.Authenticator != nil {
+		return c.Authenticator.Authenticate(httpReq)
+	}
 	httpReq.Header.Set(c.Credentials())
 	return httpReq, nil
 }
 
 // Do executes the given request with the client's http.Client and returns the response object.
 func (c *Client) Do(req sinch.APIRequest, resp sinch.APIResponse) error {
-	return c.SinchAPI.Do(c, req, resp)
+	return c.DoContext(context.Background(), req, resp)
+}
+
+// DoContext executes the given request like Do, aborting it if ctx is done before it completes.
+func (c *Client) DoContext(ctx context.Context, req sinch.APIRequest, resp sinch.APIResponse) error {
+	req, err := c.filterRecipients(ctx, req)
+	if err != nil {
+		return err
+	}
+	err = c.API().DoContext(ctx, c, req, resp)
+	from := c.Region
+	for _, to := range c.FailoverRegions {
+		if !c.shouldFailover(ctx, err) {
+			break
+		}
+		if c.OnFailover != nil {
+			c.OnFailover(FailoverEvent{From: from, To: to, Err: err})
+		}
+		regional := c.inRegion(to)
+		err = regional.API().DoContext(ctx, regional, req, resp)
+		from = to
+	}
+	return err
 }
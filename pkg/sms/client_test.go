@@ -1,9 +1,14 @@
 package sms
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
 	"github.com/thezmc/go-sinch/pkg/sinch"
 )
 
@@ -37,6 +42,24 @@ func Test_Client_Validate(t *testing.T) {
 			},
 			expectedErr: nil,
 		},
+		"token source without project id": {
+			configFn: func() {
+				c = new(Client).WithTokenSource(staticTokenSource("token"))
+			},
+			expectedErr: NoProjectIDError,
+		},
+		"authenticator without auth token": {
+			configFn: func() {
+				c = new(Client).WithPlanID(testPlanID).WithAuthenticator(auth.NewBearerToken(testAuthToken))
+			},
+			expectedErr: nil,
+		},
+		"token source": {
+			configFn: func() {
+				c = new(Client).WithProjectID("project").WithTokenSource(staticTokenSource("token"))
+			},
+			expectedErr: nil,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -49,3 +72,26 @@ func Test_Client_Validate(t *testing.T) {
 		})
 	}
 }
+
+type staticTokenSource string
+
+func (ts staticTokenSource) Token(ctx context.Context) (*auth.Token, error) {
+	return &auth.Token{AccessToken: string(ts)}, nil
+}
+
+func Test_Client_TokenSource(t *testing.T) {
+	c := new(Client).
+		WithPlanID("plan").
+		WithProjectID("project").
+		WithTokenSource(staticTokenSource("token")).
+		WithSinchAPI(new(api.Client).WithBaseURL(USBaseURLv1))
+	assert.Equal(t, "https://zt.us.sms.api.sinch.com/xms/v1/project", c.URL())
+	assert.Equal(t, EUProjectBaseURLv1+"/project", c.EU().URL())
+	assert.Equal(t, "http://localhost/project", c.WithSinchAPI(new(api.Client).WithBaseURL("http://localhost")).URL(),
+		"custom base URLs are used as is")
+
+	req := httptest.NewRequest(http.MethodGet, "/", nil)
+	_, err := c.Authenticate(req)
+	assert.NoError(t, err)
+	assert.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+}
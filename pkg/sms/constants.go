@@ -1,5 +1,27 @@
 package sms
 
+import (
+	"time"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
 const (
 	TimeFormat = "2006-01-02T15:04:05.000Z"
 )
+
+// newTime returns t in UTC formatted with millisecond precision, as expected by the SMS API.
+func newTime(t time.Time) *sinch.Time {
+	st := sinch.NewTime(t.UTC()).WithLayout(TimeFormat)
+	return &st
+}
+
+// parseTime parses an ISO-8601 timestamp into a time formatted like the SMS API. It returns the zero time, which
+// requests reject when they are validated, if s cannot be parsed.
+func parseTime(s string) *sinch.Time {
+	parsed, err := sinch.ParseTime(s)
+	if err != nil {
+		return new(sinch.Time)
+	}
+	return newTime(parsed.Time)
+}
@@ -9,6 +9,7 @@ func (e Error) Error() string {
 const (
 	NoAuthTokenError            = Error("an auth token is required")
 	NoPlanIDError               = Error("a plan ID is required")
+	NoProjectIDError            = Error("a project ID is required")
 	InvalidToNumberError        = Error("at least one to_number is required and no more than 1000 are allowed")
 	InvalidFromNumberError      = Error("a from_number is required")
 	InvalidTypeOfNumberError    = Error("type_of_number must be an int in the range 0-6")
@@ -16,7 +17,11 @@ const (
 	InvalidBodyError            = Error("body must be between 0 and 2000 characters long")
 	InvalidCallbackURLError     = Error("callback_url must start with http and be between 0 and 2048 characters long")
 	InvalidClientReferenceError = Error("client_reference must be between 0 and 255 characters long")
-	InvalidSendAtError          = Error("send_at must be in ISO-8601 format")
-	InvalidExpireAtError        = Error("expire_at must be in ISO-8601 format")
+	InvalidSendAtError          = Error("send_at must be a valid time")
+	InvalidExpireAtError        = Error("expire_at must be a valid time after send_at")
 	InvalidMaxMessagePartsError = Error("max_number_of_message_parts must be greater than 0")
+	InvalidPageError            = Error("page must be greater than or equal to 0")
+	InvalidPageSizeError        = Error("page_size must be between 0 and 100")
+	InvalidRegionError          = Error("region must be one of us, eu, au, br or ca")
+	NoRecipientsError           = Error("the recipient filter removed all recipients")
 )
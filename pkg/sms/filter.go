@@ -0,0 +1,51 @@
+package sms
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// RecipientFilter decides which recipients of a batch are sent to, e.g. to skip numbers that cannot receive SMS. See
+// the lookup package for a filter that drops recipients that are not mobile numbers.
+type RecipientFilter interface {
+	// FilterRecipients returns the recipients to send to. An error aborts the send.
+	FilterRecipients(ctx context.Context, recipients []string) ([]string, error)
+}
+
+// RecipientFilterFunc adapts a function to a RecipientFilter.
+type RecipientFilterFunc func(ctx context.Context, recipients []string) ([]string, error)
+
+func (fn RecipientFilterFunc) FilterRecipients(ctx context.Context, recipients []string) ([]string, error) {
+	return fn(ctx, recipients)
+}
+
+// WithRecipientFilter filters the recipients of every BatchSendRequest sent with the client through f. The request
+// passed to Do is not modified. Sends whose recipients are all filtered out fail with NoRecipientsError.
+func (c *Client) WithRecipientFilter(f RecipientFilter) *Client {
+	c.RecipientFilter = f
+	return c
+}
+
+// filterRecipients returns req with its recipients filtered, or req itself if it is not a batch or no filter is set. The
+// client and request are validated first, so invalid requests are never filtered.
+func (c *Client) filterRecipients(ctx context.Context, req sinch.APIRequest) (sinch.APIRequest, error) {
+	bsr, ok := req.(*BatchSendRequest)
+	if !ok || c.RecipientFilter == nil || len(bsr.ToNumbers) == 0 {
+		return req, nil
+	}
+	if err := api.Validate(c, bsr); err != nil {
+		return nil, err
+	}
+	recipients, err := c.RecipientFilter.FilterRecipients(ctx, bsr.ToNumbers)
+	if err != nil {
+		return nil, err
+	}
+	if len(recipients) == 0 {
+		return nil, NoRecipientsError
+	}
+	filtered := *bsr
+	filtered.ToNumbers = recipients
+	return &filtered, nil
+}
@@ -0,0 +1,71 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Client_RecipientFilter(t *testing.T) {
+	var gotTo []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body BatchSendRequest
+		data, _ := io.ReadAll(r.Body)
+		_ = json.Unmarshal(data, &body)
+		gotTo = body.ToNumbers
+		w.WriteHeader(http.StatusCreated)
+		io.WriteString(w, `{"id": "batch"}`)
+	}))
+	defer srv.Close()
+
+	dropLandlines := RecipientFilterFunc(func(ctx context.Context, recipients []string) ([]string, error) {
+		var kept []string
+		for _, r := range recipients {
+			if r != "+12025550100" {
+				kept = append(kept, r)
+			}
+		}
+		return kept, nil
+	})
+	client, err := New(WithPlanID("plan"), WithAuthToken("token"), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()), WithRecipientFilter(dropLandlines))
+	assert.NoError(t, err)
+
+	req := new(BatchSendRequest).To("+12025550100", "+12025550101").From("+12025550199").WithMessageBody("Hello")
+	assert.NoError(t, client.Do(req, new(BatchSendResponse)))
+	assert.Equal(t, []string{"+12025550101"}, gotTo)
+	assert.Equal(t, []string{"+12025550100", "+12025550101"}, req.ToNumbers, "the request must not be modified")
+
+	gotTo = nil
+	req = new(BatchSendRequest).To("+12025550100").From("+12025550199").WithMessageBody("Hello")
+	assert.ErrorIs(t, client.Do(req, new(BatchSendResponse)), NoRecipientsError)
+	assert.Nil(t, gotTo)
+
+	client.WithRecipientFilter(RecipientFilterFunc(func(ctx context.Context, recipients []string) ([]string, error) {
+		return nil, assert.AnError
+	}))
+	assert.ErrorIs(t, client.Do(req, new(BatchSendResponse)), assert.AnError)
+}
+
+func Test_Client_RecipientFilter_InvalidRequest(t *testing.T) {
+	var calls int
+	countCalls := RecipientFilterFunc(func(ctx context.Context, recipients []string) ([]string, error) {
+		calls++
+		return recipients, nil
+	})
+	client, err := New(WithPlanID("plan"), WithAuthToken("token"), WithRecipientFilter(countCalls))
+	assert.NoError(t, err)
+
+	req := new(BatchSendRequest).To("+12025550100").From("+12025550199")
+	assert.ErrorIs(t, client.Do(req, new(BatchSendResponse)), InvalidBodyError)
+	assert.Zero(t, calls, "invalid requests must not be filtered")
+
+	client.WithAuthToken("")
+	req.WithMessageBody("Hello")
+	assert.ErrorIs(t, client.Do(req, new(BatchSendResponse)), NoAuthTokenError)
+	assert.Zero(t, calls, "requests of invalid clients must not be filtered")
+}
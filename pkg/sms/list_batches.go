@@ -0,0 +1,153 @@
+package sms
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type ListBatches struct {
+	request  *ListBatchesRequest
+	response *ListBatchesResponse
+}
+
+func (lb *ListBatches) Request() *ListBatchesRequest {
+	return lb.request
+}
+
+func (lb *ListBatches) Response() *ListBatchesResponse {
+	return lb.response
+}
+
+type ListBatchesRequest struct {
+	Page            int         `url:"page,omitempty"`             // The page number starting from 0.
+	PageSize        int         `url:"page_size,omitempty"`        // Determines the size of a page. Max 100.
+	FromNumbers     []string    `url:"from,omitempty" del:","`     // Only list messages sent from these sender numbers.
+	StartDate       *sinch.Time `url:"start_date,omitempty"`       // Only list messages received at or after this date/time. Default: Now-24
+	EndDate         *sinch.Time `url:"end_date,omitempty"`         // Only list messages received before this date/time.
+	ClientReference string      `url:"client_reference,omitempty"` // Client reference to include
+}
+
+// DefaultPageSize is the number of batches per page when ListBatchesRequest.PageSize is not set.
+const DefaultPageSize = 30
+
+type ListBatchesResponse struct {
+	Count    int                 `json:"count"`     // The total number of entries matching the given filters.
+	Page     int                 `json:"page"`      // The requested page.
+	PageSize int                 `json:"page_size"` // The number of entries returned in this request.
+	Batches  []BatchSendResponse `json:"batches"`   // The page of batches matching the given filters.
+
+	requestedPageSize int // The page size of the request, set by ListBatches to find the last page.
+}
+
+// WithPage sets the page number to fetch, starting from 0.
+func (lbr *ListBatchesRequest) WithPage(page int) *ListBatchesRequest {
+	lbr.Page = page
+	return lbr
+}
+
+// WithPageSize sets the number of batches per page.
+func (lbr *ListBatchesRequest) WithPageSize(pageSize int) *ListBatchesRequest {
+	lbr.PageSize = pageSize
+	return lbr
+}
+
+// From filters the batches by sender number.
+func (lbr *ListBatchesRequest) From(from ...string) *ListBatchesRequest {
+	lbr.FromNumbers = append(lbr.FromNumbers, from...)
+	return lbr
+}
+
+// Between filters the batches by creation time. Either bound may be the zero time to leave it open.
+func (lbr *ListBatchesRequest) Between(start, end time.Time) *ListBatchesRequest {
+	lbr.StartDate, lbr.EndDate = nil, nil
+	if !start.IsZero() {
+		lbr.StartDate = newTime(start)
+	}
+	if !end.IsZero() {
+		lbr.EndDate = newTime(end)
+	}
+	return lbr
+}
+
+// WithClientReference filters the batches by client reference.
+func (lbr *ListBatchesRequest) WithClientReference(clientReference string) *ListBatchesRequest {
+	lbr.ClientReference = clientReference
+	return lbr
+}
+
+// SetPageToken sets the page number from a token returned by ListBatchesResponse.NextPageToken.
+func (lbr *ListBatchesRequest) SetPageToken(token string) {
+	lbr.Page, _ = strconv.Atoi(token)
+}
+
+func (lbr *ListBatchesRequest) Validate() error {
+	if lbr.Page < 0 {
+		return InvalidPageError
+	}
+	if lbr.PageSize < 0 || lbr.PageSize > 100 {
+		return InvalidPageSizeError
+	}
+	return nil
+}
+
+func (lbr *ListBatchesRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lbr *ListBatchesRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lbr *ListBatchesRequest) QueryString() (string, error) {
+	v, err := query.Values(lbr)
+	if err != nil {
+		return "", err
+	}
+	if len(v) == 0 {
+		return "", nil
+	}
+	return "?" + v.Encode(), nil
+}
+
+func (lbr *ListBatchesRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lbr *ListBatchesRequest) Path() string {
+	return "/batches"
+}
+
+func (lbr *ListBatchesResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lbr)
+}
+
+func (lbr *ListBatchesResponse) Items() []BatchSendResponse {
+	return lbr.Batches
+}
+
+// NextPageToken returns the number of the next page, or an empty string if this page is the last one. A page is the
+// last one if it holds fewer batches than the requested page size, or if it reaches the total count. PageSize is not
+// used since it is the number of batches on this page, not the requested page size.
+func (lbr *ListBatchesResponse) NextPageToken() string {
+	pageSize := lbr.requestedPageSize
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+	if len(lbr.Batches) < pageSize || (lbr.Page+1)*pageSize >= lbr.Count {
+		return ""
+	}
+	return strconv.Itoa(lbr.Page + 1)
+}
+
+// ListBatches returns a pager over the batches matching the request. The pager takes ownership of req.
+func (c *Client) ListBatches(req *ListBatchesRequest) *api.Pager[BatchSendResponse] {
+	return api.NewPager[BatchSendResponse](c, req, func() sinch.ListResponse[BatchSendResponse] {
+		return &ListBatchesResponse{requestedPageSize: req.PageSize}
+	})
+}
@@ -0,0 +1,89 @@
+package sms
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_ListBatches_Implementations(t *testing.T) {
+	var _ sinch.Action[*ListBatchesRequest, *ListBatchesResponse] = new(ListBatches)
+	var _ sinch.ListRequest = new(ListBatchesRequest)
+	var _ sinch.ListResponse[BatchSendResponse] = new(ListBatchesResponse)
+}
+
+func Test_ListBatchesRequest(t *testing.T) {
+	lbr := new(ListBatchesRequest).
+		WithPageSize(2).
+		From("12345", "67890").
+		Between(time.Date(2022, 7, 25, 0, 0, 0, 0, time.UTC), time.Time{})
+	assert.NoError(t, lbr.Validate())
+	qs, err := lbr.QueryString()
+	assert.NoError(t, err)
+	assert.Equal(t, "?from=12345%2C67890&page_size=2&start_date=2022-07-25T00%3A00%3A00.000Z", qs)
+
+	assert.ErrorIs(t, new(ListBatchesRequest).WithPageSize(101).Validate(), InvalidPageSizeError)
+	assert.ErrorIs(t, new(ListBatchesRequest).WithPage(-1).Validate(), InvalidPageError)
+}
+
+func Test_Client_ListBatches(t *testing.T) {
+	pages := map[string]string{
+		"0": `{"count": 3, "page": 0, "page_size": 2, "batches": [{"id": "a"}, {"id": "b"}]}`,
+		"1": `{"count": 3, "page": 1, "page_size": 1, "batches": [{"id": "c"}]}`,
+	}
+	var fetches int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		page := r.URL.Query().Get("page")
+		if page == "" {
+			page = "0"
+		}
+		resp, ok := pages[page]
+		if !ok {
+			t.Errorf("unexpected page %s", page)
+			resp = `{"count": 3, "page": 2, "page_size": 0, "batches": []}`
+		}
+		_, _ = w.Write([]byte(resp))
+	}))
+	defer srv.Close()
+
+	client := new(Client).
+		WithPlanID("plan").
+		WithAuthToken("token").
+		WithSinchAPI(new(api.Client).WithBaseURL(srv.URL).WithHTTPClient(srv.Client()))
+
+	batches, err := client.ListBatches(new(ListBatchesRequest).WithPageSize(2)).All(context.Background())
+	assert.NoError(t, err)
+	var ids []string
+	for _, batch := range batches {
+		ids = append(ids, batch.ID)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, ids)
+	assert.Equal(t, 2, fetches)
+}
+
+func Test_ListBatchesResponse_NextPageToken(t *testing.T) {
+	tests := map[string]struct {
+		response *ListBatchesResponse
+		want     string
+	}{
+		"full page":          {response: &ListBatchesResponse{Count: 5, PageSize: 2, Batches: make([]BatchSendResponse, 2), requestedPageSize: 2}, want: "1"},
+		"partial last page":  {response: &ListBatchesResponse{Count: 5, Page: 2, PageSize: 1, Batches: make([]BatchSendResponse, 1), requestedPageSize: 2}},
+		"full last page":     {response: &ListBatchesResponse{Count: 4, Page: 1, PageSize: 2, Batches: make([]BatchSendResponse, 2), requestedPageSize: 2}},
+		"empty page":         {response: &ListBatchesResponse{Count: 4, Page: 2}},
+		"default page size":  {response: &ListBatchesResponse{Count: 40, PageSize: 30, Batches: make([]BatchSendResponse, 30)}, want: "1"},
+		"short default page": {response: &ListBatchesResponse{Count: 40, PageSize: 10, Batches: make([]BatchSendResponse, 10)}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.response.NextPageToken())
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package sms
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Option configures a Client created with New.
+type Option = api.ServiceOption[*Client]
+
+// New returns a client for the US region that sends requests with api.DefaultHTTPClient unless configured otherwise.
+// It returns an error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	return api.NewService(WithRegion(RegionUS), opts...)
+}
+
+// WithHTTPClient sets the HTTP client used to send requests.
+var WithHTTPClient = api.WithServiceHTTPClient[*Client]
+
+// WithPlanID sets the service plan ID.
+func WithPlanID(planID string) Option {
+	return func(c *Client) {
+		c.WithPlanID(planID)
+	}
+}
+
+// WithAuthToken sets the API token of the service plan.
+func WithAuthToken(authToken string) Option {
+	return func(c *Client) {
+		c.WithAuthToken(authToken)
+	}
+}
+
+// WithProjectID makes the client use the project-based endpoints of the given project.
+func WithProjectID(projectID string) Option {
+	return func(c *Client) {
+		c.WithProjectID(projectID)
+	}
+}
+
+// WithAuthenticator authenticates requests with a instead of the auth token.
+func WithAuthenticator(a sinch.Authenticator) Option {
+	return func(c *Client) {
+		c.WithAuthenticator(a)
+	}
+}
+
+// WithTokenSource authenticates requests with OAuth2 access tokens from ts.
+func WithTokenSource(ts auth.TokenSource) Option {
+	return func(c *Client) {
+		c.WithTokenSource(ts)
+	}
+}
+
+// WithSinchAPI sends requests with sinchAPI, see Client.WithSinchAPI.
+func WithSinchAPI(sinchAPI *api.Client) Option {
+	return func(c *Client) {
+		c.WithSinchAPI(sinchAPI)
+	}
+}
+
+// WithBaseURL sets the base URL, e.g. EUBaseURLv1.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		api.OwnAPI(c).BaseURL = baseURL
+		c.Region = regionOf(baseURL)
+	}
+}
+
+// WithRegion sends requests to region r.
+func WithRegion(r Region) Option {
+	return func(c *Client) {
+		c.WithRegion(r)
+	}
+}
+
+// WithRegionCredentials sets the service plan ID and API token to use in region r.
+func WithRegionCredentials(r Region, planID, authToken string) Option {
+	return func(c *Client) {
+		c.WithRegionCredentials(r, planID, authToken)
+	}
+}
+
+// WithFailover retries requests that fail with a network error or a 5xx status code in the given regions, in order.
+func WithFailover(regions ...Region) Option {
+	return func(c *Client) {
+		c.WithFailover(regions...)
+	}
+}
+
+// WithFailoverHandler calls fn before a request is retried in another region.
+func WithFailoverHandler(fn func(FailoverEvent)) Option {
+	return func(c *Client) {
+		c.WithFailoverHandler(fn)
+	}
+}
+
+// WithRecipientFilter filters the recipients of batches through f before they are sent.
+func WithRecipientFilter(f RecipientFilter) Option {
+	return func(c *Client) {
+		c.WithRecipientFilter(f)
+	}
+}
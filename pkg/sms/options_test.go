@@ -0,0 +1,87 @@
+package sms
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+func Test_New(t *testing.T) {
+	shared, _ := api.New(api.WithBaseURL(AUBaseURLv1))
+	custom := &http.Client{}
+
+	tests := map[string]struct {
+		opts        []Option
+		wantBaseURL string
+		wantHTTP    *http.Client
+		wantErr     error
+	}{
+		"defaults": {
+			opts:        []Option{WithPlanID("plan"), WithAuthToken("token")},
+			wantBaseURL: USBaseURLv1,
+			wantHTTP:    api.DefaultHTTPClient,
+		},
+		"base url": {
+			opts:        []Option{WithPlanID("plan"), WithAuthToken("token"), WithBaseURL(EUBaseURLv1)},
+			wantBaseURL: EUBaseURLv1,
+			wantHTTP:    api.DefaultHTTPClient,
+		},
+		"shared api client": {
+			opts:        []Option{WithPlanID("plan"), WithAuthToken("token"), WithSinchAPI(shared)},
+			wantBaseURL: AUBaseURLv1,
+			wantHTTP:    api.DefaultHTTPClient,
+		},
+		"http client on shared api client": {
+			opts:        []Option{WithPlanID("plan"), WithAuthToken("token"), WithSinchAPI(shared), WithHTTPClient(custom)},
+			wantBaseURL: AUBaseURLv1,
+			wantHTTP:    custom,
+		},
+		"no plan id": {
+			opts:    []Option{WithAuthToken("token")},
+			wantErr: NoPlanIDError,
+		},
+		"no auth token": {
+			opts:    []Option{WithPlanID("plan")},
+			wantErr: NoAuthTokenError,
+		},
+		"no base url": {
+			opts:    []Option{WithPlanID("plan"), WithAuthToken("token"), WithBaseURL("")},
+			wantErr: api.NoBaseURLError,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, tt.wantBaseURL, got.API().BaseURL)
+			assert.Same(t, tt.wantHTTP, got.API().HTTPClient)
+		})
+	}
+
+	assert.Same(t, api.DefaultHTTPClient, shared.HTTPClient, "shared API client must not be changed")
+}
+
+func Test_Client_SharedSinchAPI(t *testing.T) {
+	shared, _ := api.New(api.WithBaseURL(EUBaseURLv1))
+	client := new(Client).WithSinchAPI(shared)
+	assert.Equal(t, RegionEU, client.Region)
+
+	client.AU()
+	assert.Equal(t, AUBaseURLv1, client.API().BaseURL)
+	assert.Equal(t, EUBaseURLv1, shared.BaseURL, "shared API client must not be changed")
+
+	shared.WithBaseURL(USBaseURLv1)
+	assert.Equal(t, AUBaseURLv1, client.API().BaseURL)
+}
+
+func Test_Client_DoWithoutSinchAPI(t *testing.T) {
+	client := new(Client).WithPlanID("plan").WithAuthToken("token")
+	assert.ErrorIs(t, client.Do(new(BatchSendRequest), new(BatchSendResponse)), api.NoBaseURLError)
+}
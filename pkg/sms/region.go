@@ -0,0 +1,128 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+// Region is a region the SMS API is hosted in. Service plans are provisioned per region.
+type Region string
+
+const (
+	RegionUS Region = "us"
+	RegionEU Region = "eu"
+	RegionAU Region = "au"
+	RegionBR Region = "br"
+	RegionCA Region = "ca"
+)
+
+// Regions lists every region of the SMS API.
+var Regions = []Region{RegionUS, RegionEU, RegionAU, RegionBR, RegionCA}
+
+// IsValid reports whether r is a known region.
+func (r Region) IsValid() bool {
+	for _, region := range Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// BaseURL returns the base URL of the region, e.g. EUBaseURLv1.
+func (r Region) BaseURL() string {
+	return "https://" + string(r) + BaseURLv1
+}
+
+// ProjectBaseURL returns the base URL of the project-based endpoints of the region, e.g. EUProjectBaseURLv1.
+func (r Region) ProjectBaseURL() string {
+	return "https://zt." + string(r) + BaseURLv1
+}
+
+// regionOf returns the region with the given base URL, or an empty region for custom base URLs.
+func regionOf(baseURL string) Region {
+	for _, r := range Regions {
+		if r.BaseURL() == baseURL {
+			return r
+		}
+	}
+	return ""
+}
+
+// PlanCredentials are the service plan ID and API token of a plan in one region.
+type PlanCredentials struct {
+	PlanID    string
+	AuthToken string
+}
+
+// FailoverEvent describes a request that failed in one region and is retried in another.
+type FailoverEvent struct {
+	From Region // The region the request failed in. Empty if the client uses a custom base URL.
+	To   Region // The region the request is retried in.
+	Err  error  // The error of the failed request.
+}
+
+// WithRegion makes the client send requests to region r. If credentials were set for r with WithRegionCredentials,
+// they replace the plan ID and auth token of the client.
+func (c *Client) WithRegion(r Region) *Client {
+	c.Region = r
+	if creds, ok := c.RegionCredentials[r]; ok {
+		c.PlanID = creds.PlanID
+		c.AuthToken = creds.AuthToken
+	}
+	api.OwnAPI(c).BaseURL = r.BaseURL()
+	return c
+}
+
+// WithRegionCredentials sets the service plan ID and API token to use in region r, for accounts with a separate plan in
+// each region.
+func (c *Client) WithRegionCredentials(r Region, planID, authToken string) *Client {
+	if c.RegionCredentials == nil {
+		c.RegionCredentials = make(map[Region]PlanCredentials)
+	}
+	c.RegionCredentials[r] = PlanCredentials{PlanID: planID, AuthToken: authToken}
+	if r == c.Region {
+		c.PlanID = planID
+		c.AuthToken = authToken
+	}
+	return c
+}
+
+// WithFailover makes the client retry requests that fail with a network error or a 5xx status code in the given
+// regions, in order, until one succeeds. The plan must be provisioned in every region, see WithRegionCredentials.
+// Requests are retried as is, so a batch whose response was lost may be sent twice.
+func (c *Client) WithFailover(regions ...Region) *Client {
+	c.FailoverRegions = regions
+	return c
+}
+
+// WithFailoverHandler makes the client call fn before a request is retried in another region, e.g. to alert on region
+// outages.
+func (c *Client) WithFailoverHandler(fn func(FailoverEvent)) *Client {
+	c.OnFailover = fn
+	return c
+}
+
+// inRegion returns a copy of the client that sends requests to region r without failover.
+func (c *Client) inRegion(r Region) *Client {
+	regional := *c
+	regional.FailoverRegions = nil
+	regional.WithRegion(r)
+	return &regional
+}
+
+// shouldFailover reports whether a request that failed with err may succeed in another region.
+func (c *Client) shouldFailover(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+	if code, ok := api.StatusCode(err); ok {
+		return code >= http.StatusInternalServerError
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
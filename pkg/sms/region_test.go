@@ -0,0 +1,151 @@
+package sms
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// regionTransport answers requests by the region in the host name with the given status code, or a network error if
+// the region has no status code.
+func regionTransport(statusCodes map[Region]int, requests *[]string) http.RoundTripper {
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		*requests = append(*requests, req.URL.Host+req.URL.Path+" "+req.Header.Get("Authorization"))
+		region := Region(strings.SplitN(req.URL.Host, ".", 2)[0])
+		code, ok := statusCodes[region]
+		if !ok {
+			return nil, errors.New("connection refused")
+		}
+		return &http.Response{
+			StatusCode: code,
+			Body:       io.NopCloser(strings.NewReader(`{"id":"batch"}`)),
+			Header:     make(http.Header),
+			Request:    req,
+		}, nil
+	})
+}
+
+func Test_Region(t *testing.T) {
+	assert.Equal(t, USBaseURLv1, RegionUS.BaseURL())
+	assert.Equal(t, EUBaseURLv1, RegionEU.BaseURL())
+	assert.Equal(t, AUBaseURLv1, RegionAU.BaseURL())
+	assert.Equal(t, BRBaseURLv1, RegionBR.BaseURL())
+	assert.Equal(t, "https://ca.sms.api.sinch.com/xms/v1", RegionCA.BaseURL())
+	assert.Equal(t, USProjectBaseURLv1, RegionUS.ProjectBaseURL())
+	assert.Equal(t, "https://zt.eu.sms.api.sinch.com/xms/v1", RegionEU.ProjectBaseURL())
+	assert.True(t, RegionCA.IsValid())
+	assert.False(t, Region("cn").IsValid())
+}
+
+func Test_Client_WithRegion(t *testing.T) {
+	c := new(Client).
+		WithPlanID("us-plan").
+		WithAuthToken("us-token").
+		WithRegionCredentials(RegionEU, "eu-plan", "eu-token").
+		EU()
+	assert.Equal(t, RegionEU, c.Region)
+	assert.Equal(t, EUBaseURLv1+"/eu-plan", c.URL())
+	assert.Equal(t, "eu-token", c.AuthToken)
+
+	c.WithRegionCredentials(RegionEU, "new-plan", "new-token")
+	assert.Equal(t, EUBaseURLv1+"/new-plan", c.URL())
+
+	c, err := New(WithPlanID("plan"), WithAuthToken("token"), WithBaseURL("http://localhost"))
+	assert.NoError(t, err)
+	assert.Empty(t, c.Region)
+	c, err = New(WithPlanID("plan"), WithAuthToken("token"), WithBaseURL(BRBaseURLv1))
+	assert.NoError(t, err)
+	assert.Equal(t, RegionBR, c.Region)
+
+	assert.ErrorIs(t, new(Client).WithPlanID("plan").WithAuthToken("token").WithRegion("cn").Validate(), InvalidRegionError)
+	assert.ErrorIs(t, new(Client).WithPlanID("plan").WithAuthToken("token").WithFailover("cn").Validate(), InvalidRegionError)
+}
+
+func Test_Client_Failover(t *testing.T) {
+	tests := map[string]struct {
+		statusCodes  map[Region]int
+		wantRequests []string
+		wantEvents   []FailoverEvent
+		wantErr      bool
+	}{
+		"primary succeeds": {
+			statusCodes:  map[Region]int{RegionUS: http.StatusCreated},
+			wantRequests: []string{"us.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token"},
+		},
+		"network error": {
+			statusCodes: map[Region]int{RegionEU: http.StatusCreated},
+			wantRequests: []string{
+				"us.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token",
+				"eu.sms.api.sinch.com/xms/v1/eu-plan/batches Bearer eu-token",
+			},
+			wantEvents: []FailoverEvent{{From: RegionUS, To: RegionEU}},
+		},
+		"server error in two regions": {
+			statusCodes: map[Region]int{
+				RegionUS: http.StatusServiceUnavailable,
+				RegionEU: http.StatusBadGateway,
+				RegionCA: http.StatusCreated,
+			},
+			wantRequests: []string{
+				"us.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token",
+				"eu.sms.api.sinch.com/xms/v1/eu-plan/batches Bearer eu-token",
+				"ca.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token",
+			},
+			wantEvents: []FailoverEvent{{From: RegionUS, To: RegionEU}, {From: RegionEU, To: RegionCA}},
+		},
+		"client error is not retried": {
+			statusCodes:  map[Region]int{RegionUS: http.StatusBadRequest, RegionEU: http.StatusCreated},
+			wantRequests: []string{"us.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token"},
+			wantErr:      true,
+		},
+		"every region fails": {
+			statusCodes: map[Region]int{RegionUS: http.StatusInternalServerError},
+			wantRequests: []string{
+				"us.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token",
+				"eu.sms.api.sinch.com/xms/v1/eu-plan/batches Bearer eu-token",
+				"ca.sms.api.sinch.com/xms/v1/us-plan/batches Bearer us-token",
+			},
+			wantEvents: []FailoverEvent{{From: RegionUS, To: RegionEU}, {From: RegionEU, To: RegionCA}},
+			wantErr:    true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			var requests []string
+			var events []FailoverEvent
+			httpClient := &http.Client{Transport: regionTransport(tt.statusCodes, &requests)}
+			c, err := New(
+				WithPlanID("us-plan"),
+				WithAuthToken("us-token"),
+				WithHTTPClient(httpClient),
+				WithRegionCredentials(RegionEU, "eu-plan", "eu-token"),
+				WithFailover(RegionEU, RegionCA),
+				WithFailoverHandler(func(e FailoverEvent) {
+					assert.Error(t, e.Err)
+					e.Err = nil
+					events = append(events, e)
+				}),
+			)
+			assert.NoError(t, err)
+
+			req := new(BatchSendRequest).To("15551231234").From("15551234321").WithMessageBody("hello")
+			err = c.Do(req, new(BatchSendResponse))
+			assert.Equal(t, tt.wantErr, err != nil, err)
+			assert.Equal(t, tt.wantRequests, requests)
+			assert.Equal(t, tt.wantEvents, events)
+			assert.Equal(t, RegionUS, c.Region)
+			assert.Same(t, httpClient, c.API().HTTPClient)
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package tendlc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"regexp"
+
+	"github.com/google/go-querystring/query"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+var country = regexp.MustCompile(`^[A-Z]{2}$`)
+
+// Brand is the company or person that sends the messages of campaigns. Brands are verified with the EIN or, for sole
+// proprietors, the contact details they are registered with.
+type Brand struct {
+	ID             string      `json:"brandRegistrationId,omitempty"` // Set by the API.
+	TCRBrandID     string      `json:"tcrBrandId,omitempty"`          // The brand ID of The Campaign Registry, set once verified.
+	EntityType     EntityType  `json:"entityType"`
+	DisplayName    string      `json:"displayName"`
+	CompanyName    string      `json:"companyName,omitempty"`
+	FirstName      string      `json:"firstName,omitempty"`
+	LastName       string      `json:"lastName,omitempty"`
+	EIN            string      `json:"ein,omitempty"`
+	EINCountry     string      `json:"einIssuingCountry,omitempty"`
+	StockSymbol    string      `json:"stockSymbol,omitempty"`
+	StockExchange  string      `json:"stockExchange,omitempty"` // e.g. NASDAQ or NYSE.
+	Vertical       Vertical    `json:"vertical"`
+	Website        string      `json:"website,omitempty"`
+	Email          string      `json:"email"`
+	Phone          string      `json:"phone,omitempty"`
+	Street         string      `json:"street,omitempty"`
+	City           string      `json:"city,omitempty"`
+	State          string      `json:"state,omitempty"`
+	PostalCode     string      `json:"postalCode,omitempty"`
+	Country        string      `json:"country"`
+	Status         BrandStatus `json:"identityStatus,omitempty"` // Set by the API.
+	FailureReasons []string    `json:"failureReasons,omitempty"` // Set by the API when Status is UNVERIFIED.
+	CreateTime     *sinch.Time `json:"createTime,omitempty"`
+	UpdateTime     *sinch.Time `json:"updateTime,omitempty"`
+}
+
+// NewCompanyBrand returns a brand of a company registered with its EIN.
+func NewCompanyBrand(entityType EntityType, companyName, ein string) *Brand {
+	return &Brand{EntityType: entityType, DisplayName: companyName, CompanyName: companyName, EIN: ein, EINCountry: "US", Country: "US"}
+}
+
+// NewSoleProprietorBrand returns a brand of a person without an EIN.
+func NewSoleProprietorBrand(firstName, lastName string) *Brand {
+	return &Brand{EntityType: EntitySoleProprietor, DisplayName: firstName + " " + lastName, FirstName: firstName, LastName: lastName, Country: "US"}
+}
+
+func (b *Brand) WithDisplayName(displayName string) *Brand {
+	b.DisplayName = displayName
+	return b
+}
+
+func (b *Brand) WithVertical(vertical Vertical) *Brand {
+	b.Vertical = vertical
+	return b
+}
+
+// WithStock sets the stock symbol and exchange of a public company.
+func (b *Brand) WithStock(symbol, exchange string) *Brand {
+	b.StockSymbol = symbol
+	b.StockExchange = exchange
+	return b
+}
+
+func (b *Brand) WithContact(email, phone, website string) *Brand {
+	b.Email = email
+	b.Phone = phone
+	b.Website = website
+	return b
+}
+
+func (b *Brand) WithAddress(street, city, state, postalCode, country string) *Brand {
+	b.Street = street
+	b.City = city
+	b.State = state
+	b.PostalCode = postalCode
+	b.Country = country
+	return b
+}
+
+func (b *Brand) validate() error {
+	if !b.EntityType.IsValid() {
+		return InvalidEntityTypeError
+	}
+	if b.EntityType == EntitySoleProprietor {
+		if b.FirstName == "" || b.LastName == "" {
+			return ContactNameRequiredError
+		}
+	} else {
+		if b.CompanyName == "" {
+			return CompanyNameRequiredError
+		}
+		if b.EIN == "" {
+			return EINRequiredError
+		}
+	}
+	if b.EntityType == EntityPublicProfit && (b.StockSymbol == "" || b.StockExchange == "") {
+		return StockSymbolRequiredError
+	}
+	if !b.Vertical.IsValid() {
+		return InvalidVerticalError
+	}
+	if b.Email == "" {
+		return EmailRequiredError
+	}
+	if b.Phone != "" && !sinch.IsE164(b.Phone) {
+		return InvalidPhoneError
+	}
+	if !country.MatchString(b.Country) {
+		return InvalidCountryError
+	}
+	return nil
+}
+
+func (b *Brand) FromJSON(data []byte) error {
+	return json.Unmarshal(data, b)
+}
+
+func queryString(v interface{}) (string, error) {
+	values, err := query.Values(v)
+	if err != nil {
+		return "", err
+	}
+	if len(values) == 0 {
+		return "", nil
+	}
+	return "?" + values.Encode(), nil
+}
+
+type SubmitBrandAction struct {
+	request  *SubmitBrandRequest
+	response *Brand
+}
+
+func (sba *SubmitBrandAction) Request() *SubmitBrandRequest {
+	return sba.request
+}
+
+func (sba *SubmitBrandAction) Response() *Brand {
+	return sba.response
+}
+
+// SubmitBrandRequest registers a brand and submits it for identity verification. The response is the brand with its
+// registration ID and a pending status, see GetBrandRequest.
+type SubmitBrandRequest struct {
+	Brand *Brand
+}
+
+func (sbr *SubmitBrandRequest) WithBrand(brand *Brand) *SubmitBrandRequest {
+	sbr.Brand = brand
+	return sbr
+}
+
+func (sbr *SubmitBrandRequest) Validate() error {
+	if sbr.Brand == nil {
+		return BrandRequiredError
+	}
+	return sbr.Brand.validate()
+}
+
+func (sbr *SubmitBrandRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (sbr *SubmitBrandRequest) Method() string {
+	return http.MethodPost
+}
+
+func (sbr *SubmitBrandRequest) Path() string {
+	return "/brandRegistrations:submit"
+}
+
+func (sbr *SubmitBrandRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (sbr *SubmitBrandRequest) Body() ([]byte, error) {
+	return json.Marshal(sbr.Brand)
+}
+
+type GetBrandAction struct {
+	request  *GetBrandRequest
+	response *Brand
+}
+
+func (gba *GetBrandAction) Request() *GetBrandRequest {
+	return gba.request
+}
+
+func (gba *GetBrandAction) Response() *Brand {
+	return gba.response
+}
+
+// GetBrandRequest fetches a brand with its verification status.
+type GetBrandRequest struct {
+	BrandID string
+}
+
+func (gbr *GetBrandRequest) WithBrandID(brandID string) *GetBrandRequest {
+	gbr.BrandID = brandID
+	return gbr
+}
+
+func (gbr *GetBrandRequest) Validate() error {
+	if gbr.BrandID == "" {
+		return BrandIDRequiredError
+	}
+	return nil
+}
+
+func (gbr *GetBrandRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gbr *GetBrandRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gbr *GetBrandRequest) Path() string {
+	return "/brandRegistrations/" + url.PathEscape(gbr.BrandID)
+}
+
+func (gbr *GetBrandRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gbr *GetBrandRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListBrandsAction struct {
+	request  *ListBrandsRequest
+	response *ListBrandsResponse
+}
+
+func (lba *ListBrandsAction) Request() *ListBrandsRequest {
+	return lba.request
+}
+
+func (lba *ListBrandsAction) Response() *ListBrandsResponse {
+	return lba.response
+}
+
+// ListBrandsRequest lists the brands of the project.
+type ListBrandsRequest struct {
+	Status    BrandStatus `url:"identityStatus,omitempty"`
+	PageSize  int         `url:"pageSize,omitempty"`
+	PageToken string      `url:"pageToken,omitempty"`
+}
+
+type ListBrandsResponse struct {
+	Brands    []Brand `json:"brandRegistrations"`
+	NextPage  string  `json:"nextPageToken"`
+	TotalSize int     `json:"totalSize"`
+}
+
+func (lbr *ListBrandsRequest) WithStatus(status BrandStatus) *ListBrandsRequest {
+	lbr.Status = status
+	return lbr
+}
+
+func (lbr *ListBrandsRequest) WithPageSize(pageSize int) *ListBrandsRequest {
+	lbr.PageSize = pageSize
+	return lbr
+}
+
+func (lbr *ListBrandsRequest) SetPageToken(token string) {
+	lbr.PageToken = token
+}
+
+func (lbr *ListBrandsRequest) Validate() error {
+	return nil
+}
+
+func (lbr *ListBrandsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lbr *ListBrandsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lbr *ListBrandsRequest) Path() string {
+	return "/brandRegistrations"
+}
+
+func (lbr *ListBrandsRequest) QueryString() (string, error) {
+	return queryString(lbr)
+}
+
+func (lbr *ListBrandsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lbr *ListBrandsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lbr)
+}
+
+func (lbr *ListBrandsResponse) Items() []Brand {
+	return lbr.Brands
+}
+
+func (lbr *ListBrandsResponse) NextPageToken() string {
+	return lbr.NextPage
+}
+
+type QualifyBrandAction struct {
+	request  *QualifyBrandRequest
+	response *Qualification
+}
+
+func (qba *QualifyBrandAction) Request() *QualifyBrandRequest {
+	return qba.request
+}
+
+func (qba *QualifyBrandAction) Response() *Qualification {
+	return qba.response
+}
+
+// QualifyBrandRequest checks whether a verified brand may register campaigns of a use case, and with which carrier
+// limits, before a campaign is submitted. The response is a Qualification.
+type QualifyBrandRequest struct {
+	BrandID string  `json:"-"`
+	UseCase UseCase `json:"useCase"`
+}
+
+// Qualification is the result of a QualifyBrandRequest.
+type Qualification struct {
+	UseCase      UseCase                 `json:"useCase"`
+	Qualified    bool                    `json:"qualified"`
+	Reason       string                  `json:"reason,omitempty"` // Set when the brand does not qualify.
+	CarrierTerms map[string]CarrierTerms `json:"carrierTerms,omitempty"`
+}
+
+// CarrierTerms are the limits a carrier applies to the campaigns of a brand, like ATT or T-MOBILE.
+type CarrierTerms struct {
+	Qualified           bool   `json:"qualified"`
+	MessageClass        string `json:"messageClass,omitempty"`
+	ThroughputPerMinute int    `json:"tpm,omitempty"`
+	DailyCap            int    `json:"dailyCap,omitempty"`
+}
+
+func (qbr *QualifyBrandRequest) WithBrandID(brandID string) *QualifyBrandRequest {
+	qbr.BrandID = brandID
+	return qbr
+}
+
+func (qbr *QualifyBrandRequest) WithUseCase(useCase UseCase) *QualifyBrandRequest {
+	qbr.UseCase = useCase
+	return qbr
+}
+
+func (qbr *QualifyBrandRequest) Validate() error {
+	if qbr.BrandID == "" {
+		return BrandIDRequiredError
+	}
+	if !qbr.UseCase.IsValid() {
+		return InvalidUseCaseError
+	}
+	return nil
+}
+
+func (qbr *QualifyBrandRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (qbr *QualifyBrandRequest) Method() string {
+	return http.MethodPost
+}
+
+func (qbr *QualifyBrandRequest) Path() string {
+	return "/brandRegistrations/" + url.PathEscape(qbr.BrandID) + ":qualify"
+}
+
+func (qbr *QualifyBrandRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (qbr *QualifyBrandRequest) Body() ([]byte, error) {
+	return json.Marshal(qbr)
+}
+
+func (q *Qualification) FromJSON(data []byte) error {
+	return json.Unmarshal(data, q)
+}
@@ -0,0 +1,125 @@
+package tendlc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Brands_Implementations(t *testing.T) {
+	var _ sinch.Action[*SubmitBrandRequest, *Brand] = new(SubmitBrandAction)
+	var _ sinch.Action[*GetBrandRequest, *Brand] = new(GetBrandAction)
+	var _ sinch.Action[*ListBrandsRequest, *ListBrandsResponse] = new(ListBrandsAction)
+	var _ sinch.ListRequest = new(ListBrandsRequest)
+	var _ sinch.ListResponse[Brand] = new(ListBrandsResponse)
+	var _ sinch.Action[*QualifyBrandRequest, *Qualification] = new(QualifyBrandAction)
+}
+
+func validBrand() *Brand {
+	return NewCompanyBrand(EntityPrivateProfit, "Acme Inc", "12-3456789").
+		WithVertical(VerticalRetail).
+		WithContact("ops@acme.example", "+12025550100", "https://acme.example").
+		WithAddress("1 Main St", "Springfield", "IL", "62701", "US")
+}
+
+func Test_SubmitBrandRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		req     *SubmitBrandRequest
+		wantErr error
+	}{
+		"no brand":             {req: new(SubmitBrandRequest), wantErr: BrandRequiredError},
+		"invalid entity type":  {req: new(SubmitBrandRequest).WithBrand(NewCompanyBrand("LLC", "Acme Inc", "12-3456789")), wantErr: InvalidEntityTypeError},
+		"missing ein":          {req: new(SubmitBrandRequest).WithBrand(NewCompanyBrand(EntityPrivateProfit, "Acme Inc", "")), wantErr: EINRequiredError},
+		"public without stock": {req: new(SubmitBrandRequest).WithBrand(NewCompanyBrand(EntityPublicProfit, "Acme Inc", "12-3456789")), wantErr: StockSymbolRequiredError},
+		"sole proprietor name": {req: new(SubmitBrandRequest).WithBrand(NewSoleProprietorBrand("Ada", "")), wantErr: ContactNameRequiredError},
+		"invalid vertical":     {req: new(SubmitBrandRequest).WithBrand(validBrand().WithVertical("CRYPTO")), wantErr: InvalidVerticalError},
+		"missing email":        {req: new(SubmitBrandRequest).WithBrand(validBrand().WithContact("", "", "")), wantErr: EmailRequiredError},
+		"invalid phone":        {req: new(SubmitBrandRequest).WithBrand(validBrand().WithContact("ops@acme.example", "2025550100", "")), wantErr: InvalidPhoneError},
+		"invalid country":      {req: new(SubmitBrandRequest).WithBrand(validBrand().WithAddress("", "", "", "", "USA")), wantErr: InvalidCountryError},
+		"company":              {req: new(SubmitBrandRequest).WithBrand(validBrand())},
+		"public company":       {req: new(SubmitBrandRequest).WithBrand(validBrand().WithStock("ACME", "NASDAQ"))},
+		"sole proprietor":      {req: new(SubmitBrandRequest).WithBrand(NewSoleProprietorBrand("Ada", "Lovelace").WithVertical(VerticalProfessional).WithContact("ada@example.com", "", ""))},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func Test_BrandRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+	}{
+		"submit": {
+			req:        new(SubmitBrandRequest).WithBrand(validBrand()),
+			wantMethod: http.MethodPost,
+			wantPath:   "/brandRegistrations:submit",
+		},
+		"get without id": {
+			req:     new(GetBrandRequest),
+			wantErr: BrandIDRequiredError,
+		},
+		"get": {
+			req:        new(GetBrandRequest).WithBrandID("brand"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/brandRegistrations/brand",
+		},
+		"list": {
+			req:        new(ListBrandsRequest).WithStatus(BrandStatusVerified).WithPageSize(10),
+			wantMethod: http.MethodGet,
+			wantPath:   "/brandRegistrations",
+			wantQuery:  "?identityStatus=VERIFIED&pageSize=10",
+		},
+		"qualify without use case": {
+			req:     new(QualifyBrandRequest).WithBrandID("brand"),
+			wantErr: InvalidUseCaseError,
+		},
+		"qualify": {
+			req:        new(QualifyBrandRequest).WithBrandID("brand").WithUseCase(UseCaseMarketing),
+			wantMethod: http.MethodPost,
+			wantPath:   "/brandRegistrations/brand:qualify",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+		})
+	}
+}
+
+func Test_Brand_Body(t *testing.T) {
+	body, err := new(SubmitBrandRequest).WithBrand(NewSoleProprietorBrand("Ada", "Lovelace").WithVertical(VerticalProfessional).WithContact("ada@example.com", "", "")).Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"entityType":"SOLE_PROPRIETOR","displayName":"Ada Lovelace","firstName":"Ada","lastName":"Lovelace",
+		"vertical":"PROFESSIONAL","email":"ada@example.com","country":"US"}`, string(body))
+
+	body, err = new(QualifyBrandRequest).WithBrandID("brand").WithUseCase(UseCase2FA).Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"useCase":"2FA"}`, string(body))
+}
+
+func Test_Qualification_FromJSON(t *testing.T) {
+	q := new(Qualification)
+	assert.NoError(t, q.FromJSON([]byte(`{"useCase": "MARKETING", "qualified": true,
+		"carrierTerms": {"ATT": {"qualified": true, "messageClass": "E", "tpm": 240}, "T-MOBILE": {"qualified": true, "dailyCap": 2000}}}`)))
+	assert.True(t, q.Qualified)
+	assert.Equal(t, 240, q.CarrierTerms["ATT"].ThroughputPerMinute)
+	assert.Equal(t, 2000, q.CarrierTerms["T-MOBILE"].DailyCap)
+}
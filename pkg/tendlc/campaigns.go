@@ -0,0 +1,329 @@
+package tendlc
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Campaign describes the messages a brand sends for a use case. Carriers review the campaign before numbers linked to
+// it may send messages.
+type Campaign struct {
+	ID               string         `json:"campaignRegistrationId,omitempty"` // Set by the API.
+	CampaignID       string         `json:"campaignId,omitempty"`             // The TCR campaign ID, set once approved. Used as the campaign ID of numbers and batches.
+	BrandID          string         `json:"brandRegistrationId"`
+	UseCase          UseCase        `json:"useCase"`
+	SubUseCases      []UseCase      `json:"subUseCases,omitempty"`
+	Description      string         `json:"description"`
+	MessageFlow      string         `json:"messageFlow"` // How recipients opt in, e.g. a web form with a consent checkbox.
+	SampleMessages   []string       `json:"sampleMessages"`
+	HelpMessage      string         `json:"helpMessage,omitempty"`
+	OptInMessage     string         `json:"optInMessage,omitempty"`
+	OptOutMessage    string         `json:"optOutMessage,omitempty"`
+	OptInKeywords    []string       `json:"optInKeywords,omitempty"`
+	OptOutKeywords   []string       `json:"optOutKeywords,omitempty"`
+	HelpKeywords     []string       `json:"helpKeywords,omitempty"`
+	EmbeddedLink     bool           `json:"embeddedLink"`
+	EmbeddedPhone    bool           `json:"embeddedPhone"`
+	NumberPool       bool           `json:"numberPool"`
+	AgeGated         bool           `json:"ageGated"`
+	DirectLending    bool           `json:"directLending"`
+	Status           CampaignStatus `json:"status,omitempty"`           // Set by the API.
+	RejectionReasons []string       `json:"rejectionReasons,omitempty"` // Set by the API when Status is REJECTED.
+	CreateTime       *sinch.Time    `json:"createTime,omitempty"`
+	UpdateTime       *sinch.Time    `json:"updateTime,omitempty"`
+}
+
+// NewCampaign returns a campaign of a brand with the default opt-out and help keywords.
+func NewCampaign(brandID string, useCase UseCase, description string) *Campaign {
+	return &Campaign{
+		BrandID:        brandID,
+		UseCase:        useCase,
+		Description:    description,
+		OptOutKeywords: []string{"STOP"},
+		HelpKeywords:   []string{"HELP"},
+	}
+}
+
+func (c *Campaign) WithSubUseCases(useCases ...UseCase) *Campaign {
+	c.SubUseCases = append(c.SubUseCases, useCases...)
+	return c
+}
+
+func (c *Campaign) WithMessageFlow(messageFlow string) *Campaign {
+	c.MessageFlow = messageFlow
+	return c
+}
+
+func (c *Campaign) WithSampleMessages(messages ...string) *Campaign {
+	c.SampleMessages = append(c.SampleMessages, messages...)
+	return c
+}
+
+// WithOptIn sets the keywords recipients opt in with and the confirmation they receive.
+func (c *Campaign) WithOptIn(message string, keywords ...string) *Campaign {
+	c.OptInMessage = message
+	c.OptInKeywords = keywords
+	return c
+}
+
+// WithOptOut sets the confirmation recipients receive when they opt out, and replaces the default keywords if any are
+// given.
+func (c *Campaign) WithOptOut(message string, keywords ...string) *Campaign {
+	c.OptOutMessage = message
+	if len(keywords) > 0 {
+		c.OptOutKeywords = keywords
+	}
+	return c
+}
+
+// WithHelp sets the reply to help requests, and replaces the default keywords if any are given.
+func (c *Campaign) WithHelp(message string, keywords ...string) *Campaign {
+	c.HelpMessage = message
+	if len(keywords) > 0 {
+		c.HelpKeywords = keywords
+	}
+	return c
+}
+
+// WithContent declares whether the messages contain links or phone numbers.
+func (c *Campaign) WithContent(embeddedLink, embeddedPhone bool) *Campaign {
+	c.EmbeddedLink = embeddedLink
+	c.EmbeddedPhone = embeddedPhone
+	return c
+}
+
+// Approved returns true if numbers can be linked to the campaign.
+func (c *Campaign) Approved() bool {
+	return c.Status == CampaignStatusApproved && c.CampaignID != ""
+}
+
+func (c *Campaign) validate() error {
+	if c.BrandID == "" {
+		return BrandIDRequiredError
+	}
+	if !c.UseCase.IsValid() {
+		return InvalidUseCaseError
+	}
+	if err := c.validateSubUseCases(); err != nil {
+		return err
+	}
+	if c.Description == "" {
+		return DescriptionRequiredError
+	}
+	if c.MessageFlow == "" {
+		return MessageFlowRequiredError
+	}
+	if len(c.SampleMessages) < 1 || len(c.SampleMessages) > 5 {
+		return InvalidSampleMessagesError
+	}
+	return nil
+}
+
+func (c *Campaign) validateSubUseCases() error {
+	minCount, maxCount := 0, 0
+	switch c.UseCase {
+	case UseCaseMixed:
+		minCount, maxCount = 2, 5
+	case UseCaseLowVolume:
+		minCount, maxCount = 1, 5
+	}
+	if len(c.SubUseCases) < minCount || len(c.SubUseCases) > maxCount {
+		return InvalidSubUseCasesError
+	}
+	for _, useCase := range c.SubUseCases {
+		if !useCase.IsStandard() {
+			return InvalidSubUseCasesError
+		}
+	}
+	return nil
+}
+
+func (c *Campaign) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+type SubmitCampaignAction struct {
+	request  *SubmitCampaignRequest
+	response *Campaign
+}
+
+func (sca *SubmitCampaignAction) Request() *SubmitCampaignRequest {
+	return sca.request
+}
+
+func (sca *SubmitCampaignAction) Response() *Campaign {
+	return sca.response
+}
+
+// SubmitCampaignRequest registers a campaign of a verified brand and submits it for carrier review. The response is the
+// campaign with its registration ID and a pending status, see GetCampaignRequest.
+type SubmitCampaignRequest struct {
+	Campaign *Campaign
+}
+
+func (scr *SubmitCampaignRequest) WithCampaign(campaign *Campaign) *SubmitCampaignRequest {
+	scr.Campaign = campaign
+	return scr
+}
+
+func (scr *SubmitCampaignRequest) Validate() error {
+	if scr.Campaign == nil {
+		return CampaignRequiredError
+	}
+	return scr.Campaign.validate()
+}
+
+func (scr *SubmitCampaignRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (scr *SubmitCampaignRequest) Method() string {
+	return http.MethodPost
+}
+
+func (scr *SubmitCampaignRequest) Path() string {
+	return "/campaignRegistrations:submit"
+}
+
+func (scr *SubmitCampaignRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (scr *SubmitCampaignRequest) Body() ([]byte, error) {
+	return json.Marshal(scr.Campaign)
+}
+
+type GetCampaignAction struct {
+	request  *GetCampaignRequest
+	response *Campaign
+}
+
+func (gca *GetCampaignAction) Request() *GetCampaignRequest {
+	return gca.request
+}
+
+func (gca *GetCampaignAction) Response() *Campaign {
+	return gca.response
+}
+
+// GetCampaignRequest fetches a campaign with its review status.
+type GetCampaignRequest struct {
+	CampaignID string // The registration ID of the campaign.
+}
+
+func (gcr *GetCampaignRequest) WithCampaignID(campaignID string) *GetCampaignRequest {
+	gcr.CampaignID = campaignID
+	return gcr
+}
+
+func (gcr *GetCampaignRequest) Validate() error {
+	if gcr.CampaignID == "" {
+		return CampaignIDRequiredError
+	}
+	return nil
+}
+
+func (gcr *GetCampaignRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (gcr *GetCampaignRequest) Method() string {
+	return http.MethodGet
+}
+
+func (gcr *GetCampaignRequest) Path() string {
+	return "/campaignRegistrations/" + url.PathEscape(gcr.CampaignID)
+}
+
+func (gcr *GetCampaignRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (gcr *GetCampaignRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+type ListCampaignsAction struct {
+	request  *ListCampaignsRequest
+	response *ListCampaignsResponse
+}
+
+func (lca *ListCampaignsAction) Request() *ListCampaignsRequest {
+	return lca.request
+}
+
+func (lca *ListCampaignsAction) Response() *ListCampaignsResponse {
+	return lca.response
+}
+
+// ListCampaignsRequest lists the campaigns of the project, optionally only those of a brand or with a status.
+type ListCampaignsRequest struct {
+	BrandID   string         `url:"brandRegistrationId,omitempty"`
+	Status    CampaignStatus `url:"status,omitempty"`
+	PageSize  int            `url:"pageSize,omitempty"`
+	PageToken string         `url:"pageToken,omitempty"`
+}
+
+type ListCampaignsResponse struct {
+	Campaigns []Campaign `json:"campaignRegistrations"`
+	NextPage  string     `json:"nextPageToken"`
+	TotalSize int        `json:"totalSize"`
+}
+
+func (lcr *ListCampaignsRequest) WithBrandID(brandID string) *ListCampaignsRequest {
+	lcr.BrandID = brandID
+	return lcr
+}
+
+func (lcr *ListCampaignsRequest) WithStatus(status CampaignStatus) *ListCampaignsRequest {
+	lcr.Status = status
+	return lcr
+}
+
+func (lcr *ListCampaignsRequest) WithPageSize(pageSize int) *ListCampaignsRequest {
+	lcr.PageSize = pageSize
+	return lcr
+}
+
+func (lcr *ListCampaignsRequest) SetPageToken(token string) {
+	lcr.PageToken = token
+}
+
+func (lcr *ListCampaignsRequest) Validate() error {
+	return nil
+}
+
+func (lcr *ListCampaignsRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (lcr *ListCampaignsRequest) Method() string {
+	return http.MethodGet
+}
+
+func (lcr *ListCampaignsRequest) Path() string {
+	return "/campaignRegistrations"
+}
+
+func (lcr *ListCampaignsRequest) QueryString() (string, error) {
+	return queryString(lcr)
+}
+
+func (lcr *ListCampaignsRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (lcr *ListCampaignsResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, lcr)
+}
+
+func (lcr *ListCampaignsResponse) Items() []Campaign {
+	return lcr.Campaigns
+}
+
+func (lcr *ListCampaignsResponse) NextPageToken() string {
+	return lcr.NextPage
+}
@@ -0,0 +1,121 @@
+package tendlc
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Campaigns_Implementations(t *testing.T) {
+	var _ sinch.Action[*SubmitCampaignRequest, *Campaign] = new(SubmitCampaignAction)
+	var _ sinch.Action[*GetCampaignRequest, *Campaign] = new(GetCampaignAction)
+	var _ sinch.Action[*ListCampaignsRequest, *ListCampaignsResponse] = new(ListCampaignsAction)
+	var _ sinch.ListRequest = new(ListCampaignsRequest)
+	var _ sinch.ListResponse[Campaign] = new(ListCampaignsResponse)
+}
+
+func validCampaign(useCase UseCase) *Campaign {
+	return NewCampaign("brand", useCase, "Order updates for Acme customers").
+		WithMessageFlow("Customers opt in with a checkbox at checkout").
+		WithSampleMessages("Acme: your order 1234 has shipped. Reply STOP to opt out.")
+}
+
+func Test_SubmitCampaignRequest_Validate(t *testing.T) {
+	tests := map[string]struct {
+		req     *SubmitCampaignRequest
+		wantErr error
+	}{
+		"no campaign":               {req: new(SubmitCampaignRequest), wantErr: CampaignRequiredError},
+		"no brand":                  {req: new(SubmitCampaignRequest).WithCampaign(NewCampaign("", UseCase2FA, "codes")), wantErr: BrandIDRequiredError},
+		"unknown use case":          {req: new(SubmitCampaignRequest).WithCampaign(validCampaign("SPAM")), wantErr: InvalidUseCaseError},
+		"mixed with one sub":        {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseMixed).WithSubUseCases(UseCase2FA)), wantErr: InvalidSubUseCasesError},
+		"low volume without sub":    {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseLowVolume)), wantErr: InvalidSubUseCasesError},
+		"special sub use case":      {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseLowVolume).WithSubUseCases(UseCaseCharity)), wantErr: InvalidSubUseCasesError},
+		"sub use cases on standard": {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCase2FA).WithSubUseCases(UseCaseMarketing)), wantErr: InvalidSubUseCasesError},
+		"no description":            {req: new(SubmitCampaignRequest).WithCampaign(NewCampaign("brand", UseCase2FA, "")), wantErr: DescriptionRequiredError},
+		"no message flow":           {req: new(SubmitCampaignRequest).WithCampaign(NewCampaign("brand", UseCase2FA, "codes")), wantErr: MessageFlowRequiredError},
+		"no sample messages":        {req: new(SubmitCampaignRequest).WithCampaign(NewCampaign("brand", UseCase2FA, "codes").WithMessageFlow("app signup")), wantErr: InvalidSampleMessagesError},
+		"too many sample messages":  {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCase2FA).WithSampleMessages("2", "3", "4", "5", "6")), wantErr: InvalidSampleMessagesError},
+		"standard":                  {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseDeliveryNotification))},
+		"mixed":                     {req: new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseMixed).WithSubUseCases(UseCase2FA, UseCaseMarketing))},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func Test_CampaignRequests(t *testing.T) {
+	tests := map[string]struct {
+		req        sinch.APIRequest
+		wantErr    error
+		wantMethod string
+		wantPath   string
+		wantQuery  string
+	}{
+		"submit": {
+			req:        new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCase2FA)),
+			wantMethod: http.MethodPost,
+			wantPath:   "/campaignRegistrations:submit",
+		},
+		"get without id": {
+			req:     new(GetCampaignRequest),
+			wantErr: CampaignIDRequiredError,
+		},
+		"get": {
+			req:        new(GetCampaignRequest).WithCampaignID("campaign"),
+			wantMethod: http.MethodGet,
+			wantPath:   "/campaignRegistrations/campaign",
+		},
+		"list": {
+			req:        new(ListCampaignsRequest).WithBrandID("brand").WithStatus(CampaignStatusApproved),
+			wantMethod: http.MethodGet,
+			wantPath:   "/campaignRegistrations",
+			wantQuery:  "?brandRegistrationId=brand&status=APPROVED",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, tt.wantMethod, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+			query, err := tt.req.QueryString()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantQuery, query)
+		})
+	}
+}
+
+func Test_Campaign_Body(t *testing.T) {
+	body, err := new(SubmitCampaignRequest).WithCampaign(validCampaign(UseCaseMixed).
+		WithSubUseCases(UseCase2FA, UseCaseMarketing).
+		WithOptOut("You are unsubscribed.").
+		WithHelp("Acme support: help@acme.example", "HELP", "INFO").
+		WithContent(true, false)).Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"brandRegistrationId":"brand","useCase":"MIXED","subUseCases":["2FA","MARKETING"],
+		"description":"Order updates for Acme customers","messageFlow":"Customers opt in with a checkbox at checkout",
+		"sampleMessages":["Acme: your order 1234 has shipped. Reply STOP to opt out."],
+		"helpMessage":"Acme support: help@acme.example","optOutMessage":"You are unsubscribed.",
+		"optOutKeywords":["STOP"],"helpKeywords":["HELP","INFO"],
+		"embeddedLink":true,"embeddedPhone":false,"numberPool":false,"ageGated":false,"directLending":false}`, string(body))
+}
+
+func Test_Campaign_Approved(t *testing.T) {
+	c := new(Campaign)
+	assert.NoError(t, c.FromJSON([]byte(`{"campaignRegistrationId": "registration", "campaignId": "CABC123", "status": "APPROVED"}`)))
+	assert.True(t, c.Approved())
+
+	c = new(Campaign)
+	assert.NoError(t, c.FromJSON([]byte(`{"campaignRegistrationId": "registration", "status": "REJECTED", "rejectionReasons": ["Sample messages do not match the use case"]}`)))
+	assert.False(t, c.Approved())
+	assert.Len(t, c.RejectionReasons, 1)
+}
@@ -0,0 +1,29 @@
+// Package tendlc is a client for the Sinch 10DLC registration API, which registers the brands and campaigns US carriers
+// require for application-to-person SMS on 10 digit long codes. Numbers are linked to an approved campaign with
+// LinkNumberRequest, and its campaign ID is then used by sms.BatchSendRequest.
+package tendlc
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Client struct {
+	api.ProjectClient
+}
+
+const BaseURLv1 = "https://us10dlc.numbers.api.sinch.com/v1/projects"
+
+// ListBrands returns a pager over the registered brands of the project.
+func (c *Client) ListBrands(req *ListBrandsRequest) *api.Pager[Brand] {
+	return api.NewPager[Brand](c, req, func() sinch.ListResponse[Brand] {
+		return new(ListBrandsResponse)
+	})
+}
+
+// ListCampaigns returns a pager over the registered campaigns of the project.
+func (c *Client) ListCampaigns(req *ListCampaignsRequest) *api.Pager[Campaign] {
+	return api.NewPager[Campaign](c, req, func() sinch.ListResponse[Campaign] {
+		return new(ListCampaignsResponse)
+	})
+}
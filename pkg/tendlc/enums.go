@@ -0,0 +1,143 @@
+package tendlc
+
+// EntityType is the legal form of the organization behind a brand.
+type EntityType string
+
+const (
+	EntityPrivateProfit  EntityType = "PRIVATE_PROFIT"
+	EntityPublicProfit   EntityType = "PUBLIC_PROFIT" // Requires a stock symbol and exchange.
+	EntityNonProfit      EntityType = "NON_PROFIT"
+	EntityGovernment     EntityType = "GOVERNMENT"
+	EntitySoleProprietor EntityType = "SOLE_PROPRIETOR" // Registered with a contact name instead of an EIN.
+)
+
+func (et EntityType) IsValid() bool {
+	switch et {
+	case EntityPrivateProfit, EntityPublicProfit, EntityNonProfit, EntityGovernment, EntitySoleProprietor:
+		return true
+	}
+	return false
+}
+
+// Vertical is the industry of a brand.
+type Vertical string
+
+const (
+	VerticalAgriculture    Vertical = "AGRICULTURE"
+	VerticalCommunication  Vertical = "COMMUNICATION"
+	VerticalConstruction   Vertical = "CONSTRUCTION"
+	VerticalEducation      Vertical = "EDUCATION"
+	VerticalEnergy         Vertical = "ENERGY"
+	VerticalEntertainment  Vertical = "ENTERTAINMENT"
+	VerticalFinancial      Vertical = "FINANCIAL"
+	VerticalGambling       Vertical = "GAMBLING"
+	VerticalGovernment     Vertical = "GOVERNMENT"
+	VerticalHealthcare     Vertical = "HEALTHCARE"
+	VerticalHospitality    Vertical = "HOSPITALITY"
+	VerticalHumanResources Vertical = "HUMAN_RESOURCES"
+	VerticalInsurance      Vertical = "INSURANCE"
+	VerticalLegal          Vertical = "LEGAL"
+	VerticalManufacturing  Vertical = "MANUFACTURING"
+	VerticalNGO            Vertical = "NGO"
+	VerticalPolitical      Vertical = "POLITICAL"
+	VerticalPostal         Vertical = "POSTAL"
+	VerticalProfessional   Vertical = "PROFESSIONAL"
+	VerticalRealEstate     Vertical = "REAL_ESTATE"
+	VerticalRetail         Vertical = "RETAIL"
+	VerticalTechnology     Vertical = "TECHNOLOGY"
+	VerticalTransportation Vertical = "TRANSPORTATION"
+)
+
+var verticals = map[Vertical]bool{
+	VerticalAgriculture: true, VerticalCommunication: true, VerticalConstruction: true, VerticalEducation: true,
+	VerticalEnergy: true, VerticalEntertainment: true, VerticalFinancial: true, VerticalGambling: true,
+	VerticalGovernment: true, VerticalHealthcare: true, VerticalHospitality: true, VerticalHumanResources: true,
+	VerticalInsurance: true, VerticalLegal: true, VerticalManufacturing: true, VerticalNGO: true,
+	VerticalPolitical: true, VerticalPostal: true, VerticalProfessional: true, VerticalRealEstate: true,
+	VerticalRetail: true, VerticalTechnology: true, VerticalTransportation: true,
+}
+
+func (v Vertical) IsValid() bool {
+	return verticals[v]
+}
+
+// UseCase is the kind of messages a campaign sends. Standard use cases may be combined as the sub use cases of
+// UseCaseMixed and UseCaseLowVolume campaigns, special use cases need carrier review.
+type UseCase string
+
+// Standard use cases.
+const (
+	UseCase2FA                  UseCase = "2FA"
+	UseCaseAccountNotification  UseCase = "ACCOUNT_NOTIFICATION"
+	UseCaseCustomerCare         UseCase = "CUSTOMER_CARE"
+	UseCaseDeliveryNotification UseCase = "DELIVERY_NOTIFICATION"
+	UseCaseFraudAlert           UseCase = "FRAUD_ALERT"
+	UseCaseHigherEducation      UseCase = "HIGHER_EDUCATION"
+	UseCaseMarketing            UseCase = "MARKETING"
+	UseCasePollingVoting        UseCase = "POLLING_VOTING"
+	UseCasePublicService        UseCase = "PUBLIC_SERVICE_ANNOUNCEMENT"
+	UseCaseSecurityAlert        UseCase = "SECURITY_ALERT"
+	UseCaseMixed                UseCase = "MIXED"      // Needs 2 to 5 sub use cases.
+	UseCaseLowVolume            UseCase = "LOW_VOLUME" // Needs 1 to 5 sub use cases.
+)
+
+// Special use cases.
+const (
+	UseCaseAgentsFranchises UseCase = "AGENTS_FRANCHISES"
+	UseCaseCarrierExempt    UseCase = "CARRIER_EXEMPT"
+	UseCaseCharity          UseCase = "CHARITY"
+	UseCaseEmergency        UseCase = "EMERGENCY"
+	UseCaseK12Education     UseCase = "K12_EDUCATION"
+	UseCasePolitical        UseCase = "POLITICAL"
+	UseCaseProxy            UseCase = "PROXY"
+	UseCaseSocial           UseCase = "SOCIAL"
+	UseCaseSoleProprietor   UseCase = "SOLE_PROPRIETOR"
+	UseCaseSweepstake       UseCase = "SWEEPSTAKE"
+)
+
+// IsStandard returns true if uc can be a sub use case.
+func (uc UseCase) IsStandard() bool {
+	switch uc {
+	case UseCase2FA, UseCaseAccountNotification, UseCaseCustomerCare, UseCaseDeliveryNotification, UseCaseFraudAlert,
+		UseCaseHigherEducation, UseCaseMarketing, UseCasePollingVoting, UseCasePublicService, UseCaseSecurityAlert:
+		return true
+	}
+	return false
+}
+
+func (uc UseCase) IsValid() bool {
+	switch uc {
+	case UseCaseMixed, UseCaseLowVolume, UseCaseAgentsFranchises, UseCaseCarrierExempt, UseCaseCharity,
+		UseCaseEmergency, UseCaseK12Education, UseCasePolitical, UseCaseProxy, UseCaseSocial, UseCaseSoleProprietor,
+		UseCaseSweepstake:
+		return true
+	}
+	return uc.IsStandard()
+}
+
+// BrandStatus is the identity verification status of a brand.
+type BrandStatus string
+
+const (
+	BrandStatusPending        BrandStatus = "PENDING"
+	BrandStatusVerified       BrandStatus = "VERIFIED"
+	BrandStatusVettedVerified BrandStatus = "VETTED_VERIFIED" // Verified with an external vetting, which raises throughput.
+	BrandStatusUnverified     BrandStatus = "UNVERIFIED"
+	BrandStatusSelfDeclared   BrandStatus = "SELF_DECLARED"
+)
+
+// IsVerified returns true if the identity of the brand was verified.
+func (bs BrandStatus) IsVerified() bool {
+	return bs == BrandStatusVerified || bs == BrandStatusVettedVerified
+}
+
+// CampaignStatus is the review status of a campaign.
+type CampaignStatus string
+
+const (
+	CampaignStatusPending   CampaignStatus = "PENDING"
+	CampaignStatusApproved  CampaignStatus = "APPROVED"
+	CampaignStatusRejected  CampaignStatus = "REJECTED"
+	CampaignStatusSuspended CampaignStatus = "SUSPENDED"
+	CampaignStatusExpired   CampaignStatus = "EXPIRED"
+)
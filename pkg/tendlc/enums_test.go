@@ -0,0 +1,38 @@
+package tendlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_UseCase(t *testing.T) {
+	tests := map[string]struct {
+		useCase      UseCase
+		wantValid    bool
+		wantStandard bool
+	}{
+		"standard":   {useCase: UseCase2FA, wantValid: true, wantStandard: true},
+		"mixed":      {useCase: UseCaseMixed, wantValid: true},
+		"low volume": {useCase: UseCaseLowVolume, wantValid: true},
+		"special":    {useCase: UseCaseCharity, wantValid: true},
+		"unknown":    {useCase: "SPAM"},
+		"empty":      {useCase: ""},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.wantValid, tt.useCase.IsValid())
+			assert.Equal(t, tt.wantStandard, tt.useCase.IsStandard())
+		})
+	}
+}
+
+func Test_Enums_IsValid(t *testing.T) {
+	assert.True(t, EntitySoleProprietor.IsValid())
+	assert.False(t, EntityType("LLC").IsValid())
+	assert.True(t, VerticalRealEstate.IsValid())
+	assert.False(t, Vertical("CRYPTO").IsValid())
+	assert.True(t, BrandStatusVettedVerified.IsVerified())
+	assert.False(t, BrandStatusSelfDeclared.IsVerified())
+}
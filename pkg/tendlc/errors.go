@@ -0,0 +1,33 @@
+package tendlc
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ProjectIDRequiredError     = api.ProjectIDRequiredError
+	KeyIDRequiredError         = api.KeyIDRequiredError
+	KeySecretRequiredError     = api.KeySecretRequiredError
+	BrandRequiredError         = sinch.Error("a brand is required")
+	BrandIDRequiredError       = sinch.Error("brand ID is required")
+	InvalidEntityTypeError     = sinch.Error("entity type must be one of PRIVATE_PROFIT, PUBLIC_PROFIT, NON_PROFIT, GOVERNMENT or SOLE_PROPRIETOR")
+	CompanyNameRequiredError   = sinch.Error("company name is required")
+	EINRequiredError           = sinch.Error("EIN is required for brands that are not sole proprietors")
+	StockSymbolRequiredError   = sinch.Error("stock symbol and exchange are required for public companies")
+	ContactNameRequiredError   = sinch.Error("first and last name are required for sole proprietors")
+	EmailRequiredError         = sinch.Error("email is required")
+	InvalidPhoneError          = sinch.Error("phone must be in E.164 format")
+	InvalidCountryError        = sinch.Error("country must be an ISO 3166-1 alpha-2 country code")
+	InvalidVerticalError       = sinch.Error("vertical is not a known industry vertical")
+	CampaignRequiredError      = sinch.Error("a campaign is required")
+	CampaignIDRequiredError    = sinch.Error("campaign ID is required")
+	InvalidUseCaseError        = sinch.Error("use case is not a known 10DLC use case")
+	InvalidSubUseCasesError    = sinch.Error("sub use cases must be standard use cases, 2 to 5 for MIXED and 1 to 5 for LOW_VOLUME")
+	DescriptionRequiredError   = sinch.Error("campaign description is required")
+	MessageFlowRequiredError   = sinch.Error("message flow is required")
+	InvalidSampleMessagesError = sinch.Error("between 1 and 5 sample messages are required")
+	CampaignNotApprovedError   = sinch.Error("numbers can only be linked to an approved campaign")
+	ServicePlanIDRequiredError = sinch.Error("service plan ID is required")
+	PhoneNumberRequiredError   = sinch.Error("phone number is required")
+)
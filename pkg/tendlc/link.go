@@ -0,0 +1,24 @@
+package tendlc
+
+import "github.com/thezmc/go-sinch/pkg/numbers"
+
+// LinkNumberRequest returns the Numbers API request that links an active number to an approved campaign, so the
+// number can send messages of the campaign with the service plan. Send it with a numbers.Client. The provisioning to
+// the campaign happens asynchronously, see numbers.Client.WaitForProvisioning.
+func LinkNumberRequest(phoneNumber, servicePlanID string, campaign *Campaign) (*numbers.UpdateRequest, error) {
+	if phoneNumber == "" {
+		return nil, PhoneNumberRequiredError
+	}
+	if servicePlanID == "" {
+		return nil, ServicePlanIDRequiredError
+	}
+	if campaign == nil {
+		return nil, CampaignRequiredError
+	}
+	if !campaign.Approved() {
+		return nil, CampaignNotApprovedError
+	}
+	return new(numbers.UpdateRequest).
+		WithPhoneNumber(phoneNumber).
+		WithSMSConfiguration(&numbers.RequestSMSConfiguration{ServicePlanID: servicePlanID, CampaignID: campaign.CampaignID}), nil
+}
@@ -0,0 +1,38 @@
+package tendlc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LinkNumberRequest(t *testing.T) {
+	approved := &Campaign{ID: "registration", CampaignID: "CABC123", Status: CampaignStatusApproved}
+
+	tests := map[string]struct {
+		phoneNumber   string
+		servicePlanID string
+		campaign      *Campaign
+		wantErr       error
+	}{
+		"no number":   {servicePlanID: "plan", campaign: approved, wantErr: PhoneNumberRequiredError},
+		"no plan":     {phoneNumber: "+12025550100", campaign: approved, wantErr: ServicePlanIDRequiredError},
+		"no campaign": {phoneNumber: "+12025550100", servicePlanID: "plan", wantErr: CampaignRequiredError},
+		"pending":     {phoneNumber: "+12025550100", servicePlanID: "plan", campaign: &Campaign{Status: CampaignStatusPending}, wantErr: CampaignNotApprovedError},
+		"approved":    {phoneNumber: "+12025550100", servicePlanID: "plan", campaign: approved},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := LinkNumberRequest(tt.phoneNumber, tt.servicePlanID, tt.campaign)
+			assert.ErrorIs(t, err, tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.NoError(t, req.Validate())
+			assert.Equal(t, tt.phoneNumber, req.PhoneNumber)
+			assert.Equal(t, "plan", req.SMSConfiguration.ServicePlanID)
+			assert.Equal(t, "CABC123", req.SMSConfiguration.CampaignID)
+		})
+	}
+}
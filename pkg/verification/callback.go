@@ -0,0 +1,196 @@
+package verification
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	EventTypeRequest    = "VerificationRequestEvent"
+	EventTypeResult     = "VerificationResultEvent"
+	MaxCallbackBodySize = 1 << 20
+)
+
+// Decision tells Sinch whether to go ahead with a verification.
+type Decision string
+
+const (
+	Allow Decision = "allow"
+	Deny  Decision = "deny"
+)
+
+// RequestEvent is sent when a verification is started, before the code is sent to the user.
+type RequestEvent struct {
+	ID             string       `json:"id"`
+	Event          string       `json:"event"`
+	Method         Method       `json:"method"`
+	Identity       Identity     `json:"identity"`
+	Price          *sinch.Price `json:"price,omitempty"`
+	Reference      string       `json:"reference,omitempty"`
+	Custom         string       `json:"custom,omitempty"`
+	AcceptLanguage []string     `json:"acceptLanguage,omitempty"`
+}
+
+// ResultEvent is sent when a verification completes.
+type ResultEvent struct {
+	ID        string   `json:"id"`
+	Event     string   `json:"event"`
+	Method    Method   `json:"method"`
+	Identity  Identity `json:"identity"`
+	Status    Status   `json:"status"`
+	Reason    string   `json:"reason,omitempty"`
+	Reference string   `json:"reference,omitempty"`
+	Source    string   `json:"source,omitempty"`
+	Custom    string   `json:"custom,omitempty"`
+}
+
+// RequestEventResponse is the response to a RequestEvent. Besides allowing or denying the verification it can set
+// options of the chosen method.
+type RequestEventResponse struct {
+	Action    Decision                  `json:"action"`
+	SMS       *SMSResponseOptions       `json:"sms,omitempty"`
+	FlashCall *FlashCallResponseOptions `json:"flashCall,omitempty"`
+	Callout   *CalloutResponseOptions   `json:"callout,omitempty"`
+}
+
+type SMSResponseOptions struct {
+	Code           string   `json:"code,omitempty"` // Overrides the generated code.
+	AcceptLanguage []string `json:"acceptLanguage,omitempty"`
+}
+
+type FlashCallResponseOptions struct {
+	CLI         string `json:"cli,omitempty"` // Overrides the caller ID of the flash call.
+	DialTimeout int    `json:"dialTimeout,omitempty"`
+}
+
+type CalloutResponseOptions struct {
+	Locale string `json:"locale,omitempty"`
+	Code   string `json:"code,omitempty"` // Overrides the generated code.
+}
+
+// AllowRequest returns a response that allows the verification.
+func AllowRequest() *RequestEventResponse {
+	return &RequestEventResponse{Action: Allow}
+}
+
+// DenyRequest returns a response that denies the verification.
+func DenyRequest() *RequestEventResponse {
+	return &RequestEventResponse{Action: Deny}
+}
+
+// RequestHandlerFunc decides whether a verification may go ahead. Returning an error makes the callback handler respond
+// with a 500. A nil response allows the verification.
+type RequestHandlerFunc func(ctx context.Context, event *RequestEvent) (*RequestEventResponse, error)
+
+// ResultHandlerFunc handles the result of a verification. Returning an error makes the callback handler respond with a
+// 500.
+type ResultHandlerFunc func(ctx context.Context, event *ResultEvent) error
+
+// CallbackHandler is an http.Handler that receives Verification API callbacks, verifies they are signed with the
+// application key and secret and dispatches them to the registered handlers. Verifications are allowed when no request
+// handler is registered.
+type CallbackHandler struct {
+	ApplicationKey    string
+	ApplicationSecret string // The base 64 encoded application secret.
+	onRequest         RequestHandlerFunc
+	onResult          ResultHandlerFunc
+}
+
+// WithApplication sets the key and base 64 encoded secret of the application the callbacks are signed with.
+func (ch *CallbackHandler) WithApplication(key, secret string) *CallbackHandler {
+	ch.ApplicationKey = key
+	ch.ApplicationSecret = secret
+	return ch
+}
+
+// OnRequest registers fn as the handler for verification request events.
+func (ch *CallbackHandler) OnRequest(fn RequestHandlerFunc) *CallbackHandler {
+	ch.onRequest = fn
+	return ch
+}
+
+// OnResult registers fn as the handler for verification result events.
+func (ch *CallbackHandler) OnResult(fn ResultHandlerFunc) *CallbackHandler {
+	ch.onResult = fn
+	return ch
+}
+
+func (ch *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxCallbackBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !VerifyCallback(ch.ApplicationKey, ch.ApplicationSecret, r, body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var envelope struct {
+		Event string `json:"event"`
+	}
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	switch envelope.Event {
+	case EventTypeRequest:
+		event := new(RequestEvent)
+		if err := json.Unmarshal(body, event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		resp := AllowRequest()
+		if ch.onRequest != nil {
+			got, err := ch.onRequest(r.Context(), event)
+			if err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			if got != nil {
+				resp = got
+			}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		_ = json.NewEncoder(w).Encode(resp)
+	case EventTypeResult:
+		event := new(ResultEvent)
+		if err := json.Unmarshal(body, event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if ch.onResult != nil {
+			if err := ch.onResult(r.Context(), event); err != nil {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusOK)
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+	}
+}
+
+// CallbackStringToSign returns the string Sinch signs a callback with, see auth.CallbackStringToSign.
+func CallbackStringToSign(r *http.Request, body []byte) string {
+	return auth.CallbackStringToSign(r, body)
+}
+
+// VerifyCallback returns true if the Authorization header of the callback holds a valid signature of the request by
+// the given application, see auth.VerifyCallback.
+func VerifyCallback(key, secret string, r *http.Request, body []byte) bool {
+	return auth.VerifyCallback(key, secret, r, body)
+}
@@ -0,0 +1,136 @@
+package verification
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/auth"
+)
+
+func newCallback(t *testing.T, method, body, secret string, useDate bool) *http.Request {
+	r := httptest.NewRequest(method, "/callbacks/verification", strings.NewReader(body))
+	r.Header.Set("Content-Type", "application/json; charset=utf-8")
+	now := time.Now().UTC()
+	if useDate {
+		r.Header.Set("Date", now.Format(http.TimeFormat))
+	} else {
+		r.Header.Set(auth.TimestampHeader, now.Format(time.RFC3339))
+	}
+	if secret != "" {
+		signature, err := auth.Sign(secret, CallbackStringToSign(r, []byte(body)))
+		assert.NoError(t, err)
+		r.Header.Set("Authorization", "Application key:"+signature)
+	}
+	return r
+}
+
+func Test_CallbackHandler(t *testing.T) {
+	const requestEvent = `{"id": "1", "event": "VerificationRequestEvent", "method": "sms", "identity": {"type": "number", "endpoint": "+46700000000"},
+		"price": {"amount": 0.0453, "currencyId": "USD"}, "reference": "ref"}`
+	const resultEvent = `{"id": "1", "event": "VerificationResultEvent", "method": "sms", "identity": {"type": "number", "endpoint": "+46700000000"},
+		"status": "SUCCESSFUL", "source": "manual"}`
+	wrongSecret := "d3Jvbmc="
+
+	var result *ResultEvent
+	denied := new(CallbackHandler).
+		WithApplication("key", testSecret).
+		OnRequest(func(ctx context.Context, event *RequestEvent) (*RequestEventResponse, error) {
+			if event.Identity.Endpoint == "+46700000000" {
+				return DenyRequest(), nil
+			}
+			return nil, nil
+		}).
+		OnResult(func(ctx context.Context, event *ResultEvent) error {
+			result = event
+			return nil
+		})
+	allowed := new(CallbackHandler).WithApplication("key", testSecret)
+	failing := new(CallbackHandler).
+		WithApplication("key", testSecret).
+		OnRequest(func(ctx context.Context, event *RequestEvent) (*RequestEventResponse, error) {
+			return nil, assert.AnError
+		})
+
+	tests := map[string]struct {
+		handler        *CallbackHandler
+		req            *http.Request
+		expectedStatus int
+		expectedBody   string
+	}{
+		"wrong method": {
+			handler:        allowed,
+			req:            newCallback(t, http.MethodGet, "", testSecret, false),
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"missing signature": {
+			handler:        allowed,
+			req:            newCallback(t, http.MethodPost, requestEvent, "", false),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"wrong secret": {
+			handler:        allowed,
+			req:            newCallback(t, http.MethodPost, requestEvent, wrongSecret, false),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"unknown event": {
+			handler:        allowed,
+			req:            newCallback(t, http.MethodPost, `{"event": "UnknownEvent"}`, testSecret, false),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"allowed without handler": {
+			handler:        allowed,
+			req:            newCallback(t, http.MethodPost, requestEvent, testSecret, false),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"action": "allow"}`,
+		},
+		"denied by handler": {
+			handler:        denied,
+			req:            newCallback(t, http.MethodPost, requestEvent, testSecret, true),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"action": "deny"}`,
+		},
+		"handler error": {
+			handler:        failing,
+			req:            newCallback(t, http.MethodPost, requestEvent, testSecret, false),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"result": {
+			handler:        denied,
+			req:            newCallback(t, http.MethodPost, resultEvent, testSecret, false),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, tt.req)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+			}
+		})
+	}
+
+	assert.Equal(t, StatusSuccessful, result.Status)
+	assert.Equal(t, "manual", result.Source)
+}
+
+func Test_RequestEventResponse(t *testing.T) {
+	rec := httptest.NewRecorder()
+	handler := new(CallbackHandler).
+		WithApplication("key", testSecret).
+		OnRequest(func(ctx context.Context, event *RequestEvent) (*RequestEventResponse, error) {
+			resp := AllowRequest()
+			resp.SMS = &SMSResponseOptions{Code: "1234"}
+			return resp, nil
+		})
+	handler.ServeHTTP(rec, newCallback(t, http.MethodPost, `{"id": "1", "event": "VerificationRequestEvent", "method": "sms"}`, testSecret, false))
+	assert.JSONEq(t, `{"action": "allow", "sms": {"code": "1234"}}`, rec.Body.String())
+	assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+}
@@ -0,0 +1,42 @@
+// Package verification is a client for the Sinch Verification API, which verifies phone numbers by SMS, flash call,
+// phone call or data verification.
+package verification
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+)
+
+type Client struct {
+	api.ApplicationClient
+}
+
+const BaseURLv1 = "https://verification.api.sinch.com/verification/v1"
+
+// Start starts a verification, see StartRequest.
+func (c *Client) Start(ctx context.Context, req *StartRequest) (*StartResponse, error) {
+	resp := new(StartResponse)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Report reports the code or CLI the user received, see ReportRequest.
+func (c *Client) Report(ctx context.Context, req *ReportRequest) (*Result, error) {
+	resp := new(Result)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Status fetches the status of a verification, see StatusRequest.
+func (c *Client) Status(ctx context.Context, req *StatusRequest) (*Result, error) {
+	resp := new(Result)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
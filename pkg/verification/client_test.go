@@ -0,0 +1,104 @@
+package verification
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+var testSecret = base64.StdEncoding.EncodeToString([]byte("secret"))
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing key":    {opts: []Option{WithApplication("", testSecret)}, wantErr: ApplicationKeyRequiredError},
+		"missing secret": {opts: []Option{WithApplication("key", "")}, wantErr: ApplicationSecretRequiredError},
+		"application":    {opts: []Option{WithApplication("key", testSecret)}},
+		"authenticator":  {opts: []Option{WithAuthenticator(auth.NewBearerToken("token"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithApplication("key", testSecret))
+	assert.NoError(t, err)
+	assert.Equal(t, BaseURLv1, c.URL())
+
+	_, err = New()
+	assert.ErrorIs(t, err, ApplicationKeyRequiredError)
+}
+
+func Test_Client_Do(t *testing.T) {
+	var gotPath, gotAuth string
+	var gotBody map[string]any
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.Method + " " + r.URL.EscapedPath()
+		body, _ := io.ReadAll(r.Body)
+		gotBody = nil
+		_ = json.Unmarshal(body, &gotBody)
+
+		signature, _ := auth.Sign(testSecret, auth.StringToSign(r.Method, body, r.Header.Get("Content-Type"), r.Header.Get(auth.TimestampHeader), r.URL.Path))
+		gotAuth = r.Header.Get("Authorization")
+		if gotAuth != "Application key:"+signature {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			io.WriteString(w, `{"id": "1234", "method": "sms", "sms": {"template": "Your code is {{CODE}}", "interceptionTimeout": 298}}`)
+		default:
+			io.WriteString(w, `{"id": "1234", "method": "sms", "status": "SUCCESSFUL", "reference": "ref", "verificationTimestamp": "2023-04-21T14:45:51.123Z"}`)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(WithApplication("key", testSecret), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	started, err := c.Start(ctx, new(StartRequest).WithNumber("+46700000000").SMS().WithReference("ref"))
+	assert.NoError(t, err)
+	assert.Equal(t, "POST /verifications", gotPath)
+	assert.Equal(t, map[string]any{"identity": map[string]any{"type": "number", "endpoint": "+46700000000"}, "method": "sms", "reference": "ref"}, gotBody)
+	assert.Equal(t, "1234", started.ID)
+	assert.Equal(t, 298, started.SMS.InterceptionTimeout)
+
+	result, err := c.Report(ctx, new(ReportRequest).WithID(started.ID).WithSMSCode("0000"))
+	assert.NoError(t, err)
+	assert.Equal(t, "PUT /verifications/id/1234", gotPath)
+	assert.Equal(t, map[string]any{"method": "sms", "sms": map[string]any{"code": "0000"}}, gotBody)
+	assert.Equal(t, StatusSuccessful, result.Status)
+	assert.Equal(t, 2023, result.VerificationTimestamp.Year())
+
+	_, err = c.Status(ctx, new(StatusRequest).WithReference("ref"))
+	assert.NoError(t, err)
+	assert.Equal(t, "GET /verifications/reference/ref", gotPath)
+
+	c.WithApplication("key", base64.StdEncoding.EncodeToString([]byte("wrong")))
+	_, err = c.Status(ctx, new(StatusRequest).WithID("1234"))
+	code, _ := api.StatusCode(err)
+	assert.Equal(t, http.StatusUnauthorized, code)
+	assert.True(t, strings.HasPrefix(gotAuth, "Application key:"))
+}
@@ -0,0 +1,16 @@
+package verification
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ApplicationKeyRequiredError    = api.ApplicationKeyRequiredError
+	ApplicationSecretRequiredError = api.ApplicationSecretRequiredError
+	NumberRequiredError            = sinch.Error("phone number is required")
+	InvalidMethodError             = sinch.Error("method must be one of sms, flashCall, callout or seamless")
+	IDOrNumberRequiredError        = sinch.Error("exactly one of verification ID or phone number is required")
+	CodeRequiredError              = sinch.Error("a code or CLI is required to report a verification")
+	LookupRequiredError            = sinch.Error("exactly one of verification ID, phone number or reference is required")
+)
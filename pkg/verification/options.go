@@ -0,0 +1,25 @@
+package verification
+
+import "github.com/thezmc/go-sinch/pkg/api"
+
+// Option configures a Client created with New.
+type Option = api.ServiceOption[*Client]
+
+// New returns a client that sends requests to BaseURLv1 with api.DefaultHTTPClient unless configured otherwise. It
+// returns an error if the configured client is not valid.
+func New(opts ...Option) (*Client, error) {
+	return api.NewService(WithBaseURL(BaseURLv1), opts...)
+}
+
+var (
+	// WithApplication sets the key and base 64 encoded secret of the application requests are signed with.
+	WithApplication = api.WithServiceApplication[*Client]
+	// WithAuthenticator authenticates requests with a instead of the application key and secret.
+	WithAuthenticator = api.WithServiceAuthenticator[*Client]
+	// WithSinchAPI sends requests with sinchAPI, see api.WithServiceSinchAPI.
+	WithSinchAPI = api.WithServiceSinchAPI[*Client]
+	// WithBaseURL sets the base URL.
+	WithBaseURL = api.WithServiceBaseURL[*Client]
+	// WithHTTPClient sets the HTTP client used to send requests.
+	WithHTTPClient = api.WithServiceHTTPClient[*Client]
+)
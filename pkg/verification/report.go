@@ -0,0 +1,123 @@
+package verification
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+type ReportAction struct {
+	request  *ReportRequest
+	response *Result
+}
+
+func (r *ReportAction) Request() *ReportRequest {
+	return r.request
+}
+
+func (r *ReportAction) Response() *Result {
+	return r.response
+}
+
+// ReportRequest reports the code or caller ID the user received, identifying the verification by its ID or by the
+// phone number being verified.
+type ReportRequest struct {
+	ID                 string           `json:"-"`
+	Number             string           `json:"-"`
+	VerificationMethod Method           `json:"method"`
+	SMS                *SMSReport       `json:"sms,omitempty"`
+	FlashCall          *FlashCallReport `json:"flashCall,omitempty"`
+	Callout            *CalloutReport   `json:"callout,omitempty"`
+}
+
+type SMSReport struct {
+	Code string `json:"code"`
+	CLI  string `json:"cli,omitempty"`
+}
+
+type FlashCallReport struct {
+	CLI string `json:"cli"` // The caller ID of the flash call.
+}
+
+type CalloutReport struct {
+	Code string `json:"code"`
+}
+
+// WithID reports the verification with the given ID.
+func (rr *ReportRequest) WithID(id string) *ReportRequest {
+	rr.ID = id
+	return rr
+}
+
+// WithNumber reports the latest verification of the given phone number.
+func (rr *ReportRequest) WithNumber(number string) *ReportRequest {
+	rr.Number = number
+	return rr
+}
+
+// WithSMSCode reports the code received in an SMS.
+func (rr *ReportRequest) WithSMSCode(code string) *ReportRequest {
+	rr.VerificationMethod = MethodSMS
+	rr.SMS = &SMSReport{Code: code}
+	return rr
+}
+
+// WithFlashCallCLI reports the caller ID of a flash call.
+func (rr *ReportRequest) WithFlashCallCLI(cli string) *ReportRequest {
+	rr.VerificationMethod = MethodFlashCall
+	rr.FlashCall = &FlashCallReport{CLI: cli}
+	return rr
+}
+
+// WithCalloutCode reports the code read out in a phone call.
+func (rr *ReportRequest) WithCalloutCode(code string) *ReportRequest {
+	rr.VerificationMethod = MethodCallout
+	rr.Callout = &CalloutReport{Code: code}
+	return rr
+}
+
+func (rr *ReportRequest) Validate() error {
+	if (rr.ID == "") == (rr.Number == "") {
+		return IDOrNumberRequiredError
+	}
+	switch rr.VerificationMethod {
+	case MethodSMS:
+		if rr.SMS == nil || rr.SMS.Code == "" {
+			return CodeRequiredError
+		}
+	case MethodFlashCall:
+		if rr.FlashCall == nil || rr.FlashCall.CLI == "" {
+			return CodeRequiredError
+		}
+	case MethodCallout:
+		if rr.Callout == nil || rr.Callout.Code == "" {
+			return CodeRequiredError
+		}
+	default:
+		return InvalidMethodError
+	}
+	return nil
+}
+
+func (rr *ReportRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (rr *ReportRequest) Method() string {
+	return http.MethodPut
+}
+
+func (rr *ReportRequest) Path() string {
+	if rr.ID != "" {
+		return "/verifications/id/" + url.PathEscape(rr.ID)
+	}
+	return "/verifications/number/" + url.PathEscape(rr.Number)
+}
+
+func (rr *ReportRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (rr *ReportRequest) Body() ([]byte, error) {
+	return json.Marshal(rr)
+}
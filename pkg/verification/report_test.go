@@ -0,0 +1,70 @@
+package verification
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Report_Implementations(t *testing.T) {
+	var _ sinch.Action[*ReportRequest, *Result] = new(ReportAction)
+	var _ sinch.APIRequest = new(ReportRequest)
+	var _ sinch.APIResponse = new(Result)
+}
+
+func Test_ReportRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *ReportRequest
+		wantErr  error
+		wantPath string
+		wantBody string
+	}{
+		"missing id and number": {
+			req:     new(ReportRequest).WithSMSCode("1234"),
+			wantErr: IDOrNumberRequiredError,
+		},
+		"id and number": {
+			req:     new(ReportRequest).WithID("1").WithNumber("+46700000000").WithSMSCode("1234"),
+			wantErr: IDOrNumberRequiredError,
+		},
+		"missing method": {
+			req:     new(ReportRequest).WithID("1"),
+			wantErr: InvalidMethodError,
+		},
+		"missing code": {
+			req:     new(ReportRequest).WithID("1").WithSMSCode(""),
+			wantErr: CodeRequiredError,
+		},
+		"sms by id": {
+			req:      new(ReportRequest).WithID("1").WithSMSCode("1234"),
+			wantPath: "/verifications/id/1",
+			wantBody: `{"method":"sms","sms":{"code":"1234"}}`,
+		},
+		"flash call by number": {
+			req:      new(ReportRequest).WithNumber("+46700000000").WithFlashCallCLI("+46700005312"),
+			wantPath: "/verifications/number/+46700000000",
+			wantBody: `{"method":"flashCall","flashCall":{"cli":"+46700005312"}}`,
+		},
+		"callout by number": {
+			req:      new(ReportRequest).WithNumber("+46700000000").WithCalloutCode("1234"),
+			wantPath: "/verifications/number/+46700000000",
+			wantBody: `{"method":"callout","callout":{"code":"1234"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPut, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+		})
+	}
+}
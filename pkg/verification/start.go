@@ -0,0 +1,157 @@
+package verification
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type StartAction struct {
+	request  *StartRequest
+	response *StartResponse
+}
+
+func (s *StartAction) Request() *StartRequest {
+	return s.request
+}
+
+func (s *StartAction) Response() *StartResponse {
+	return s.response
+}
+
+// StartRequest starts the verification of a phone number.
+type StartRequest struct {
+	Identity           Identity          `json:"identity"`
+	VerificationMethod Method            `json:"method"`
+	Reference          string            `json:"reference,omitempty"` // Your reference for the verification, which must be unique. Can be used to query the status.
+	Custom             string            `json:"custom,omitempty"`    // Passed back in the callbacks of the verification.
+	SMSOptions         *SMSOptions       `json:"smsOptions,omitempty"`
+	FlashCallOptions   *FlashCallOptions `json:"flashCallOptions,omitempty"`
+}
+
+type SMSOptions struct {
+	Expiry   string `json:"expiry,omitempty"`   // How long the code is valid, formatted as HH:MM:SS.
+	CodeType string `json:"codeType,omitempty"` // One of Numeric, Alpha or Alphanumeric.
+	Template string `json:"template,omitempty"` // The SMS text, with {{CODE}} replaced by the code.
+}
+
+type FlashCallOptions struct {
+	DialTimeout int `json:"dialTimeout,omitempty"` // Seconds the call rings before it is hung up.
+}
+
+// StartResponse holds the details the device needs to complete the verification.
+type StartResponse struct {
+	ID        string            `json:"id"`
+	Method    Method            `json:"method"`
+	SMS       *SMSDetails       `json:"sms,omitempty"`
+	FlashCall *FlashCallDetails `json:"flashCall,omitempty"`
+	Callout   *CalloutDetails   `json:"callout,omitempty"`
+	Seamless  *SeamlessDetails  `json:"seamless,omitempty"`
+	Links     []Link            `json:"_links,omitempty"`
+}
+
+type SMSDetails struct {
+	Template            string `json:"template"`
+	InterceptionTimeout int    `json:"interceptionTimeout"`
+}
+
+type FlashCallDetails struct {
+	CLIFilter           string `json:"cliFilter"` // A regular expression matching the caller ID of the flash call.
+	InterceptionTimeout int    `json:"interceptionTimeout"`
+	ReportTimeout       int    `json:"reportTimeout"`
+	DenyCallAfter       int    `json:"denyCallAfter"`
+}
+
+type CalloutDetails struct {
+	StartPollingAfter int `json:"startPollingAfter"`
+	StopPollingAfter  int `json:"stopPollingAfter"`
+	PollingInterval   int `json:"pollingInterval"`
+}
+
+type SeamlessDetails struct {
+	TargetURI string `json:"targetUri"` // The URI the device must request over its mobile data connection.
+}
+
+// WithNumber sets the phone number to verify, in E.164 format with leading +.
+func (sr *StartRequest) WithNumber(number string) *StartRequest {
+	sr.Identity = NumberIdentity(number)
+	return sr
+}
+
+func (sr *StartRequest) WithMethod(method Method) *StartRequest {
+	sr.VerificationMethod = method
+	return sr
+}
+
+// SMS starts an SMS verification.
+func (sr *StartRequest) SMS() *StartRequest {
+	return sr.WithMethod(MethodSMS)
+}
+
+// FlashCall starts a flash call verification.
+func (sr *StartRequest) FlashCall() *StartRequest {
+	return sr.WithMethod(MethodFlashCall)
+}
+
+// Callout starts a phone call verification.
+func (sr *StartRequest) Callout() *StartRequest {
+	return sr.WithMethod(MethodCallout)
+}
+
+// Seamless starts a data verification.
+func (sr *StartRequest) Seamless() *StartRequest {
+	return sr.WithMethod(MethodSeamless)
+}
+
+func (sr *StartRequest) WithReference(reference string) *StartRequest {
+	sr.Reference = reference
+	return sr
+}
+
+func (sr *StartRequest) WithCustom(custom string) *StartRequest {
+	sr.Custom = custom
+	return sr
+}
+
+func (sr *StartRequest) WithSMSOptions(options *SMSOptions) *StartRequest {
+	sr.SMSOptions = options
+	return sr
+}
+
+func (sr *StartRequest) WithFlashCallOptions(options *FlashCallOptions) *StartRequest {
+	sr.FlashCallOptions = options
+	return sr
+}
+
+func (sr *StartRequest) Validate() error {
+	if sr.Identity.Endpoint == "" {
+		return NumberRequiredError
+	}
+	if !sr.VerificationMethod.IsValid() {
+		return InvalidMethodError
+	}
+	return nil
+}
+
+func (sr *StartRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (sr *StartRequest) Method() string {
+	return http.MethodPost
+}
+
+func (sr *StartRequest) Path() string {
+	return "/verifications"
+}
+
+func (sr *StartRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (sr *StartRequest) Body() ([]byte, error) {
+	return json.Marshal(sr)
+}
+
+func (sr *StartResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, sr)
+}
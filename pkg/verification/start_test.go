@@ -0,0 +1,75 @@
+package verification
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Start_Implementations(t *testing.T) {
+	var _ sinch.Action[*StartRequest, *StartResponse] = new(StartAction)
+	var _ sinch.APIRequest = new(StartRequest)
+	var _ sinch.APIResponse = new(StartResponse)
+}
+
+func Test_StartRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *StartRequest
+		wantErr  error
+		wantBody string
+	}{
+		"missing number": {
+			req:     new(StartRequest).SMS(),
+			wantErr: NumberRequiredError,
+		},
+		"missing method": {
+			req:     new(StartRequest).WithNumber("+46700000000"),
+			wantErr: InvalidMethodError,
+		},
+		"flash call": {
+			req:      new(StartRequest).WithNumber("+46700000000").FlashCall().WithFlashCallOptions(&FlashCallOptions{DialTimeout: 10}),
+			wantBody: `{"identity":{"type":"number","endpoint":"+46700000000"},"method":"flashCall","flashCallOptions":{"dialTimeout":10}}`,
+		},
+		"callout": {
+			req:      new(StartRequest).WithNumber("+46700000000").Callout().WithCustom("custom"),
+			wantBody: `{"identity":{"type":"number","endpoint":"+46700000000"},"method":"callout","custom":"custom"}`,
+		},
+		"seamless": {
+			req:      new(StartRequest).WithNumber("+46700000000").Seamless(),
+			wantBody: `{"identity":{"type":"number","endpoint":"+46700000000"},"method":"seamless"}`,
+		},
+		"sms with options": {
+			req:      new(StartRequest).WithNumber("+46700000000").SMS().WithSMSOptions(&SMSOptions{CodeType: "Numeric"}),
+			wantBody: `{"identity":{"type":"number","endpoint":"+46700000000"},"method":"sms","smsOptions":{"codeType":"Numeric"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPost, tt.req.Method())
+			assert.Equal(t, "/verifications", tt.req.Path())
+		})
+	}
+}
+
+func Test_StartResponse_FromJSON(t *testing.T) {
+	resp := new(StartResponse)
+	assert.NoError(t, resp.FromJSON([]byte(`{"id": "1", "method": "flashCall", "flashCall": {"cliFilter": "(.*)5312(.*)", "interceptionTimeout": 45, "reportTimeout": 75, "denyCallAfter": 0},
+		"_links": [{"rel": "status", "href": "https://verification.api.sinch.com/verification/v1/verifications/id/1", "method": "GET"}]}`)))
+	assert.Equal(t, MethodFlashCall, resp.Method)
+	assert.Equal(t, "(.*)5312(.*)", resp.FlashCall.CLIFilter)
+	assert.Equal(t, "status", resp.Links[0].Rel)
+
+	resp = new(StartResponse)
+	assert.NoError(t, resp.FromJSON([]byte(`{"id": "2", "method": "seamless", "seamless": {"targetUri": "https://example.com/verify"}}`)))
+	assert.Equal(t, "https://example.com/verify", resp.Seamless.TargetURI)
+}
@@ -0,0 +1,88 @@
+package verification
+
+import (
+	"net/http"
+	"net/url"
+)
+
+type StatusAction struct {
+	request  *StatusRequest
+	response *Result
+}
+
+func (s *StatusAction) Request() *StatusRequest {
+	return s.request
+}
+
+func (s *StatusAction) Response() *Result {
+	return s.response
+}
+
+// StatusRequest fetches the status of a verification by its ID, by the phone number and method, or by your reference.
+type StatusRequest struct {
+	ID                 string
+	Number             string
+	VerificationMethod Method // Required with Number.
+	Reference          string
+}
+
+// WithID queries the verification with the given ID.
+func (sr *StatusRequest) WithID(id string) *StatusRequest {
+	sr.ID = id
+	return sr
+}
+
+// WithNumber queries the latest verification of the phone number with the given method.
+func (sr *StatusRequest) WithNumber(method Method, number string) *StatusRequest {
+	sr.VerificationMethod = method
+	sr.Number = number
+	return sr
+}
+
+// WithReference queries the verification started with the given reference.
+func (sr *StatusRequest) WithReference(reference string) *StatusRequest {
+	sr.Reference = reference
+	return sr
+}
+
+func (sr *StatusRequest) Validate() error {
+	set := 0
+	for _, v := range []string{sr.ID, sr.Number, sr.Reference} {
+		if v != "" {
+			set++
+		}
+	}
+	if set != 1 {
+		return LookupRequiredError
+	}
+	if sr.Number != "" && !sr.VerificationMethod.IsValid() {
+		return InvalidMethodError
+	}
+	return nil
+}
+
+func (sr *StatusRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (sr *StatusRequest) Method() string {
+	return http.MethodGet
+}
+
+func (sr *StatusRequest) Path() string {
+	switch {
+	case sr.ID != "":
+		return "/verifications/id/" + url.PathEscape(sr.ID)
+	case sr.Number != "":
+		return "/verifications/" + string(sr.VerificationMethod) + "/number/" + url.PathEscape(sr.Number)
+	}
+	return "/verifications/reference/" + url.PathEscape(sr.Reference)
+}
+
+func (sr *StatusRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (sr *StatusRequest) Body() ([]byte, error) {
+	return nil, nil
+}
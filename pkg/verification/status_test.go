@@ -0,0 +1,77 @@
+package verification
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Status_Implementations(t *testing.T) {
+	var _ sinch.Action[*StatusRequest, *Result] = new(StatusAction)
+	var _ sinch.APIRequest = new(StatusRequest)
+}
+
+func Test_StatusRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *StatusRequest
+		wantErr  error
+		wantPath string
+	}{
+		"nothing set": {
+			req:     new(StatusRequest),
+			wantErr: LookupRequiredError,
+		},
+		"id and reference": {
+			req:     new(StatusRequest).WithID("1").WithReference("ref"),
+			wantErr: LookupRequiredError,
+		},
+		"number without method": {
+			req:     new(StatusRequest).WithNumber("", "+46700000000"),
+			wantErr: InvalidMethodError,
+		},
+		"id": {
+			req:      new(StatusRequest).WithID("1"),
+			wantPath: "/verifications/id/1",
+		},
+		"number": {
+			req:      new(StatusRequest).WithNumber(MethodCallout, "+46700000000"),
+			wantPath: "/verifications/callout/number/+46700000000",
+		},
+		"reference": {
+			req:      new(StatusRequest).WithReference("my ref"),
+			wantPath: "/verifications/reference/my%20ref",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			assert.Equal(t, http.MethodGet, tt.req.Method())
+			assert.Equal(t, tt.wantPath, tt.req.Path())
+		})
+	}
+}
+
+func Test_Result_FromJSON(t *testing.T) {
+	r := new(Result)
+	assert.NoError(t, r.FromJSON([]byte(`{"id": "1", "method": "callout", "status": "FAIL", "reason": "Expired", "callComplete": true,
+		"price": {"verificationPrice": {"currencyId": "USD", "amount": 0.0453}}}`)))
+	assert.Equal(t, StatusFail, r.Status)
+	assert.Equal(t, "Expired", r.Reason)
+	assert.True(t, r.CallComplete)
+	assert.Equal(t, "0.0453", r.Price.VerificationPrice.Amount.String())
+	total, err := r.Price.VerificationPrice.Money().MulInt(3).Add(sinch.Money{})
+	assert.NoError(t, err)
+	assert.Equal(t, "0.1359 USD", total.String())
+	assert.Nil(t, r.VerificationTimestamp)
+
+	data, err := json.Marshal(r)
+	assert.NoError(t, err)
+	assert.NotContains(t, string(data), "verificationTimestamp")
+}
@@ -0,0 +1,84 @@
+package verification
+
+import (
+	"encoding/json"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Method is the way a phone number is verified.
+type Method string
+
+const (
+	MethodSMS       Method = "sms"       // A code is sent in an SMS.
+	MethodFlashCall Method = "flashCall" // A missed call is placed and the caller ID is the code.
+	MethodCallout   Method = "callout"   // A phone call reads out the code.
+	MethodSeamless  Method = "seamless"  // The number is verified over the mobile data connection, without user interaction.
+)
+
+// IsValid reports whether m is a known verification method.
+func (m Method) IsValid() bool {
+	switch m {
+	case MethodSMS, MethodFlashCall, MethodCallout, MethodSeamless:
+		return true
+	}
+	return false
+}
+
+// Status is the status of a verification.
+type Status string
+
+const (
+	StatusPending    Status = "PENDING"
+	StatusSuccessful Status = "SUCCESSFUL"
+	StatusFail       Status = "FAIL"
+	StatusDenied     Status = "DENIED"
+	StatusAborted    Status = "ABORTED"
+	StatusError      Status = "ERROR"
+)
+
+// IdentityTypeNumber is the only identity type supported by the Verification API.
+const IdentityTypeNumber = "number"
+
+// Identity identifies the phone number being verified.
+type Identity struct {
+	Type     string `json:"type"`
+	Endpoint string `json:"endpoint"` // The phone number in E.164 format with leading +.
+}
+
+// NumberIdentity returns the identity of the given phone number.
+func NumberIdentity(number string) Identity {
+	return Identity{Type: IdentityTypeNumber, Endpoint: number}
+}
+
+type Link struct {
+	Rel    string `json:"rel"`
+	Href   string `json:"href"`
+	Method string `json:"method"`
+}
+
+type Prices struct {
+	VerificationPrice *sinch.Price `json:"verificationPrice,omitempty"`
+	TerminationPrice  *sinch.Price `json:"terminationPrice,omitempty"`
+	BillableDuration  int          `json:"billableDuration,omitempty"`
+}
+
+// Result is the status of a verification, as returned when a code is reported or the status is queried.
+type Result struct {
+	ID                    string      `json:"id"`
+	Method                Method      `json:"method"`
+	Status                Status      `json:"status"`
+	Reason                string      `json:"reason,omitempty"` // Why the verification failed, e.g. "Fraud", "Expired" or "Invalid code".
+	Reference             string      `json:"reference,omitempty"`
+	Custom                string      `json:"custom,omitempty"`
+	Identity              *Identity   `json:"identity,omitempty"`
+	CountryID             string      `json:"countryId,omitempty"`
+	VerificationTimestamp *sinch.Time `json:"verificationTimestamp,omitempty"`
+	CallComplete          bool        `json:"callComplete,omitempty"`
+	Source                string      `json:"source,omitempty"` // How the code was reported, "intercepted" or "manual".
+	Price                 *Prices     `json:"price,omitempty"`
+}
+
+func (r *Result) FromJSON(data []byte) error {
+	return json.Unmarshal(data, r)
+}
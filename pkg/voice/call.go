@@ -0,0 +1,138 @@
+package voice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type CallAction struct {
+	request  *CallRequest
+	response *CallResult
+}
+
+func (ca *CallAction) Request() *CallRequest {
+	return ca.request
+}
+
+func (ca *CallAction) Response() *CallResult {
+	return ca.response
+}
+
+// CallRequest fetches information about a call.
+type CallRequest struct {
+	CallID string
+}
+
+// Call statuses.
+const (
+	CallStatusInitiated = "INITIATED"
+	CallStatusOngoing   = "ONGOING"
+	CallStatusFinal     = "FINAL"
+)
+
+// Call results.
+const (
+	CallResultNA       = "N/A"
+	CallResultAnswered = "ANSWERED"
+	CallResultBusy     = "BUSY"
+	CallResultNoAnswer = "NOANSWER"
+	CallResultFailed   = "FAILED"
+)
+
+type CallResult struct {
+	From      Destination  `json:"from"`
+	To        Destination  `json:"to"`
+	Domain    string       `json:"domain"`
+	CallID    string       `json:"callId"`
+	Duration  int          `json:"duration"` // Seconds.
+	Status    string       `json:"status"`
+	Result    string       `json:"result"`
+	Reason    string       `json:"reason"`
+	Timestamp sinch.Time   `json:"timestamp"`
+	Custom    string       `json:"custom"`
+	UserRate  *sinch.Price `json:"userRate,omitempty"`
+	Debit     *sinch.Price `json:"debit,omitempty"`
+}
+
+func (cr *CallRequest) WithCallID(callID string) *CallRequest {
+	cr.CallID = callID
+	return cr
+}
+
+func (cr *CallRequest) Validate() error {
+	if cr.CallID == "" {
+		return CallIDRequiredError
+	}
+	return nil
+}
+
+func (cr *CallRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (cr *CallRequest) Method() string {
+	return http.MethodGet
+}
+
+func (cr *CallRequest) Path() string {
+	return "/calls/id/" + url.PathEscape(cr.CallID)
+}
+
+func (cr *CallRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (cr *CallRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (cr *CallResult) FromJSON(data []byte) error {
+	return json.Unmarshal(data, cr)
+}
+
+// UpdateCallRequest controls an ongoing call with SVAML, e.g. to play a message or hang up. Only calls controlled by
+// callbacks can be updated. The response has no content, use sinch.NoContent.
+type UpdateCallRequest struct {
+	CallID string
+	SVAML  *SVAML
+}
+
+func (ucr *UpdateCallRequest) WithCallID(callID string) *UpdateCallRequest {
+	ucr.CallID = callID
+	return ucr
+}
+
+func (ucr *UpdateCallRequest) WithSVAML(svaml *SVAML) *UpdateCallRequest {
+	ucr.SVAML = svaml
+	return ucr
+}
+
+func (ucr *UpdateCallRequest) Validate() error {
+	if ucr.CallID == "" {
+		return CallIDRequiredError
+	}
+	return ucr.SVAML.Validate()
+}
+
+func (ucr *UpdateCallRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (ucr *UpdateCallRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (ucr *UpdateCallRequest) Path() string {
+	return "/calls/id/" + url.PathEscape(ucr.CallID)
+}
+
+func (ucr *UpdateCallRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (ucr *UpdateCallRequest) Body() ([]byte, error) {
+	return json.Marshal(ucr.SVAML)
+}
@@ -0,0 +1,81 @@
+package voice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Call_Implementations(t *testing.T) {
+	var _ sinch.Action[*CallRequest, *CallResult] = new(CallAction)
+	var _ sinch.APIRequest = new(CallRequest)
+	var _ sinch.APIResponse = new(CallResult)
+	var _ sinch.APIRequest = new(UpdateCallRequest)
+}
+
+func Test_CallRequest(t *testing.T) {
+	cr := new(CallRequest)
+	assert.ErrorIs(t, cr.Validate(), CallIDRequiredError)
+
+	cr.WithCallID("call-1")
+	assert.NoError(t, cr.Validate())
+	assert.Equal(t, http.MethodGet, cr.Method())
+	assert.Equal(t, "/calls/id/call-1", cr.Path())
+}
+
+func Test_CallResult_FromJSON(t *testing.T) {
+	cr := new(CallResult)
+	assert.NoError(t, cr.FromJSON([]byte(`{"from": {"type": "number", "endpoint": "+46700000001"}, "to": {"type": "number", "endpoint": "+46700000000"},
+		"domain": "pstn", "callId": "call-1", "duration": 42, "status": "FINAL", "result": "ANSWERED", "reason": "CALLERHANGUP",
+		"timestamp": "2023-04-21T14:45:51Z", "userRate": {"currencyId": "USD", "amount": 0.0125}}`)))
+	assert.Equal(t, CallResultAnswered, cr.Result)
+	assert.Equal(t, 42, cr.Duration)
+	assert.Equal(t, 2023, cr.Timestamp.Year())
+	assert.Equal(t, "0.0125", cr.UserRate.Amount.String())
+	assert.Equal(t, "0.0125 USD", cr.UserRate.Money().String())
+}
+
+func Test_UpdateCallRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *UpdateCallRequest
+		wantErr  error
+		wantBody string
+	}{
+		"missing call id": {
+			req:     new(UpdateCallRequest).WithSVAML(&SVAML{Action: &Action{Name: ActionHangup}}),
+			wantErr: CallIDRequiredError,
+		},
+		"missing svaml": {
+			req:     new(UpdateCallRequest).WithCallID("call-1"),
+			wantErr: SVAMLRequiredError,
+		},
+		"empty svaml": {
+			req:     new(UpdateCallRequest).WithCallID("call-1").WithSVAML(new(SVAML)),
+			wantErr: SVAMLRequiredError,
+		},
+		"say and continue": {
+			req: new(UpdateCallRequest).WithCallID("call-1").WithSVAML(&SVAML{
+				Instructions: []Instruction{{Name: InstructionSay, Text: "Please hold", Locale: "en-US"}},
+				Action:       &Action{Name: ActionContinue},
+			}),
+			wantBody: `{"instructions":[{"name":"say","text":"Please hold","locale":"en-US"}],"action":{"name":"continue"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPatch, tt.req.Method())
+			assert.Equal(t, "/calls/id/call-1", tt.req.Path())
+			assert.Equal(t, http.StatusNoContent, tt.req.ExpectedStatusCode())
+		})
+	}
+}
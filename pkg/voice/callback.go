@@ -0,0 +1,216 @@
+package voice
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+// Callback event types.
+const (
+	EventICE    = "ice"    // Incoming Call Event, sent when a call reaches one of your numbers or app users.
+	EventACE    = "ace"    // Answered Call Event, sent when a callout or connected call is answered.
+	EventDICE   = "dice"   // Disconnected Call Event, sent when a call ends.
+	EventPIE    = "pie"    // Prompt Input Event, sent with the result of RunMenu.
+	EventNotify = "notify" // Notification, e.g. that a recording is available.
+
+	MaxCallbackBodySize = 1 << 20
+)
+
+// CallEvent holds the fields common to every callback event.
+type CallEvent struct {
+	Event          string     `json:"event"`
+	CallID         string     `json:"callid"`
+	Timestamp      sinch.Time `json:"timestamp"`
+	Version        int        `json:"version"`
+	Custom         string     `json:"custom"`
+	ApplicationKey string     `json:"applicationKey"`
+}
+
+type ICE struct {
+	CallEvent
+	CallResourceURL string       `json:"callResourceUrl"`
+	UserRate        *sinch.Price `json:"userRate,omitempty"`
+	CLI             string       `json:"cli"`
+	To              Destination  `json:"to"`
+	Domain          string       `json:"domain"`
+	OriginationType string       `json:"originationType"`
+	RDNIS           string       `json:"rdnis"`
+	CallHeaders     []CallHeader `json:"callHeaders,omitempty"`
+}
+
+type ACE struct {
+	CallEvent
+	AMD *AMDResult `json:"amd,omitempty"`
+}
+
+// AMDResult is the result of answering machine detection, see Action.WithAMD.
+type AMDResult struct {
+	Status   string `json:"status"` // One of machine, human, notsure or hangup.
+	Reason   string `json:"reason"`
+	Duration int    `json:"duration"`
+}
+
+type DICE struct {
+	CallEvent
+	Reason   string       `json:"reason"`
+	Result   string       `json:"result"`
+	Debit    *sinch.Price `json:"debit,omitempty"`
+	UserRate *sinch.Price `json:"userRate,omitempty"`
+	To       Destination  `json:"to"`
+	From     string       `json:"from"`
+	Duration int          `json:"duration"` // Seconds.
+}
+
+type PIE struct {
+	CallEvent
+	MenuResult MenuResult `json:"menuResult"`
+}
+
+type MenuResult struct {
+	MenuID      string `json:"menuId"`
+	Type        string `json:"type"`  // One of return, sequence, timeout, hangup or invalidinput.
+	Value       string `json:"value"` // The value of ReturnValue or the digits entered.
+	InputMethod string `json:"inputMethod"`
+}
+
+// ICEHandlerFunc answers an incoming call with SVAML. A nil document hangs up the call.
+type ICEHandlerFunc func(ctx context.Context, event *ICE) (*SVAML, error)
+
+// ACEHandlerFunc answers an answered call event with SVAML. A nil document continues the call.
+type ACEHandlerFunc func(ctx context.Context, event *ACE) (*SVAML, error)
+
+// PIEHandlerFunc answers a prompt input event with SVAML. A nil document continues the call.
+type PIEHandlerFunc func(ctx context.Context, event *PIE) (*SVAML, error)
+
+// DICEHandlerFunc handles a disconnected call event.
+type DICEHandlerFunc func(ctx context.Context, event *DICE) error
+
+// CallbackHandler is an http.Handler that receives Voice API callbacks, verifies they are signed with the application
+// key and secret, dispatches them to the registered handlers and responds with their SVAML. Handler errors and
+// invalid SVAML make it respond with a 500.
+type CallbackHandler struct {
+	ApplicationKey    string
+	ApplicationSecret string // The base 64 encoded application secret.
+	onICE             ICEHandlerFunc
+	onACE             ACEHandlerFunc
+	onPIE             PIEHandlerFunc
+	onDICE            DICEHandlerFunc
+}
+
+// WithApplication sets the key and base 64 encoded secret of the application the callbacks are signed with.
+func (ch *CallbackHandler) WithApplication(key, secret string) *CallbackHandler {
+	ch.ApplicationKey = key
+	ch.ApplicationSecret = secret
+	return ch
+}
+
+func (ch *CallbackHandler) OnICE(fn ICEHandlerFunc) *CallbackHandler {
+	ch.onICE = fn
+	return ch
+}
+
+func (ch *CallbackHandler) OnACE(fn ACEHandlerFunc) *CallbackHandler {
+	ch.onACE = fn
+	return ch
+}
+
+func (ch *CallbackHandler) OnPIE(fn PIEHandlerFunc) *CallbackHandler {
+	ch.onPIE = fn
+	return ch
+}
+
+func (ch *CallbackHandler) OnDICE(fn DICEHandlerFunc) *CallbackHandler {
+	ch.onDICE = fn
+	return ch
+}
+
+func (ch *CallbackHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, MaxCallbackBodySize))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	if !auth.VerifyCallback(ch.ApplicationKey, ch.ApplicationSecret, r, body) {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	var envelope CallEvent
+	if err := json.Unmarshal(body, &envelope); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	var (
+		svaml     *SVAML
+		decodeErr error
+	)
+	switch envelope.Event {
+	case EventICE:
+		event := new(ICE)
+		if decodeErr = json.Unmarshal(body, event); decodeErr == nil {
+			svaml, err = respond(ch.onICE, ctx, event, Hangup)
+		}
+	case EventACE:
+		event := new(ACE)
+		if decodeErr = json.Unmarshal(body, event); decodeErr == nil {
+			svaml, err = respond(ch.onACE, ctx, event, Continue)
+		}
+	case EventPIE:
+		event := new(PIE)
+		if decodeErr = json.Unmarshal(body, event); decodeErr == nil {
+			svaml, err = respond(ch.onPIE, ctx, event, Continue)
+		}
+	case EventDICE:
+		event := new(DICE)
+		if decodeErr = json.Unmarshal(body, event); decodeErr == nil && ch.onDICE != nil {
+			err = ch.onDICE(ctx, event)
+		}
+	case EventNotify:
+	default:
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if decodeErr != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	if svaml == nil {
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(svaml)
+}
+
+// respond calls fn, if set, and returns its SVAML or a document with the default action.
+func respond[E any](fn func(context.Context, *E) (*SVAML, error), ctx context.Context, event *E, defaultAction func() *Action) (*SVAML, error) {
+	var svaml *SVAML
+	if fn != nil {
+		var err error
+		if svaml, err = fn(ctx, event); err != nil {
+			return nil, err
+		}
+	}
+	if svaml == nil {
+		return new(SVAML).WithAction(defaultAction()), nil
+	}
+	return svaml, svaml.Validate()
+}
@@ -0,0 +1,150 @@
+package voice
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/auth"
+)
+
+func newCallback(t *testing.T, method, body, secret string) *http.Request {
+	r := httptest.NewRequest(method, "/callbacks/voice", bytes.NewReader([]byte(body)))
+	r.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		_, err := auth.NewApplicationSigned("key", secret).Authenticate(r)
+		assert.NoError(t, err)
+	}
+	return r
+}
+
+func Test_CallbackHandler(t *testing.T) {
+	const ice = `{"event": "ice", "callid": "call-1", "timestamp": "2023-04-21T14:45:51Z", "version": 1, "cli": "+46700000001",
+		"to": {"type": "did", "endpoint": "+46700000000"}, "domain": "pstn", "originationType": "PSTN"}`
+	const ace = `{"event": "ace", "callid": "call-1", "amd": {"status": "machine", "reason": "longgreeting", "duration": 3}}`
+	const pie = `{"event": "pie", "callid": "call-1", "menuResult": {"menuId": "main", "type": "return", "value": "sales", "inputMethod": "dtmf"}}`
+	const dice = `{"event": "dice", "callid": "call-1", "reason": "CALLERHANGUP", "result": "ANSWERED", "duration": 42}`
+
+	var dices []*DICE
+	handler := new(CallbackHandler).
+		WithApplication("key", testSecret).
+		OnICE(func(ctx context.Context, event *ICE) (*SVAML, error) {
+			return new(SVAML).Say("Hello "+event.CLI, "en-US").WithAction(ConnectPSTN(event.To.Endpoint)), nil
+		}).
+		OnACE(func(ctx context.Context, event *ACE) (*SVAML, error) {
+			if event.AMD != nil && event.AMD.Status == "machine" {
+				return new(SVAML).WithAction(Hangup()), nil
+			}
+			return nil, nil
+		}).
+		OnPIE(func(ctx context.Context, event *PIE) (*SVAML, error) {
+			if event.MenuResult.Value == "sales" {
+				return new(SVAML).WithAction(ConnectPSTN("")), nil
+			}
+			return nil, assert.AnError
+		}).
+		OnDICE(func(ctx context.Context, event *DICE) error {
+			dices = append(dices, event)
+			return nil
+		})
+	defaults := new(CallbackHandler).WithApplication("key", testSecret)
+
+	tests := map[string]struct {
+		handler        *CallbackHandler
+		req            *http.Request
+		expectedStatus int
+		expectedBody   string
+	}{
+		"wrong method": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodGet, "", testSecret),
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+		"unsigned": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, ice, ""),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		"unknown event": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, `{"event": "dance"}`, testSecret),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ice that does not decode": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, `{"event": "ice", "to": "+46700000000"}`, testSecret),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"dice that does not decode": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, `{"event": "dice", "duration": "long"}`, testSecret),
+			expectedStatus: http.StatusBadRequest,
+		},
+		"ice": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, ice, testSecret),
+			expectedStatus: http.StatusOK,
+			expectedBody: `{"instructions": [{"name": "say", "text": "Hello +46700000001", "locale": "en-US"}],
+				"action": {"name": "connectPstn", "number": "+46700000000"}}`,
+		},
+		"ice default": {
+			handler:        defaults,
+			req:            newCallback(t, http.MethodPost, ice, testSecret),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"action": {"name": "hangup"}}`,
+		},
+		"ace": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, ace, testSecret),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"action": {"name": "hangup"}}`,
+		},
+		"ace default": {
+			handler:        defaults,
+			req:            newCallback(t, http.MethodPost, ace, testSecret),
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"action": {"name": "continue"}}`,
+		},
+		"pie with invalid svaml": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, pie, testSecret),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"pie handler error": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, `{"event": "pie", "menuResult": {"value": "support"}}`, testSecret),
+			expectedStatus: http.StatusInternalServerError,
+		},
+		"dice": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, dice, testSecret),
+			expectedStatus: http.StatusOK,
+		},
+		"notify": {
+			handler:        handler,
+			req:            newCallback(t, http.MethodPost, `{"event": "notify", "callid": "call-1"}`, testSecret),
+			expectedStatus: http.StatusOK,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			tt.handler.ServeHTTP(rec, tt.req)
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, rec.Body.String())
+				assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+			} else {
+				assert.Empty(t, rec.Body.String())
+			}
+		})
+	}
+
+	assert.Len(t, dices, 1)
+	assert.Equal(t, "CALLERHANGUP", dices[0].Reason)
+	assert.Equal(t, 42, dices[0].Duration)
+}
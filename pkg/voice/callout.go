@@ -0,0 +1,178 @@
+package voice
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type CalloutAction struct {
+	request  *CalloutRequest
+	response *CalloutResponse
+}
+
+func (ca *CalloutAction) Request() *CalloutRequest {
+	return ca.request
+}
+
+func (ca *CalloutAction) Response() *CalloutResponse {
+	return ca.response
+}
+
+// Callout methods.
+const (
+	MethodTTSCallout        = "ttsCallout"
+	MethodConferenceCallout = "conferenceCallout"
+	MethodCustomCallout     = "customCallout"
+)
+
+// CalloutRequest places a call to a phone number, SIP address or app user. Exactly one of TTS, Conference or Custom
+// must be set, see WithTTS, WithConference and WithCustom.
+type CalloutRequest struct {
+	CalloutMethod string             `json:"method"`
+	TTS           *TTSCallout        `json:"ttsCallout,omitempty"`
+	Conference    *ConferenceCallout `json:"conferenceCallout,omitempty"`
+	Custom        *CustomCallout     `json:"customCallout,omitempty"`
+}
+
+// TTSCallout calls the destination and plays a text or prompts.
+type TTSCallout struct {
+	CLI         string      `json:"cli,omitempty"` // The caller ID shown to the destination, one of your numbers.
+	Destination Destination `json:"destination"`
+	DTMF        string      `json:"dtmf,omitempty"` // Digits sent when the call is answered, w is a 500ms pause.
+	Domain      string      `json:"domain,omitempty"`
+	Custom      string      `json:"custom,omitempty"` // Passed back in the callbacks of the call.
+	Locale      string      `json:"locale,omitempty"`
+	Text        string      `json:"text,omitempty"`
+	Prompts     string      `json:"prompts,omitempty"` // Semicolon separated prompts, e.g. "#tts[Hello];myprerecordedfile".
+	EnableACE   bool        `json:"enableAce,omitempty"`
+	EnableDICE  bool        `json:"enableDice,omitempty"`
+	EnablePIE   bool        `json:"enablePie,omitempty"`
+}
+
+// ConferenceCallout calls the destination and connects it to a conference.
+type ConferenceCallout struct {
+	CLI                   string                 `json:"cli,omitempty"`
+	Destination           Destination            `json:"destination"`
+	ConferenceID          string                 `json:"conferenceId"`
+	ConferenceDTMFOptions *ConferenceDTMFOptions `json:"conferenceDtmfOptions,omitempty"`
+	DTMF                  string                 `json:"dtmf,omitempty"`
+	Custom                string                 `json:"custom,omitempty"`
+	MaxDuration           int                    `json:"maxDuration,omitempty"` // Seconds.
+	EnableACE             bool                   `json:"enableAce,omitempty"`
+	EnableDICE            bool                   `json:"enableDice,omitempty"`
+	EnablePIE             bool                   `json:"enablePie,omitempty"`
+	Locale                string                 `json:"locale,omitempty"`
+	Greeting              string                 `json:"greeting,omitempty"` // Played when the participant joins.
+	MOHClass              string                 `json:"mohClass,omitempty"` // Music on hold, one of ring, music1, music2 or music3.
+	Domain                string                 `json:"domain,omitempty"`
+}
+
+type ConferenceDTMFOptions struct {
+	Mode         string `json:"mode,omitempty"` // One of ignore, forward or detect.
+	MaxDigits    int    `json:"maxDigits,omitempty"`
+	TimeoutMills int    `json:"timeoutMills,omitempty"`
+}
+
+// CustomCallout calls the destination and controls the call with SVAML. The ICE, ACE and PIE fields hold SVAML
+// documents or URLs the events are posted to.
+type CustomCallout struct {
+	CLI         string      `json:"cli,omitempty"`
+	Destination Destination `json:"destination"`
+	DTMF        string      `json:"dtmf,omitempty"`
+	Custom      string      `json:"custom,omitempty"`
+	MaxDuration int         `json:"maxDuration,omitempty"`
+	ICE         string      `json:"ice,omitempty"`
+	ACE         string      `json:"ace,omitempty"`
+	PIE         string      `json:"pie,omitempty"`
+}
+
+type CalloutResponse struct {
+	CallID string `json:"callId"`
+}
+
+// WithTTS makes the request a text to speech callout.
+func (cr *CalloutRequest) WithTTS(callout *TTSCallout) *CalloutRequest {
+	cr.CalloutMethod = MethodTTSCallout
+	cr.TTS = callout
+	return cr
+}
+
+// WithConference makes the request a conference callout.
+func (cr *CalloutRequest) WithConference(callout *ConferenceCallout) *CalloutRequest {
+	cr.CalloutMethod = MethodConferenceCallout
+	cr.Conference = callout
+	return cr
+}
+
+// WithCustom makes the request a custom callout.
+func (cr *CalloutRequest) WithCustom(callout *CustomCallout) *CalloutRequest {
+	cr.CalloutMethod = MethodCustomCallout
+	cr.Custom = callout
+	return cr
+}
+
+func (cr *CalloutRequest) Validate() error {
+	set := 0
+	for _, ok := range []bool{cr.TTS != nil, cr.Conference != nil, cr.Custom != nil} {
+		if ok {
+			set++
+		}
+	}
+	if set != 1 {
+		return InvalidCalloutError
+	}
+	switch {
+	case cr.TTS != nil:
+		if cr.CalloutMethod != MethodTTSCallout {
+			return InvalidCalloutError
+		}
+		if cr.TTS.Destination.Endpoint == "" {
+			return DestinationRequiredError
+		}
+		if cr.TTS.Text == "" && cr.TTS.Prompts == "" {
+			return TextRequiredError
+		}
+	case cr.Conference != nil:
+		if cr.CalloutMethod != MethodConferenceCallout {
+			return InvalidCalloutError
+		}
+		if cr.Conference.Destination.Endpoint == "" {
+			return DestinationRequiredError
+		}
+		if cr.Conference.ConferenceID == "" {
+			return ConferenceIDRequiredError
+		}
+	case cr.Custom != nil:
+		if cr.CalloutMethod != MethodCustomCallout {
+			return InvalidCalloutError
+		}
+		if cr.Custom.Destination.Endpoint == "" {
+			return DestinationRequiredError
+		}
+	}
+	return nil
+}
+
+func (cr *CalloutRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (cr *CalloutRequest) Method() string {
+	return http.MethodPost
+}
+
+func (cr *CalloutRequest) Path() string {
+	return "/callouts"
+}
+
+func (cr *CalloutRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (cr *CalloutRequest) Body() ([]byte, error) {
+	return json.Marshal(cr)
+}
+
+func (cr *CalloutResponse) FromJSON(data []byte) error {
+	return json.Unmarshal(data, cr)
+}
@@ -0,0 +1,74 @@
+package voice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Callout_Implementations(t *testing.T) {
+	var _ sinch.Action[*CalloutRequest, *CalloutResponse] = new(CalloutAction)
+	var _ sinch.APIRequest = new(CalloutRequest)
+	var _ sinch.APIResponse = new(CalloutResponse)
+}
+
+func Test_CalloutRequest(t *testing.T) {
+	tests := map[string]struct {
+		req      *CalloutRequest
+		wantErr  error
+		wantBody string
+	}{
+		"empty": {
+			req:     new(CalloutRequest),
+			wantErr: InvalidCalloutError,
+		},
+		"two callouts": {
+			req:     new(CalloutRequest).WithTTS(&TTSCallout{}).WithCustom(&CustomCallout{}),
+			wantErr: InvalidCalloutError,
+		},
+		"tts without destination": {
+			req:     new(CalloutRequest).WithTTS(&TTSCallout{Text: "Hello"}),
+			wantErr: DestinationRequiredError,
+		},
+		"tts without text": {
+			req:     new(CalloutRequest).WithTTS(&TTSCallout{Destination: Number("+46700000000")}),
+			wantErr: TextRequiredError,
+		},
+		"conference without id": {
+			req:     new(CalloutRequest).WithConference(&ConferenceCallout{Destination: Number("+46700000000")}),
+			wantErr: ConferenceIDRequiredError,
+		},
+		"tts": {
+			req: new(CalloutRequest).WithTTS(&TTSCallout{CLI: "+46700000001", Destination: Number("+46700000000"), Locale: "en-US", Prompts: "#tts[Hello]", EnableACE: true}),
+			wantBody: `{"method":"ttsCallout","ttsCallout":{"cli":"+46700000001","destination":{"type":"number","endpoint":"+46700000000"},
+				"locale":"en-US","prompts":"#tts[Hello]","enableAce":true}}`,
+		},
+		"conference": {
+			req: new(CalloutRequest).WithConference(&ConferenceCallout{Destination: Username("alice"), ConferenceID: "standup", Domain: DomainMXP}),
+			wantBody: `{"method":"conferenceCallout","conferenceCallout":{"destination":{"type":"username","endpoint":"alice"},
+				"conferenceId":"standup","domain":"mxp"}}`,
+		},
+		"custom": {
+			req: new(CalloutRequest).WithCustom(&CustomCallout{Destination: SIP("sip:alice@example.com"), ICE: `{"action":{"name":"hangup"}}`}),
+			wantBody: `{"method":"customCallout","customCallout":{"destination":{"type":"sip","endpoint":"sip:alice@example.com"},
+				"ice":"{\"action\":{\"name\":\"hangup\"}}"}}`,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+			if tt.wantErr != nil {
+				return
+			}
+			body, err := tt.req.Body()
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.wantBody, string(body))
+			assert.Equal(t, http.MethodPost, tt.req.Method())
+			assert.Equal(t, "/callouts", tt.req.Path())
+			assert.Equal(t, http.StatusOK, tt.req.ExpectedStatusCode())
+		})
+	}
+}
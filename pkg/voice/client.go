@@ -0,0 +1,48 @@
+// Package voice is a client for the Sinch Voice API, which places and controls phone calls and conferences and answers
+// call events with SVAML.
+package voice
+
+import (
+	"context"
+
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+type Client struct {
+	api.ApplicationClient
+}
+
+// BaseURLv1 routes requests to the nearest data center. The regional base URLs pin requests to one data center.
+const (
+	BaseURLv1               = "https://calling.api.sinch.com/calling/v1"
+	EuropeBaseURLv1         = "https://calling-euc1.api.sinch.com/calling/v1"
+	NorthAmericaBaseURLv1   = "https://calling-use1.api.sinch.com/calling/v1"
+	SouthAmericaBaseURLv1   = "https://calling-sae1.api.sinch.com/calling/v1"
+	SoutheastAsia1BaseURLv1 = "https://calling-apse1.api.sinch.com/calling/v1"
+	SoutheastAsia2BaseURLv1 = "https://calling-apse2.api.sinch.com/calling/v1"
+)
+
+// Callout places a call and returns its ID, see CalloutRequest.
+func (c *Client) Callout(ctx context.Context, req *CalloutRequest) (string, error) {
+	resp := new(CalloutResponse)
+	if err := c.DoContext(ctx, req, resp); err != nil {
+		return "", err
+	}
+	return resp.CallID, nil
+}
+
+// Call fetches information about a call.
+func (c *Client) Call(ctx context.Context, callID string) (*CallResult, error) {
+	resp := new(CallResult)
+	if err := c.DoContext(ctx, new(CallRequest).WithCallID(callID), resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Hangup hangs up a call.
+func (c *Client) Hangup(ctx context.Context, callID string) error {
+	req := new(UpdateCallRequest).WithCallID(callID).WithSVAML(new(SVAML).WithAction(Hangup()))
+	return c.DoContext(ctx, req, new(sinch.NoContent))
+}
@@ -0,0 +1,92 @@
+package voice
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/auth"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+var testSecret = base64.StdEncoding.EncodeToString([]byte("secret"))
+
+func Test_Client_Implementations(t *testing.T) {
+	var _ sinch.APIClient = new(Client)
+}
+
+func Test_Client_Validate(t *testing.T) {
+	tests := map[string]struct {
+		opts    []Option
+		wantErr error
+	}{
+		"missing key":    {opts: []Option{WithApplication("", testSecret)}, wantErr: ApplicationKeyRequiredError},
+		"missing secret": {opts: []Option{WithApplication("key", "")}, wantErr: ApplicationSecretRequiredError},
+		"application":    {opts: []Option{WithApplication("key", testSecret)}},
+		"authenticator":  {opts: []Option{WithAuthenticator(auth.NewBasicAuth("key", "secret"))}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := New(tt.opts...)
+			assert.ErrorIs(t, err, tt.wantErr)
+		})
+	}
+}
+
+func Test_New(t *testing.T) {
+	c, err := New(WithApplication("key", testSecret), WithBaseURL(EuropeBaseURLv1))
+	assert.NoError(t, err)
+	assert.Equal(t, EuropeBaseURLv1, c.URL())
+
+	_, err = New()
+	assert.ErrorIs(t, err, ApplicationKeyRequiredError)
+}
+
+func Test_Client_Do(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		got = append(got, r.Method+" "+r.URL.Path+" "+string(body))
+
+		signature, _ := auth.Sign(testSecret, auth.StringToSign(r.Method, body, r.Header.Get("Content-Type"), r.Header.Get(auth.TimestampHeader), r.URL.Path))
+		if r.Header.Get("Authorization") != "Application key:"+signature {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch r.Method {
+		case http.MethodPost:
+			io.WriteString(w, `{"callId": "call-1"}`)
+		case http.MethodGet:
+			io.WriteString(w, `{"callId": "call-1", "status": "ONGOING", "result": "N/A", "to": {"type": "number", "endpoint": "+46700000000"}}`)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := New(WithApplication("key", testSecret), WithBaseURL(srv.URL), WithHTTPClient(srv.Client()))
+	assert.NoError(t, err)
+	ctx := context.Background()
+
+	callID, err := c.Callout(ctx, new(CalloutRequest).WithTTS(&TTSCallout{Destination: Number("+46700000000"), Text: "Hello"}))
+	assert.NoError(t, err)
+	assert.Equal(t, "call-1", callID)
+
+	call, err := c.Call(ctx, callID)
+	assert.NoError(t, err)
+	assert.Equal(t, CallStatusOngoing, call.Status)
+	assert.Equal(t, "+46700000000", call.To.Endpoint)
+
+	assert.NoError(t, c.Hangup(ctx, callID))
+
+	assert.Equal(t, []string{
+		`POST /callouts {"method":"ttsCallout","ttsCallout":{"destination":{"type":"number","endpoint":"+46700000000"},"text":"Hello"}}`,
+		"GET /calls/id/call-1 ",
+		`PATCH /calls/id/call-1 {"action":{"name":"hangup"}}`,
+	}, got)
+}
@@ -0,0 +1,188 @@
+package voice
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+)
+
+type ConferenceAction struct {
+	request  *ConferenceRequest
+	response *Conference
+}
+
+func (ca *ConferenceAction) Request() *ConferenceRequest {
+	return ca.request
+}
+
+func (ca *ConferenceAction) Response() *Conference {
+	return ca.response
+}
+
+// ConferenceRequest fetches the participants of a conference.
+type ConferenceRequest struct {
+	ConferenceID string
+}
+
+type Conference struct {
+	Participants []Participant `json:"participants"`
+}
+
+type Participant struct {
+	CLI      string `json:"cli"`
+	ID       string `json:"id"` // The call ID of the participant.
+	Duration int    `json:"duration"`
+	Muted    bool   `json:"muted"`
+	OnHold   bool   `json:"onhold"`
+}
+
+func (cr *ConferenceRequest) WithConferenceID(conferenceID string) *ConferenceRequest {
+	cr.ConferenceID = conferenceID
+	return cr
+}
+
+func (cr *ConferenceRequest) Validate() error {
+	if cr.ConferenceID == "" {
+		return ConferenceIDRequiredError
+	}
+	return nil
+}
+
+func (cr *ConferenceRequest) ExpectedStatusCode() int {
+	return http.StatusOK
+}
+
+func (cr *ConferenceRequest) Method() string {
+	return http.MethodGet
+}
+
+func (cr *ConferenceRequest) Path() string {
+	return "/conferences/id/" + url.PathEscape(cr.ConferenceID)
+}
+
+func (cr *ConferenceRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (cr *ConferenceRequest) Body() ([]byte, error) {
+	return nil, nil
+}
+
+func (c *Conference) FromJSON(data []byte) error {
+	return json.Unmarshal(data, c)
+}
+
+// Participant commands.
+const (
+	CommandMute   = "mute"
+	CommandUnmute = "unmute"
+	CommandOnHold = "onhold"
+	CommandResume = "resume"
+)
+
+// ManageParticipantRequest mutes, unmutes, holds or resumes a conference participant. The response has no content,
+// use sinch.NoContent.
+type ManageParticipantRequest struct {
+	ConferenceID string `json:"-"`
+	CallID       string `json:"-"`
+	Command      string `json:"command"`
+	MOH          string `json:"moh,omitempty"` // Music on hold with CommandOnHold, one of ring or music1 to music3.
+}
+
+func (mpr *ManageParticipantRequest) WithParticipant(conferenceID, callID string) *ManageParticipantRequest {
+	mpr.ConferenceID = conferenceID
+	mpr.CallID = callID
+	return mpr
+}
+
+func (mpr *ManageParticipantRequest) WithCommand(command string) *ManageParticipantRequest {
+	mpr.Command = command
+	return mpr
+}
+
+func (mpr *ManageParticipantRequest) WithMOH(moh string) *ManageParticipantRequest {
+	mpr.MOH = moh
+	return mpr
+}
+
+func (mpr *ManageParticipantRequest) Validate() error {
+	if mpr.ConferenceID == "" {
+		return ConferenceIDRequiredError
+	}
+	if mpr.CallID == "" {
+		return CallIDRequiredError
+	}
+	switch mpr.Command {
+	case CommandMute, CommandUnmute, CommandOnHold, CommandResume:
+		return nil
+	}
+	return InvalidCommandError
+}
+
+func (mpr *ManageParticipantRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (mpr *ManageParticipantRequest) Method() string {
+	return http.MethodPatch
+}
+
+func (mpr *ManageParticipantRequest) Path() string {
+	return "/conferences/id/" + url.PathEscape(mpr.ConferenceID) + "/" + url.PathEscape(mpr.CallID)
+}
+
+func (mpr *ManageParticipantRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (mpr *ManageParticipantRequest) Body() ([]byte, error) {
+	return json.Marshal(mpr)
+}
+
+// KickParticipantRequest removes a participant from a conference, or every participant if CallID is empty. The
+// response has no content, use sinch.NoContent.
+type KickParticipantRequest struct {
+	ConferenceID string
+	CallID       string
+}
+
+func (kpr *KickParticipantRequest) WithConferenceID(conferenceID string) *KickParticipantRequest {
+	kpr.ConferenceID = conferenceID
+	return kpr
+}
+
+func (kpr *KickParticipantRequest) WithCallID(callID string) *KickParticipantRequest {
+	kpr.CallID = callID
+	return kpr
+}
+
+func (kpr *KickParticipantRequest) Validate() error {
+	if kpr.ConferenceID == "" {
+		return ConferenceIDRequiredError
+	}
+	return nil
+}
+
+func (kpr *KickParticipantRequest) ExpectedStatusCode() int {
+	return http.StatusNoContent
+}
+
+func (kpr *KickParticipantRequest) Method() string {
+	return http.MethodDelete
+}
+
+func (kpr *KickParticipantRequest) Path() string {
+	path := "/conferences/id/" + url.PathEscape(kpr.ConferenceID)
+	if kpr.CallID != "" {
+		path += "/" + url.PathEscape(kpr.CallID)
+	}
+	return path
+}
+
+func (kpr *KickParticipantRequest) QueryString() (string, error) {
+	return "", nil
+}
+
+func (kpr *KickParticipantRequest) Body() ([]byte, error) {
+	return nil, nil
+}
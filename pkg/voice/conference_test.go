@@ -0,0 +1,79 @@
+package voice
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+func Test_Conference_Implementations(t *testing.T) {
+	var _ sinch.Action[*ConferenceRequest, *Conference] = new(ConferenceAction)
+	var _ sinch.APIRequest = new(ConferenceRequest)
+	var _ sinch.APIResponse = new(Conference)
+	var _ sinch.APIRequest = new(ManageParticipantRequest)
+	var _ sinch.APIRequest = new(KickParticipantRequest)
+}
+
+func Test_ConferenceRequest(t *testing.T) {
+	cr := new(ConferenceRequest)
+	assert.ErrorIs(t, cr.Validate(), ConferenceIDRequiredError)
+
+	cr.WithConferenceID("standup")
+	assert.NoError(t, cr.Validate())
+	assert.Equal(t, "/conferences/id/standup", cr.Path())
+
+	c := new(Conference)
+	assert.NoError(t, c.FromJSON([]byte(`{"participants": [{"cli": "+46700000000", "id": "call-1", "duration": 10, "muted": true, "onhold": false}]}`)))
+	assert.Equal(t, []Participant{{CLI: "+46700000000", ID: "call-1", Duration: 10, Muted: true}}, c.Participants)
+}
+
+func Test_ManageParticipantRequest(t *testing.T) {
+	tests := map[string]struct {
+		req     *ManageParticipantRequest
+		wantErr error
+	}{
+		"missing conference": {
+			req:     new(ManageParticipantRequest).WithParticipant("", "call-1").WithCommand(CommandMute),
+			wantErr: ConferenceIDRequiredError,
+		},
+		"missing call": {
+			req:     new(ManageParticipantRequest).WithParticipant("standup", "").WithCommand(CommandMute),
+			wantErr: CallIDRequiredError,
+		},
+		"invalid command": {
+			req:     new(ManageParticipantRequest).WithParticipant("standup", "call-1").WithCommand("kick"),
+			wantErr: InvalidCommandError,
+		},
+		"hold": {
+			req: new(ManageParticipantRequest).WithParticipant("standup", "call-1").WithCommand(CommandOnHold).WithMOH("music1"),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.req.Validate(), tt.wantErr)
+		})
+	}
+
+	req := tests["hold"].req
+	body, err := req.Body()
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"command": "onhold", "moh": "music1"}`, string(body))
+	assert.Equal(t, http.MethodPatch, req.Method())
+	assert.Equal(t, "/conferences/id/standup/call-1", req.Path())
+}
+
+func Test_KickParticipantRequest(t *testing.T) {
+	req := new(KickParticipantRequest)
+	assert.ErrorIs(t, req.Validate(), ConferenceIDRequiredError)
+
+	req.WithConferenceID("standup")
+	assert.NoError(t, req.Validate())
+	assert.Equal(t, http.MethodDelete, req.Method())
+	assert.Equal(t, "/conferences/id/standup", req.Path())
+
+	req.WithCallID("call-1")
+	assert.Equal(t, "/conferences/id/standup/call-1", req.Path())
+}
@@ -0,0 +1,20 @@
+package voice
+
+import (
+	"github.com/thezmc/go-sinch/pkg/api"
+	"github.com/thezmc/go-sinch/pkg/sinch"
+)
+
+const (
+	ApplicationKeyRequiredError    = api.ApplicationKeyRequiredError
+	ApplicationSecretRequiredError = api.ApplicationSecretRequiredError
+	CallIDRequiredError            = sinch.Error("call ID is required")
+	ConferenceIDRequiredError      = sinch.Error("conference ID is required")
+	DestinationRequiredError       = sinch.Error("destination is required")
+	InvalidCalloutError            = sinch.Error("exactly one of a text to speech, conference or custom callout is required")
+	TextRequiredError              = sinch.Error("text or prompts are required for a text to speech callout")
+	SVAMLRequiredError             = sinch.Error("SVAML with at least one instruction or an action is required")
+	InvalidCommandError            = sinch.Error("command must be one of mute, unmute, onhold or resume")
+	InvalidInstructionError        = sinch.Error("invalid SVAML instruction")
+	InvalidActionError             = sinch.Error("invalid SVAML action")
+)
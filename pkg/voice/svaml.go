@@ -0,0 +1,417 @@
+package voice
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// SVAML is the Sinch Voice Application Markup Language document used to control a call: instructions run in order,
+// then the action decides what happens to the call. Documents are built with the instruction methods and WithAction:
+//
+//	svaml := new(voice.SVAML).
+//		Say("Connecting you now", "en-US").
+//		WithAction(voice.ConnectPSTN("+46700000000").WithCLI("+46700000001"))
+type SVAML struct {
+	Instructions []Instruction `json:"instructions,omitempty"`
+	Action       *Action       `json:"action,omitempty"`
+}
+
+// Instruction names.
+const (
+	InstructionPlayFiles      = "playFiles"
+	InstructionSay            = "say"
+	InstructionSendDTMF       = "sendDtmf"
+	InstructionSetCookie      = "setCookie"
+	InstructionAnswer         = "answer"
+	InstructionStartRecording = "startRecording"
+	InstructionStopRecording  = "stopRecording"
+)
+
+// Instruction is a step executed before the action of a SVAML document.
+type Instruction struct {
+	Name    string            `json:"name"`
+	Text    string            `json:"text,omitempty"`    // The text to speak with InstructionSay.
+	IDs     []string          `json:"ids,omitempty"`     // The prompts to play with InstructionPlayFiles.
+	Locale  string            `json:"locale,omitempty"`  // The voice and language of the text, e.g. en-US.
+	Value   string            `json:"value,omitempty"`   // The digits of InstructionSendDTMF or the cookie value of InstructionSetCookie.
+	Key     string            `json:"key,omitempty"`     // The cookie name of InstructionSetCookie.
+	Options *RecordingOptions `json:"options,omitempty"` // The options of InstructionStartRecording.
+}
+
+type RecordingOptions struct {
+	DestinationURL       string                `json:"destinationUrl,omitempty"` // Where the recording is stored, e.g. an s3:// URL.
+	Credentials          string                `json:"credentials,omitempty"`
+	Format               string                `json:"format,omitempty"` // One of mp3 or wav.
+	NotificationEvents   bool                  `json:"notificationEvents,omitempty"`
+	TranscriptionOptions *TranscriptionOptions `json:"transcriptionOptions,omitempty"`
+}
+
+type TranscriptionOptions struct {
+	Enabled bool   `json:"enabled"`
+	Locale  string `json:"locale,omitempty"`
+}
+
+// Action names.
+const (
+	ActionHangup      = "hangup"
+	ActionContinue    = "continue"
+	ActionConnectPSTN = "connectPstn"
+	ActionConnectSIP  = "connectSip"
+	ActionConnectMXP  = "connectMxp"
+	ActionConnectConf = "connectConf"
+	ActionRunMenu     = "runMenu"
+	ActionPark        = "park"
+)
+
+// Action is the final step of a SVAML document. Create actions with Hangup, Continue, ConnectPSTN, ConnectSIP,
+// ConnectMXP, ConnectConference, RunMenu or Park and set their options with the With methods.
+type Action struct {
+	Name                  string                 `json:"name"`
+	Number                string                 `json:"number,omitempty"`
+	Destination           *Destination           `json:"destination,omitempty"`
+	ConferenceID          string                 `json:"conferenceId,omitempty"`
+	ConferenceDTMFOptions *ConferenceDTMFOptions `json:"conferenceDtmfOptions,omitempty"`
+	Locale                string                 `json:"locale,omitempty"`
+	CLI                   string                 `json:"cli,omitempty"`
+	MaxDuration           int                    `json:"maxDuration,omitempty"` // Seconds.
+	DialTimeout           int                    `json:"dialTimeout,omitempty"` // Seconds.
+	SuppressCallbacks     bool                   `json:"suppressCallbacks,omitempty"`
+	DTMF                  string                 `json:"dtmf,omitempty"`
+	Indications           string                 `json:"indications,omitempty"` // The country of the ringback tone, e.g. se.
+	AMD                   *AMD                   `json:"amd,omitempty"`
+	Transport             string                 `json:"transport,omitempty"` // The SIP transport, one of UDP, TCP or TLS.
+	CallHeaders           []CallHeader           `json:"callHeaders,omitempty"`
+	MOH                   string                 `json:"moh,omitempty"` // Music on hold, one of ring or music1 to music3.
+	Barge                 bool                   `json:"barge,omitempty"`
+	EnableVoice           bool                   `json:"enableVoice,omitempty"`
+	MainMenu              string                 `json:"mainMenu,omitempty"`
+	Menus                 []Menu                 `json:"menus,omitempty"`
+	IntroPrompt           string                 `json:"introPrompt,omitempty"`
+	HoldPrompt            string                 `json:"holdPrompt,omitempty"`
+}
+
+// AMD is answering machine detection.
+type AMD struct {
+	Enabled bool `json:"enabled"`
+}
+
+type CallHeader struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Menu is an IVR menu of RunMenu. The prompts are semicolon separated, e.g. "#tts[Press 1 for sales]".
+type Menu struct {
+	ID              string       `json:"id"`
+	MainPrompt      string       `json:"mainPrompt"`
+	RepeatPrompt    string       `json:"repeatPrompt,omitempty"`
+	Repeats         int          `json:"repeats,omitempty"`
+	MaxDigits       int          `json:"maxDigits,omitempty"`
+	TimeoutMills    int          `json:"timeoutMills,omitempty"`
+	MaxTimeoutMills int          `json:"maxTimeoutMills,omitempty"`
+	Options         []MenuOption `json:"options,omitempty"`
+}
+
+// MenuOption maps a DTMF digit to another menu, see GoToMenu, or to a value returned in the PIE, see ReturnValue.
+type MenuOption struct {
+	DTMF   string `json:"dtmf"`
+	Action string `json:"action"`
+}
+
+// GoToMenu returns the action of a menu option that opens the menu with the given ID.
+func GoToMenu(id string) string {
+	return "menu(" + id + ")"
+}
+
+// ReturnValue returns the action of a menu option that ends the menu and sends value in a PIE.
+func ReturnValue(value string) string {
+	return "return(" + value + ")"
+}
+
+// PlayFiles plays the given prompts, e.g. "#tts[Hello]", "#ssml[<speak>Hello</speak>]" or a URL.
+func (s *SVAML) PlayFiles(locale string, ids ...string) *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionPlayFiles, IDs: ids, Locale: locale})
+}
+
+// Say speaks text with the voice of the locale.
+func (s *SVAML) Say(text, locale string) *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionSay, Text: text, Locale: locale})
+}
+
+// SendDTMF sends digits to the call. A w is a 500ms pause.
+func (s *SVAML) SendDTMF(digits string) *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionSendDTMF, Value: digits})
+}
+
+// SetCookie stores a value that is sent with the later callbacks of the call.
+func (s *SVAML) SetCookie(key, value string) *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionSetCookie, Key: key, Value: value})
+}
+
+// Answer answers the call before the action is run, e.g. to play prompts to the caller first.
+func (s *SVAML) Answer() *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionAnswer})
+}
+
+// StartRecording starts recording the call.
+func (s *SVAML) StartRecording(options *RecordingOptions) *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionStartRecording, Options: options})
+}
+
+// StopRecording stops recording the call.
+func (s *SVAML) StopRecording() *SVAML {
+	return s.WithInstruction(Instruction{Name: InstructionStopRecording})
+}
+
+// WithInstruction appends an instruction.
+func (s *SVAML) WithInstruction(instruction Instruction) *SVAML {
+	s.Instructions = append(s.Instructions, instruction)
+	return s
+}
+
+// WithAction sets the action.
+func (s *SVAML) WithAction(action *Action) *SVAML {
+	s.Action = action
+	return s
+}
+
+// Hangup hangs up the call.
+func Hangup() *Action {
+	return &Action{Name: ActionHangup}
+}
+
+// Continue continues the call, e.g. after a DICE or a PIE that needs no further action.
+func Continue() *Action {
+	return &Action{Name: ActionContinue}
+}
+
+// ConnectPSTN connects the call to a phone number.
+func ConnectPSTN(number string) *Action {
+	return &Action{Name: ActionConnectPSTN, Number: number}
+}
+
+// ConnectSIP connects the call to a SIP address.
+func ConnectSIP(address string) *Action {
+	destination := SIP(address)
+	return &Action{Name: ActionConnectSIP, Destination: &destination}
+}
+
+// ConnectMXP connects the call to an app user, see Username.
+func ConnectMXP(destination Destination) *Action {
+	return &Action{Name: ActionConnectMXP, Destination: &destination}
+}
+
+// ConnectConference connects the call to a conference, which is created if it does not exist.
+func ConnectConference(conferenceID string) *Action {
+	return &Action{Name: ActionConnectConf, ConferenceID: conferenceID}
+}
+
+// RunMenu plays an IVR menu and sends the result in a PIE. The first menu is the main menu unless set with
+// WithMainMenu.
+func RunMenu(menus ...Menu) *Action {
+	a := &Action{Name: ActionRunMenu, Menus: menus}
+	if len(menus) > 0 {
+		a.MainMenu = menus[0].ID
+	}
+	return a
+}
+
+// Park puts the call on hold, playing holdPrompt until the call is updated or maxDuration seconds pass.
+func Park(holdPrompt string, maxDuration int) *Action {
+	return &Action{Name: ActionPark, HoldPrompt: holdPrompt, MaxDuration: maxDuration}
+}
+
+func (a *Action) WithCLI(cli string) *Action {
+	a.CLI = cli
+	return a
+}
+
+func (a *Action) WithLocale(locale string) *Action {
+	a.Locale = locale
+	return a
+}
+
+func (a *Action) WithMaxDuration(seconds int) *Action {
+	a.MaxDuration = seconds
+	return a
+}
+
+func (a *Action) WithDialTimeout(seconds int) *Action {
+	a.DialTimeout = seconds
+	return a
+}
+
+// WithSuppressCallbacks stops the ACE and DICE of the connected call from being sent.
+func (a *Action) WithSuppressCallbacks() *Action {
+	a.SuppressCallbacks = true
+	return a
+}
+
+func (a *Action) WithDTMF(digits string) *Action {
+	a.DTMF = digits
+	return a
+}
+
+func (a *Action) WithIndications(country string) *Action {
+	a.Indications = country
+	return a
+}
+
+// WithAMD enables answering machine detection. The result is sent in the ACE.
+func (a *Action) WithAMD() *Action {
+	a.AMD = &AMD{Enabled: true}
+	return a
+}
+
+func (a *Action) WithTransport(transport string) *Action {
+	a.Transport = transport
+	return a
+}
+
+func (a *Action) WithCallHeader(key, value string) *Action {
+	a.CallHeaders = append(a.CallHeaders, CallHeader{Key: key, Value: value})
+	return a
+}
+
+func (a *Action) WithMOH(moh string) *Action {
+	a.MOH = moh
+	return a
+}
+
+func (a *Action) WithConferenceDTMFOptions(options *ConferenceDTMFOptions) *Action {
+	a.ConferenceDTMFOptions = options
+	return a
+}
+
+// WithBarge lets the caller interrupt the prompts of RunMenu by pressing a key.
+func (a *Action) WithBarge() *Action {
+	a.Barge = true
+	return a
+}
+
+func (a *Action) WithMainMenu(id string) *Action {
+	a.MainMenu = id
+	return a
+}
+
+func (a *Action) WithIntroPrompt(prompt string) *Action {
+	a.IntroPrompt = prompt
+	return a
+}
+
+var (
+	dtmfPattern       = regexp.MustCompile(`^[0-9#*wW]+$`)
+	menuActionPattern = regexp.MustCompile(`^(menu|return)\(.*\)$`)
+)
+
+// Validate returns an error if the document has neither instructions nor an action, or if an instruction or the
+// action is missing a required field.
+func (s *SVAML) Validate() error {
+	if s == nil || (len(s.Instructions) == 0 && s.Action == nil) {
+		return SVAMLRequiredError
+	}
+	for i := range s.Instructions {
+		if err := s.Instructions[i].Validate(); err != nil {
+			return err
+		}
+	}
+	if s.Action != nil {
+		return s.Action.Validate()
+	}
+	return nil
+}
+
+func (in *Instruction) Validate() error {
+	var missing string
+	switch in.Name {
+	case InstructionPlayFiles:
+		if len(in.IDs) == 0 {
+			missing = "ids"
+		}
+	case InstructionSay:
+		if in.Text == "" {
+			missing = "text"
+		}
+	case InstructionSendDTMF:
+		if !dtmfPattern.MatchString(in.Value) {
+			return fmt.Errorf("%w: %s value must be DTMF digits", InvalidInstructionError, in.Name)
+		}
+	case InstructionSetCookie:
+		if in.Key == "" {
+			missing = "key"
+		}
+	case InstructionAnswer, InstructionStopRecording:
+	case InstructionStartRecording:
+		if in.Options == nil || in.Options.DestinationURL == "" {
+			missing = "options.destinationUrl"
+		}
+	default:
+		return fmt.Errorf("%w: unknown instruction %q", InvalidInstructionError, in.Name)
+	}
+	if missing != "" {
+		return fmt.Errorf("%w: %s requires %s", InvalidInstructionError, in.Name, missing)
+	}
+	return nil
+}
+
+func (a *Action) Validate() error {
+	var missing string
+	switch a.Name {
+	case ActionHangup, ActionContinue:
+	case ActionConnectPSTN:
+		if a.Number == "" {
+			missing = "number"
+		}
+	case ActionConnectSIP, ActionConnectMXP:
+		if a.Destination == nil || a.Destination.Endpoint == "" {
+			missing = "destination"
+		}
+	case ActionConnectConf:
+		if a.ConferenceID == "" {
+			missing = "conferenceId"
+		}
+	case ActionRunMenu:
+		return a.validateMenus()
+	case ActionPark:
+		if a.HoldPrompt == "" {
+			missing = "holdPrompt"
+		}
+	default:
+		return fmt.Errorf("%w: unknown action %q", InvalidActionError, a.Name)
+	}
+	if missing != "" {
+		return fmt.Errorf("%w: %s requires %s", InvalidActionError, a.Name, missing)
+	}
+	if a.DTMF != "" && !dtmfPattern.MatchString(a.DTMF) {
+		return fmt.Errorf("%w: %s dtmf must be DTMF digits", InvalidActionError, a.Name)
+	}
+	return nil
+}
+
+func (a *Action) validateMenus() error {
+	if len(a.Menus) == 0 {
+		return fmt.Errorf("%w: %s requires menus", InvalidActionError, a.Name)
+	}
+	ids := make(map[string]bool, len(a.Menus))
+	for _, m := range a.Menus {
+		if m.ID == "" || m.MainPrompt == "" {
+			return fmt.Errorf("%w: menus require an id and a mainPrompt", InvalidActionError)
+		}
+		ids[m.ID] = true
+	}
+	if !ids[a.MainMenu] {
+		return fmt.Errorf("%w: main menu %q does not exist", InvalidActionError, a.MainMenu)
+	}
+	for _, m := range a.Menus {
+		for _, o := range m.Options {
+			if !dtmfPattern.MatchString(o.DTMF) || !menuActionPattern.MatchString(o.Action) {
+				return fmt.Errorf("%w: invalid option %q of menu %q", InvalidActionError, o.DTMF, m.ID)
+			}
+			if target := strings.TrimSuffix(strings.TrimPrefix(o.Action, "menu("), ")"); strings.HasPrefix(o.Action, "menu(") && !ids[target] {
+				return fmt.Errorf("%w: option %q of menu %q opens unknown menu %q", InvalidActionError, o.DTMF, m.ID, target)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package voice
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_SVAML_Build(t *testing.T) {
+	svaml := new(SVAML).
+		SetCookie("step", "1").
+		Say("Connecting you now", "en-US").
+		PlayFiles("en-US", "#tts[Please hold]", "https://example.com/hold.mp3").
+		WithAction(ConnectPSTN("+46700000000").WithCLI("+46700000001").WithMaxDuration(600).WithAMD())
+	assert.NoError(t, svaml.Validate())
+
+	data, err := json.Marshal(svaml)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{
+		"instructions": [
+			{"name": "setCookie", "key": "step", "value": "1"},
+			{"name": "say", "text": "Connecting you now", "locale": "en-US"},
+			{"name": "playFiles", "ids": ["#tts[Please hold]", "https://example.com/hold.mp3"], "locale": "en-US"}
+		],
+		"action": {"name": "connectPstn", "number": "+46700000000", "cli": "+46700000001", "maxDuration": 600, "amd": {"enabled": true}}
+	}`, string(data))
+}
+
+func Test_SVAML_Actions(t *testing.T) {
+	menu := RunMenu(
+		Menu{ID: "main", MainPrompt: "#tts[Press 1 for sales or 2 for support]", Options: []MenuOption{
+			{DTMF: "1", Action: ReturnValue("sales")},
+			{DTMF: "2", Action: GoToMenu("support")},
+		}},
+		Menu{ID: "support", MainPrompt: "#tts[Press 1 to talk to an agent]", Options: []MenuOption{{DTMF: "1", Action: ReturnValue("agent")}}},
+	).WithBarge()
+
+	tests := map[string]struct {
+		svaml   *SVAML
+		wantErr error
+	}{
+		"empty":                 {svaml: new(SVAML), wantErr: SVAMLRequiredError},
+		"nil":                   {wantErr: SVAMLRequiredError},
+		"hangup":                {svaml: new(SVAML).WithAction(Hangup())},
+		"continue":              {svaml: new(SVAML).Answer().WithAction(Continue())},
+		"connect sip":           {svaml: new(SVAML).WithAction(ConnectSIP("sip:alice@example.com").WithTransport("TLS").WithCallHeader("x-id", "1"))},
+		"connect sip no addr":   {svaml: new(SVAML).WithAction(ConnectSIP("")), wantErr: InvalidActionError},
+		"connect mxp":           {svaml: new(SVAML).WithAction(ConnectMXP(Username("alice")))},
+		"connect conference":    {svaml: new(SVAML).WithAction(ConnectConference("standup").WithMOH("music1"))},
+		"conference no id":      {svaml: new(SVAML).WithAction(ConnectConference("")), wantErr: InvalidActionError},
+		"connect pstn no num":   {svaml: new(SVAML).WithAction(ConnectPSTN("")), wantErr: InvalidActionError},
+		"connect pstn bad dtmf": {svaml: new(SVAML).WithAction(ConnectPSTN("+46700000000").WithDTMF("12x")), wantErr: InvalidActionError},
+		"park":                  {svaml: new(SVAML).WithAction(Park("#tts[Please wait]", 60).WithIntroPrompt("#tts[Parking]"))},
+		"park no prompt":        {svaml: new(SVAML).WithAction(Park("", 60)), wantErr: InvalidActionError},
+		"menu":                  {svaml: new(SVAML).WithAction(menu)},
+		"menu without menus":    {svaml: new(SVAML).WithAction(RunMenu()), wantErr: InvalidActionError},
+		"menu unknown main":     {svaml: new(SVAML).WithAction(RunMenu(Menu{ID: "main", MainPrompt: "#tts[Hi]"}).WithMainMenu("other")), wantErr: InvalidActionError},
+		"menu unknown target": {
+			svaml:   new(SVAML).WithAction(RunMenu(Menu{ID: "main", MainPrompt: "#tts[Hi]", Options: []MenuOption{{DTMF: "1", Action: GoToMenu("other")}}})),
+			wantErr: InvalidActionError,
+		},
+		"menu bad option": {
+			svaml:   new(SVAML).WithAction(RunMenu(Menu{ID: "main", MainPrompt: "#tts[Hi]", Options: []MenuOption{{DTMF: "1", Action: "sales"}}})),
+			wantErr: InvalidActionError,
+		},
+		"unknown action":      {svaml: new(SVAML).WithAction(&Action{Name: "dance"}), wantErr: InvalidActionError},
+		"say without text":    {svaml: new(SVAML).Say("", "en-US"), wantErr: InvalidInstructionError},
+		"play without files":  {svaml: new(SVAML).PlayFiles("en-US"), wantErr: InvalidInstructionError},
+		"send dtmf":           {svaml: new(SVAML).SendDTMF("1234#w5")},
+		"send bad dtmf":       {svaml: new(SVAML).SendDTMF("abc"), wantErr: InvalidInstructionError},
+		"cookie without key":  {svaml: new(SVAML).SetCookie("", "1"), wantErr: InvalidInstructionError},
+		"recording":           {svaml: new(SVAML).StartRecording(&RecordingOptions{DestinationURL: "s3://bucket/call.mp3"}).StopRecording()},
+		"recording no dest":   {svaml: new(SVAML).StartRecording(nil), wantErr: InvalidInstructionError},
+		"unknown instruction": {svaml: new(SVAML).WithInstruction(Instruction{Name: "dance"}), wantErr: InvalidInstructionError},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.ErrorIs(t, tt.svaml.Validate(), tt.wantErr)
+		})
+	}
+
+	assert.Equal(t, "main", menu.MainMenu)
+}
@@ -0,0 +1,35 @@
+package voice
+
+// Destination types.
+const (
+	DestinationNumber   = "number"   // A phone number in E.164 format with leading +.
+	DestinationUsername = "username" // A user of an app using the Sinch SDK.
+	DestinationSIP      = "sip"      // A SIP address.
+)
+
+// Domains a call can be placed in.
+const (
+	DomainPSTN = "pstn"
+	DomainMXP  = "mxp" // Calls to apps using the Sinch SDK.
+)
+
+// Destination is the endpoint a call is placed to or comes from.
+type Destination struct {
+	Type     string `json:"type"`
+	Endpoint string `json:"endpoint"`
+}
+
+// Number returns the destination of the given phone number.
+func Number(number string) Destination {
+	return Destination{Type: DestinationNumber, Endpoint: number}
+}
+
+// Username returns the destination of the given app user.
+func Username(username string) Destination {
+	return Destination{Type: DestinationUsername, Endpoint: username}
+}
+
+// SIP returns the destination of the given SIP address.
+func SIP(address string) Destination {
+	return Destination{Type: DestinationSIP, Endpoint: address}
+}